@@ -0,0 +1,139 @@
+// Package `ar` provides functions and types to calculate the readability for texts in Arabic language.
+// 1. OSMAN readability metric (Open Source Metric for Measuring Arabic Narratives)
+//
+// Arabic text is processed in its logical (reading) order, so right-to-left rendering does not affect the counts.
+package ar
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// CalcOsman accepts a non-empty string and returns the OSMAN readability score for it. The string must contain at least one word and at least one sentence (ended with `.`, `!`, `?` or the Arabic question mark `؟`).
+// The formula is 200.791 - 1.015 * (A / B) - 24.181 * (C / A + D / A + G / A - H / A), where
+// A is the number of words, B is the number of sentences, C is the number of hard words (more than 5 letters), D is the number of syllables,
+// G is the number of complex words (more than 4 syllables), and H is the number of faseeh words.
+// The calculated score is rounded to the first decimal point.
+func CalcOsman(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcOsmanDoc(newDocument(s, opts))
+}
+
+// CalcOsmanRaw accepts a non-empty string and options and returns the OSMAN readability score for it without rounding. See `CalcOsman`.
+func CalcOsmanRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return osmanRaw(newDocument(s, opts))
+}
+
+// CalcOsmanDoc accepts a Document and returns the OSMAN readability score for it. See `CalcOsman`.
+func CalcOsmanDoc(doc *stats.Document) (float64, error) {
+	score, err := osmanRaw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(score*10) / 10, nil
+}
+
+// osmanRaw returns the OSMAN readability score without rounding.
+func osmanRaw(doc *stats.Document) (float64, error) {
+	words := float64(doc.Words())
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate OSMAN readability score.")
+	}
+	sentences := float64(doc.Sentences())
+	if sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate OSMAN readability score.")
+	}
+
+	syllables := float64(doc.Syllables())
+	var hardWords, complexWords, faseehWords float64
+	for _, word := range doc.CountedWords() {
+		wordSyllables := doc.CountSyllables(word)
+		if len([]rune(StripDiacritics(word))) > 5 {
+			hardWords++
+		}
+		if wordSyllables > 4 {
+			complexWords++
+			if isFaseeh(word) {
+				faseehWords++
+			}
+		}
+	}
+
+	score := 200.791 - 1.015*(words/sentences) - 24.181*((hardWords+syllables+complexWords-faseehWords)/words)
+	return score, nil
+}
+
+// StripDiacritics accepts a string and returns it without Arabic diacritics (harakat, tanween, shadda, sukun, superscript alef) and without tatweel (kashida).
+func StripDiacritics(s string) string {
+	return strings.Map(func(c rune) rune {
+		if isDiacritic(c) || c == tatweel {
+			return -1
+		}
+		return c
+	}, s)
+}
+
+// CountSyllables accepts a string that represents an Arabic word and returns the estimated number of syllables in it.
+// If the word is vocalized, every short vowel mark (fatha, damma, kasra, tanween, superscript alef) starts a syllable.
+// Unvocalized words are estimated from the number of letters, as Arabic syllables are mostly CV and CVC: one syllable per two letters.
+// A word with no letters (for example, a number) counts as one syllable.
+func CountSyllables(s string) uint {
+	var vowelMarks, letters uint
+	for _, char := range s {
+		switch {
+		case isVowelMark(char):
+			vowelMarks++
+		case unicode.IsLetter(char) && !isDiacritic(char) && char != tatweel:
+			letters++
+		}
+	}
+
+	syllables := vowelMarks
+	if syllables == 0 {
+		syllables = (letters + 1) / 2
+	}
+	if syllables == 0 {
+		syllables++
+	}
+	return syllables
+}
+
+const tatweel = '\u0640'
+
+// isDiacritic reports whether the rune is an Arabic diacritic.
+func isDiacritic(char rune) bool {
+	return (char >= '\u064B' && char <= '\u0652') || char == '\u0670'
+}
+
+// isVowelMark reports whether the rune is an Arabic diacritic that marks a vowel.
+func isVowelMark(char rune) bool {
+	return (char >= '\u064B' && char <= '\u0650') || char == '\u0670'
+}
+
+// isFaseeh reports whether a complex word has the features of Faseeh (classical) Arabic:
+// it contains one of the letters ء, ئ, ؤ, ذ, ظ or ends with وا or ون.
+func isFaseeh(word string) bool {
+	stripped := StripDiacritics(word)
+	if strings.ContainsAny(stripped, "ءئؤذظ") {
+		return true
+	}
+	return strings.HasSuffix(stripped, "وا") || strings.HasSuffix(stripped, "ون")
+}
+
+// init registers `CountSyllables` as the syllable counter of Arabic texts (see `stats.RegisterSyllableCounter`).
+func init() {
+	stats.RegisterSyllableCounter("ar", stats.SyllableCounterFunc(CountSyllables))
+}
+
+// newDocument returns a Document for an Arabic text, with the given options applied over the language defaults.
+func newDocument(s string, opts []stats.Option) *stats.Document {
+	return stats.NewDocument(s, append([]stats.Option{stats.WithLanguage("ar")}, opts...)...)
+}
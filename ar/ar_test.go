@@ -0,0 +1,66 @@
+package ar_test
+
+import (
+	"goreadability/ar"
+	"testing"
+)
+
+func TestCountSyllables(t *testing.T) {
+	tests := []struct {
+		word string
+		want uint
+	}{
+		{"كَتَبَ", 3},    // every short vowel mark starts a syllable
+		{"كِتَابٌ", 3},   // the long vowel (fatha and alif) is one syllable, and so is the tanween
+		{"مُدَرِّسٌ", 4}, // the shadda and the sukun are not vowels
+		{"مَكْتَبَة", 3},
+		{"كتب", 2}, // an unvocalized word has a syllable per two letters
+		{"مدرسة", 3},
+		{"كـتـب", 2}, // the tatweel is not a letter
+		{"2024", 1},
+	}
+	for _, tt := range tests {
+		if got := ar.CountSyllables(tt.word); got != tt.want {
+			t.Errorf("CountSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestStripDiacritics(t *testing.T) {
+	tests := map[string]string{
+		"مُدَرِّسٌ": "مدرس",
+		"كـتـب":     "كتب",
+		"كتاب":      "كتاب",
+	}
+	for text, want := range tests {
+		if got := ar.StripDiacritics(text); got != want {
+			t.Errorf("StripDiacritics(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestCalcOsman(t *testing.T) {
+	tests := []struct {
+		text string
+		want float64
+	}{
+		// 4 words, 1 sentence, 11 syllables, and 1 hard word of more than 5 letters: 200.791 - 1.015 * 4 - 24.181 * (1 + 11) / 4 = 124.2.
+		{"ذهب الولد إلى المدرسة.", 124.2},
+		// A vocalized text of 2 words, 1 sentence, and 6 syllables, with the final vowel marks: 200.791 - 1.015 * 2 - 24.181 * 6 / 2 = 126.2.
+		{"كَتَبَ الوَلَدُ.", 126.2},
+		// 2 hard words, 8 syllables, and 1 complex word of more than 4 syllables: 200.791 - 1.015 * 2 - 24.181 * (2 + 8 + 1) / 2 = 65.8.
+		{"المستشفيات يعملون.", 65.8},
+		// The same with a faseeh complex word (with "ؤ" and ending with "ون"): 200.791 - 1.015 * 2 - 24.181 * (2 + 8 + 1 - 1) / 2 = 77.9.
+		{"المسؤولون يعملون.", 77.9},
+	}
+	for _, tt := range tests {
+		if got, err := ar.CalcOsman(tt.text); err != nil || got != tt.want {
+			t.Errorf("CalcOsman(%q) = %v, %v, want %v", tt.text, got, err, tt.want)
+		}
+	}
+	for _, text := range []string{"", "مرحبا"} {
+		if _, err := ar.CalcOsman(text); err == nil {
+			t.Errorf("CalcOsman(%q) returned no error", text)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+// Package `de` provides functions and types to calculate the readability for texts in German language.
+// 1. Tränkle–Bailer formulas (TB1 and TB2)
+package de
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+	"strings"
+)
+
+// CalcTraenkleBailer1 accepts a non-empty string and returns the first Tränkle–Bailer formula for it. The string must contain at least one word and at least one sentence.
+// The formula is 224.6814 - 79.8304 * (syllables / words) - 12.24032 * (words / sentences) - 1.292857 * (percentage of prepositions).
+// The calculated score is rounded to the first decimal point.
+func CalcTraenkleBailer1(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcTraenkleBailer1Doc(newDocument(s, opts))
+}
+
+// CalcTraenkleBailer1Raw accepts a non-empty string and options and returns the first Tränkle–Bailer formula for it without rounding. See `CalcTraenkleBailer1`.
+func CalcTraenkleBailer1Raw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return traenkleBailer1Raw(newDocument(s, opts))
+}
+
+// CalcTraenkleBailer1Doc accepts a Document and returns the first Tränkle–Bailer formula for it. See `CalcTraenkleBailer1`.
+func CalcTraenkleBailer1Doc(doc *stats.Document) (float64, error) {
+	score, err := traenkleBailer1Raw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(score*10) / 10, nil
+}
+
+// traenkleBailer1Raw returns the first Tränkle–Bailer formula without rounding.
+func traenkleBailer1Raw(doc *stats.Document) (float64, error) {
+	words := float64(doc.Words())
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate Tränkle–Bailer formula.")
+	}
+	sentences := float64(doc.Sentences())
+	if sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate Tränkle–Bailer formula.")
+	}
+	syllables := float64(doc.Syllables())
+	prepositionsPerc := float64(countFunctionWords(doc, prepositions)) / words * 100
+
+	score := 224.6814 - 79.8304*(syllables/words) - 12.24032*(words/sentences) - 1.292857*prepositionsPerc
+	return score, nil
+}
+
+// CalcTraenkleBailer2 accepts a non-empty string and returns the second Tränkle–Bailer formula for it. The string must contain at least one word.
+// The formula is 234.1063 - 96.11069 * (syllables / words) - 2.05444 * (percentage of prepositions) - 1.02805 * (percentage of conjunctions).
+// The calculated score is rounded to the first decimal point.
+func CalcTraenkleBailer2(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcTraenkleBailer2Doc(newDocument(s, opts))
+}
+
+// CalcTraenkleBailer2Raw accepts a non-empty string and options and returns the second Tränkle–Bailer formula for it without rounding. See `CalcTraenkleBailer2`.
+func CalcTraenkleBailer2Raw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return traenkleBailer2Raw(newDocument(s, opts))
+}
+
+// CalcTraenkleBailer2Doc accepts a Document and returns the second Tränkle–Bailer formula for it. See `CalcTraenkleBailer2`.
+func CalcTraenkleBailer2Doc(doc *stats.Document) (float64, error) {
+	score, err := traenkleBailer2Raw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(score*10) / 10, nil
+}
+
+// traenkleBailer2Raw returns the second Tränkle–Bailer formula without rounding.
+func traenkleBailer2Raw(doc *stats.Document) (float64, error) {
+	words := float64(doc.Words())
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate Tränkle–Bailer formula.")
+	}
+	syllables := float64(doc.Syllables())
+	prepositionsPerc := float64(countFunctionWords(doc, prepositions)) / words * 100
+	conjunctionsPerc := float64(countFunctionWords(doc, conjunctions)) / words * 100
+
+	score := 234.1063 - 96.11069*(syllables/words) - 2.05444*prepositionsPerc - 1.02805*conjunctionsPerc
+	return score, nil
+}
+
+// countFunctionWords accepts a Document and returns the number of its words that belong to the given word list. The words are split as they are counted (see `stats.Document.CountedWords`).
+func countFunctionWords(doc *stats.Document, list map[string]struct{}) uint {
+	var count uint
+	for _, word := range doc.CountedWords() {
+		if _, ok := list[strings.ToLower(word)]; ok {
+			count++
+		}
+	}
+	return count
+}
+
+// CountSyllables accepts a string that represents a German word and returns the number of syllables in it.
+// Every group of adjacent vowels is counted as one syllable, which covers the diphthongs ("ei", "au", "eu", "äu") and the long "ie".
+// A word without vowels (for example, a number) counts as one syllable.
+func CountSyllables(s string) uint {
+	var syllables uint
+	prevIsVowel := false
+	for _, char := range strings.ToLower(s) {
+		if isVowel(char) {
+			if !prevIsVowel {
+				syllables++
+			}
+			prevIsVowel = true
+		} else {
+			prevIsVowel = false
+		}
+	}
+
+	if syllables == 0 {
+		syllables++
+	}
+	return syllables
+}
+
+// isVowel reports whether the rune is a German vowel.
+func isVowel(char rune) bool {
+	return strings.ContainsRune("aeiouyäöü", char)
+}
+
+// prepositions lists German prepositions, including the contracted forms ("im", "zum").
+var prepositions = map[string]struct{}{
+	"ab":         {},
+	"abseits":    {},
+	"am":         {},
+	"an":         {},
+	"ans":        {},
+	"anstatt":    {},
+	"auf":        {},
+	"aufs":       {},
+	"aus":        {},
+	"außer":      {},
+	"außerhalb":  {},
+	"bei":        {},
+	"beim":       {},
+	"binnen":     {},
+	"bis":        {},
+	"dank":       {},
+	"durch":      {},
+	"durchs":     {},
+	"entlang":    {},
+	"entgegen":   {},
+	"gegen":      {},
+	"gegenüber":  {},
+	"gemäß":      {},
+	"hinter":     {},
+	"im":         {},
+	"in":         {},
+	"ins":        {},
+	"infolge":    {},
+	"innerhalb":  {},
+	"jenseits":   {},
+	"laut":       {},
+	"mit":        {},
+	"mittels":    {},
+	"nach":       {},
+	"neben":      {},
+	"oberhalb":   {},
+	"ohne":       {},
+	"seit":       {},
+	"statt":      {},
+	"trotz":      {},
+	"über":       {},
+	"übers":      {},
+	"um":         {},
+	"ums":        {},
+	"unter":      {},
+	"unterhalb":  {},
+	"von":        {},
+	"vom":        {},
+	"vor":        {},
+	"vors":       {},
+	"während":    {},
+	"wegen":      {},
+	"zu":         {},
+	"zum":        {},
+	"zur":        {},
+	"zufolge":    {},
+	"zwischen":   {},
+	"diesseits":  {},
+	"angesichts": {},
+}
+
+// conjunctions lists German coordinating and subordinating conjunctions.
+var conjunctions = map[string]struct{}{
+	"aber":       {},
+	"als":        {},
+	"also":       {},
+	"bevor":      {},
+	"bis":        {},
+	"da":         {},
+	"damit":      {},
+	"dass":       {},
+	"daß":        {},
+	"denn":       {},
+	"doch":       {},
+	"ehe":        {},
+	"falls":      {},
+	"indem":      {},
+	"jedoch":     {},
+	"nachdem":    {},
+	"ob":         {},
+	"obwohl":     {},
+	"obgleich":   {},
+	"oder":       {},
+	"seitdem":    {},
+	"sobald":     {},
+	"sodass":     {},
+	"solange":    {},
+	"sondern":    {},
+	"sowie":      {},
+	"sowohl":     {},
+	"und":        {},
+	"weil":       {},
+	"wenn":       {},
+	"wenngleich": {},
+	"weder":      {},
+	"noch":       {},
+	"wie":        {},
+	"wohingegen": {},
+	"während":    {},
+	"entweder":   {},
+}
+
+// init registers `CountSyllables` as the syllable counter of German texts (see `stats.RegisterSyllableCounter`).
+func init() {
+	stats.RegisterSyllableCounter("de", stats.SyllableCounterFunc(CountSyllables))
+}
+
+// newDocument returns a Document for a German text, with the given options applied over the language defaults.
+func newDocument(s string, opts []stats.Option) *stats.Document {
+	return stats.NewDocument(s, append([]stats.Option{stats.WithLanguage("de")}, opts...)...)
+}
@@ -0,0 +1,65 @@
+package de_test
+
+import (
+	"goreadability/de"
+	"testing"
+)
+
+func TestCountSyllables(t *testing.T) {
+	tests := []struct {
+		word string
+		want uint
+	}{
+		{"Hund", 1},
+		{"Garten", 2},
+		{"Liebe", 2}, // "ie", "ei", "eu", "au", and "äu" are one vowel each
+		{"Eis", 1},
+		{"heute", 2},
+		{"Haus", 1},
+		{"Häuser", 2},
+		{"Bäume", 2},
+		{"Mädchen", 2}, // umlauts are vowels
+		{"Tür", 1},
+		{"schön", 1},
+		{"Kaffee", 2},
+		{"Straße", 2},
+		{"Nord-Süd", 2},
+		{"2024", 1},
+	}
+	for _, tt := range tests {
+		if got := de.CountSyllables(tt.word); got != tt.want {
+			t.Errorf("CountSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestCalcTraenkleBailer(t *testing.T) {
+	tests := []struct {
+		text     string
+		tb1, tb2 float64
+	}{
+		// 11 words, 2 sentences, 14 syllables, 2 prepositions ("im", "auf"), and no conjunctions:
+		// TB1 = 224.6814 - 79.8304 * 14 / 11 - 12.24032 * 5.5 - 1.292857 * 18.18 = 32.3, TB2 = 234.1063 - 96.11069 * 14 / 11 - 2.05444 * 18.18 = 74.4.
+		{"Der Hund spielt im Garten. Die Katze schläft auf dem Sofa.", 32.3, 74.4},
+		// 8 words, 1 sentence, 9 syllables, no prepositions, and 2 conjunctions ("weil", "und"):
+		// TB1 = 224.6814 - 79.8304 * 1.125 - 12.24032 * 8 = 36.9, TB2 = 234.1063 - 96.11069 * 1.125 - 1.02805 * 25 = 100.3.
+		{"Er kommt, weil es regnet und kalt ist.", 36.9, 100.3},
+		// 10 words, 1 sentence, 14 syllables, 3 prepositions with the contracted ones ("Im", "zum", "ins"), and 1 conjunction ("aber"):
+		// TB1 = 224.6814 - 79.8304 * 1.4 - 12.24032 * 10 - 1.292857 * 30 = -48.3, TB2 = 234.1063 - 96.11069 * 1.4 - 2.05444 * 30 - 1.02805 * 10 = 27.6.
+		{"Im Sommer gehen wir zum See, aber nicht ins Kino.", -48.3, 27.6},
+	}
+	for _, tt := range tests {
+		if got, err := de.CalcTraenkleBailer1(tt.text); err != nil || got != tt.tb1 {
+			t.Errorf("CalcTraenkleBailer1(%q) = %v, %v, want %v", tt.text, got, err, tt.tb1)
+		}
+		if got, err := de.CalcTraenkleBailer2(tt.text); err != nil || got != tt.tb2 {
+			t.Errorf("CalcTraenkleBailer2(%q) = %v, %v, want %v", tt.text, got, err, tt.tb2)
+		}
+	}
+	if _, err := de.CalcTraenkleBailer1("Hallo"); err == nil {
+		t.Errorf("CalcTraenkleBailer1() without sentences returned no error")
+	}
+	if _, err := de.CalcTraenkleBailer2(""); err == nil {
+		t.Errorf("CalcTraenkleBailer2() of an empty string returned no error")
+	}
+}
@@ -5,11 +5,15 @@
 // 3. Dale–Chall readability (DCR) formula (https://en.wikipedia.org/wiki/Dale%E2%80%93Chall_readability_formula)
 // 4. Flesch reading ease score (FRES) (https://en.wikipedia.org/wiki/Flesch–Kincaid_readability_tests)
 // 5. Flesch-Kincaid grade level (FKG) (https://en.wikipedia.org/wiki/Flesch–Kincaid_readability_tests)
+// 6. SMOG grade (https://en.wikipedia.org/wiki/SMOG)
+// 7. Gunning fog index (https://en.wikipedia.org/wiki/Gunning_fog_index)
 package en
 
 import (
+	"encoding/json"
 	"errors"
 	"goreadability/stats"
+	"goreadability/wordlists"
 	"math"
 	"strings"
 	"unicode"
@@ -29,6 +33,43 @@ type AriResult struct {
 	gradeLevel string
 }
 
+// Score returns the ARI score.
+func (r AriResult) Score() int8 {
+	return r.score
+}
+
+// Age returns the age range of the readers.
+func (r AriResult) Age() string {
+	return r.age
+}
+
+// GradeLevel returns the grade level of the readers.
+func (r AriResult) GradeLevel() string {
+	return r.gradeLevel
+}
+
+// ariResultJSON is the JSON representation of AriResult.
+type ariResultJSON struct {
+	Score      int8   `json:"score"`
+	Age        string `json:"age"`
+	GradeLevel string `json:"grade_level"`
+}
+
+// MarshalJSON encodes the AriResult as a JSON object with "score", "age", and "grade_level" fields.
+func (r AriResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ariResultJSON{r.score, r.age, r.gradeLevel})
+}
+
+// UnmarshalJSON decodes the AriResult from a JSON object with "score", "age", and "grade_level" fields.
+func (r *AriResult) UnmarshalJSON(data []byte) error {
+	var decoded ariResultJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*r = AriResult{decoded.Score, decoded.Age, decoded.GradeLevel}
+	return nil
+}
+
 // ariTable maps the ARI score to AriResult.
 var ariTable = map[int]AriResult{
 	1: {
@@ -107,22 +148,47 @@ var ariTable = map[int]AriResult{
 
 // CalcAri accepts a non-empty string and returns the automated readability index (ARI) of it. The string has to have at least one word and at least one sentence (ended with `.`, `?`, `!`, or `...`)
 // The result is always rounded up to the nearest whole number.
-func CalcAri(s string) (int, error) {
+func CalcAri(s string, opts ...stats.Option) (int, error) {
 	if len(s) == 0 {
 		return 0, errors.New("Empty string.")
 	}
-	characters := float64(stats.CountCharacters(s))
-	words := float64(stats.CountWords(s))
-	sentences := float64(stats.CountSentences(s))
+	return CalcAriFromStats(stats.CountAllStats(s, opts...))
+}
+
+// CalcAriRaw accepts a non-empty string and options and returns the automated readability index (ARI) for it without rounding. See `CalcAri`.
+func CalcAriRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return ariRaw(stats.CountAllStats(s, opts...))
+}
+
+// CalcAriDoc accepts a Document and returns the automated readability index (ARI) for it. See `CalcAri`.
+func CalcAriDoc(doc *stats.Document) (int, error) {
+	return CalcAriFromStats(doc.Stats())
+}
+
+// CalcAriFromStats accepts the statistics of a text and returns the automated readability index (ARI) for it. See `CalcAri`.
+func CalcAriFromStats(st stats.TotalStats) (int, error) {
+	ariFloat, err := ariRaw(st)
+	if err != nil {
+		return 0, err
+	}
+	return int(math.Ceil(ariFloat)), nil
+}
+
+// ariRaw returns the automated readability index (ARI) without rounding.
+func ariRaw(st stats.TotalStats) (float64, error) {
+	characters := float64(st.Characters)
+	words := float64(st.Words)
+	sentences := float64(st.Sentences)
 
 	if words == 0 || sentences == 0 {
 		return 0, errors.New("No words or sentences were parsed. Cannot calculate automated readability index (ARI).")
 	}
 
 	ariFloat := 4.71*(characters/words) + 0.5*(words/sentences) - 21.43
-	// fmt.Println("Rough ARI:", ariFloat)
-	score := int(math.Ceil(ariFloat))
-	return score, nil
+	return ariFloat, nil
 }
 
 // CalcAriResult accepts an ARI score as integer and returns the AriResult structure mapped to the score.
@@ -139,98 +205,324 @@ func CalcAriResult(score int) AriResult {
 }
 
 // CalcCli accepts a non-empty string and returns the Coleman–Liau index (CLI) for it. The string must contain at least one word (a number is considered a word, for example `18.` is valid string) and at least one sentence.
+// As in the definition of Coleman and Liau, only letters are counted (see `stats.CountLetters`): 0.0588 * L - 0.296 * S - 15.8, where L is letters and S is sentences per 100 words.
+// Use `stats.WithDigitsAsLetters` to count the digits too, as the implementations based on characters (`stats.CountCharacters`) do; the difference shows on texts with many numbers.
 // The calculated CLI is rounded to the first decimal point.
-func CalcCli(s string) (float64, error) {
+func CalcCli(s string, opts ...stats.Option) (float64, error) {
 	if len(s) == 0 {
 		return 0, errors.New("Empty string.")
 	}
+	return CalcCliFromStats(stats.CountAllStats(s, opts...))
+}
+
+// CalcCliRaw accepts a non-empty string and options and returns the Coleman–Liau index (CLI) for it without rounding. See `CalcCli`.
+func CalcCliRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return cliRaw(stats.CountAllStats(s, opts...))
+}
 
-	characters := float64(stats.CountCharacters(s))
-	words := float64(stats.CountWords(s))
-	sentences := float64(stats.CountSentences(s))
+// CalcCliDoc accepts a Document and returns the Coleman–Liau index (CLI) for it. See `CalcCli`.
+func CalcCliDoc(doc *stats.Document) (float64, error) {
+	return CalcCliFromStats(doc.Stats())
+}
+
+// CalcCliFromStats accepts the statistics of a text and returns the Coleman–Liau index (CLI) for it. See `CalcCli`.
+func CalcCliFromStats(st stats.TotalStats) (float64, error) {
+	cli, err := cliRaw(st)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(cli*10) / 10, nil
+}
+
+// cliRaw returns the Coleman–Liau index (CLI) without rounding.
+func cliRaw(st stats.TotalStats) (float64, error) {
+	letters := float64(st.Letters)
+	words := float64(st.Words)
+	sentences := float64(st.Sentences)
 
 	if words == 0 {
 		return 0, errors.New("No words were parsed. Cannot calculate Coleman–Liau index (CLI).")
 	}
 
-	cli := 5.88*(characters/words) - 29.6*(sentences/words) - 15.8
-	cli = math.Round(cli*10) / 10
+	cli := 5.88*(letters/words) - 29.6*(sentences/words) - 15.8
 	return cli, nil
 }
 
-// CalcDCR accepts a non-empty string and returns the Dale–Chall readability (DCR) formula for it. The string must contain at least one word (a number is considered a word, for example `18.` is a valid string) and at least one sentence.
+// CalcDCR accepts a non-empty string and options and returns the Dale–Chall readability (DCR) formula for it. The string must contain at least one word (a number is considered a word, for example `18.` is a valid string) and at least one sentence.
 // The calculated DCR is rounded to the second decimal point.
-func CalcDCR(s string) (float64, error) {
+func CalcDCR(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcDCRFromStats(stats.CountAllStats(s, opts...), CountDifficultWords(s, opts...))
+}
+
+// CalcDCRRaw accepts a non-empty string and options and returns the Dale–Chall readability (DCR) formula for it without rounding. See `CalcDCR`.
+func CalcDCRRaw(s string, opts ...stats.Option) (float64, error) {
 	if len(s) == 0 {
 		return 0, errors.New("Empty string.")
 	}
+	return dcrRaw(stats.CountAllStats(s, opts...), CountDifficultWords(s, opts...))
+}
+
+// CalcDCRDoc accepts a Document and returns the Dale–Chall readability (DCR) formula for it. See `CalcDCR`.
+func CalcDCRDoc(doc *stats.Document) (float64, error) {
+	return CalcDCRFromStats(doc.Stats(), countDifficultWords(doc.Text(), doc.Config()))
+}
+
+// CalcDCRFromStats accepts the statistics of a text and the number of difficult words in it (see `CountDifficultWords`) and returns the Dale–Chall readability (DCR) formula for it. See `CalcDCR`.
+func CalcDCRFromStats(st stats.TotalStats, difficultWords uint) (float64, error) {
+	dcr, err := dcrRaw(st, difficultWords)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(dcr*100) / 100, nil
+}
 
-	words := float64(stats.CountWords(s))
+// dcrRaw returns the Dale–Chall readability (DCR) formula without rounding.
+func dcrRaw(st stats.TotalStats, difficultWords uint) (float64, error) {
+	words := float64(st.Words)
 	if words == 0 {
 		return 0, errors.New("No words were parsed. Cannot calculate Dale–Chall readability (DCR) formula.")
 	}
 
-	sentences := float64(stats.CountSentences(s))
+	sentences := float64(st.Sentences)
 	if sentences == 0 {
 		return 0, errors.New("No sentences were parsed. Cannot calculate Dale-Chall readability (DCR) formula.")
 	}
-	diffWords := float64(countDifficultWords(s))
+	diffWords := float64(difficultWords)
 	diffWordsPerc := diffWords / words * 100
 
 	dcr := 0.1579*diffWordsPerc + 0.0496*(words/sentences)
 	if diffWordsPerc > DIFF_WORDS_THRESHOLD {
 		dcr += ADJUSTED_SCORE
 	}
-	dcr = math.Round(dcr*100) / 100
 	return dcr, nil
 }
 
 // CalcFRES accepts a non-empty string and returns the Flesch reading ease score (FRES). The string must contain at least one word (a number is considered a word, for example `18.` is a valid string) and at least one sentence.
 // The calculated score is rounded to the first decimal point.
-func CalcFRES(s string) (float64, error) {
+func CalcFRES(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcFRESFromStats(stats.CountAllStats(s, opts...))
+}
+
+// CalcFRESRaw accepts a non-empty string and options and returns the Flesch reading ease score (FRES) for it without rounding. See `CalcFRES`.
+func CalcFRESRaw(s string, opts ...stats.Option) (float64, error) {
 	if len(s) == 0 {
 		return 0, errors.New("Empty string.")
 	}
+	return fresRaw(stats.CountAllStats(s, opts...))
+}
+
+// CalcFRESDoc accepts a Document and returns the Flesch reading ease score (FRES) for it. See `CalcFRES`.
+func CalcFRESDoc(doc *stats.Document) (float64, error) {
+	return CalcFRESFromStats(doc.Stats())
+}
+
+// CalcFRESFromStats accepts the statistics of a text and returns the Flesch reading ease score (FRES) for it. See `CalcFRES`.
+func CalcFRESFromStats(st stats.TotalStats) (float64, error) {
+	fre, err := fresRaw(st)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(fre*10) / 10, nil
+}
 
-	words := float64(stats.CountWords(s))
+// fresRaw returns the Flesch reading ease score (FRES) without rounding.
+func fresRaw(st stats.TotalStats) (float64, error) {
+	words := float64(st.Words)
 	if words == 0 {
 		return 0, errors.New("No words were parsed. Cannot calculate Flesch reading ease.")
 	}
-	sentences := float64(stats.CountSentences(s))
+	sentences := float64(st.Sentences)
 	if sentences == 0 {
 		return 0, errors.New("No sentences were parsed. Cannot calculate Flesch reading ease.")
 	}
-	syllables := float64(stats.CountSyllables(s))
+	syllables := float64(st.Syllables)
 
 	fre := 206.835 - 1.015*(words/sentences) - 84.6*(syllables/words)
-	fre = math.Round(fre*10) / 10
 	return fre, nil
 }
 
 // CalcFKG accepts a non-empty string and returns the Flesch-Kincaid grade level. The string must contain at least one word (a number is considered a word, for example `18.` is a valid string) and at least one sentence.
 // The calculated score is rounded to the first decimal point.
-func CalcFKG(s string) (float64, error) {
+func CalcFKG(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcFKGFromStats(stats.CountAllStats(s, opts...))
+}
+
+// CalcFKGRaw accepts a non-empty string and options and returns the Flesch-Kincaid grade level for it without rounding. See `CalcFKG`.
+func CalcFKGRaw(s string, opts ...stats.Option) (float64, error) {
 	if len(s) == 0 {
 		return 0, errors.New("Empty string.")
 	}
+	return fkgRaw(stats.CountAllStats(s, opts...))
+}
 
-	words := float64(stats.CountWords(s))
+// CalcFKGDoc accepts a Document and returns the Flesch-Kincaid grade level for it. See `CalcFKG`.
+func CalcFKGDoc(doc *stats.Document) (float64, error) {
+	return CalcFKGFromStats(doc.Stats())
+}
+
+// CalcFKGFromStats accepts the statistics of a text and returns the Flesch-Kincaid grade level for it. See `CalcFKG`.
+func CalcFKGFromStats(st stats.TotalStats) (float64, error) {
+	fkg, err := fkgRaw(st)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(fkg*10) / 10, nil
+}
+
+// fkgRaw returns the Flesch-Kincaid grade level without rounding.
+func fkgRaw(st stats.TotalStats) (float64, error) {
+	words := float64(st.Words)
 	if words == 0 {
 		return 0, errors.New("No words were parsed. Cannot calculate Flesch-Kincaid grade level.")
 	}
-	sentences := float64(stats.CountSentences(s))
+	sentences := float64(st.Sentences)
 	if sentences == 0 {
 		return 0, errors.New("No sentences were parsed. Cannot calculate Flesch-Kincaid grade level.")
 	}
-	syllables := float64(stats.CountSyllables(s))
+	syllables := float64(st.Syllables)
 	fkg := 0.39*(words/sentences) + 11.8*(syllables/words) - 15.59
-	fkg = math.Round(fkg*10) / 10
 	return fkg, nil
 }
 
-// countDifficultWords accepts a string and returns the number of difficult words for Dale-Chall readability formula.
-// A word is counted as a difficult one if it isn't in `easyWords` map.
-func countDifficultWords(s string) uint {
+// CalcSMOG accepts a non-empty string and returns the SMOG grade for it. The string must contain at least one sentence.
+// The formula is 1.0430 * sqrt(polysyllables * 30 / sentences) + 3.1291, where polysyllables are words of 3 or more syllables.
+// The calculated grade is rounded to the first decimal point.
+func CalcSMOG(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcSMOGFromStats(stats.CountAllStats(s, opts...))
+}
+
+// CalcSMOGRaw accepts a non-empty string and options and returns the SMOG grade for it without rounding. See `CalcSMOG`.
+func CalcSMOGRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return smogRaw(stats.CountAllStats(s, opts...))
+}
+
+// CalcSMOGDoc accepts a Document and returns the SMOG grade for it. See `CalcSMOG`.
+func CalcSMOGDoc(doc *stats.Document) (float64, error) {
+	return CalcSMOGFromStats(doc.Stats())
+}
+
+// CalcSMOGFromStats accepts the statistics of a text and returns the SMOG grade for it. See `CalcSMOG`.
+func CalcSMOGFromStats(st stats.TotalStats) (float64, error) {
+	smog, err := smogRaw(st)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(smog*10) / 10, nil
+}
+
+// smogRaw returns the SMOG grade without rounding.
+func smogRaw(st stats.TotalStats) (float64, error) {
+	sentences := float64(st.Sentences)
+	if sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate SMOG grade.")
+	}
+	polysyllables := float64(st.Polysyllables)
+
+	smog := 1.0430*math.Sqrt(polysyllables*30/sentences) + 3.1291
+	return smog, nil
+}
+
+// CalcGunningFog accepts a non-empty string and returns the Gunning fog index for it. The string must contain at least one word and at least one sentence.
+// The formula is 0.4 * ((words / sentences) + 100 * (complex words / words)), where complex words are words of 3 or more syllables.
+// The calculated index is rounded to the first decimal point.
+func CalcGunningFog(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcGunningFogFromStats(stats.CountAllStats(s, opts...))
+}
+
+// CalcGunningFogRaw accepts a non-empty string and options and returns the Gunning fog index for it without rounding. See `CalcGunningFog`.
+func CalcGunningFogRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return gunningFogRaw(stats.CountAllStats(s, opts...))
+}
+
+// CalcGunningFogDoc accepts a Document and returns the Gunning fog index for it. See `CalcGunningFog`.
+func CalcGunningFogDoc(doc *stats.Document) (float64, error) {
+	return CalcGunningFogFromStats(doc.Stats())
+}
+
+// CalcGunningFogFromStats accepts the statistics of a text and returns the Gunning fog index for it. See `CalcGunningFog`.
+func CalcGunningFogFromStats(st stats.TotalStats) (float64, error) {
+	fog, err := gunningFogRaw(st)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(fog*10) / 10, nil
+}
+
+// gunningFogRaw returns the Gunning fog index without rounding.
+func gunningFogRaw(st stats.TotalStats) (float64, error) {
+	words := float64(st.Words)
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate Gunning fog index.")
+	}
+	sentences := float64(st.Sentences)
+	if sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate Gunning fog index.")
+	}
+	complexWords := float64(st.Polysyllables)
+
+	fog := 0.4 * ((words / sentences) + 100*(complexWords/words))
+	return fog, nil
+}
+
+// CalcDifficultWords accepts a non-empty string and options and returns the percentage of difficult words in it, that is words not found in the Dale–Chall list of familiar words
+// or in the configured familiar words (see `CountDifficultWords`). The string must contain at least one word.
+// The calculated percentage is rounded to the second decimal point.
+func CalcDifficultWords(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+
+	words := float64(stats.CountWords(s, opts...))
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate the percentage of difficult words.")
+	}
+	diffWordsPerc := float64(CountDifficultWords(s, opts...)) / words * 100
+	diffWordsPerc = math.Round(diffWordsPerc*100) / 100
+	return diffWordsPerc, nil
+}
+
+// CountDifficultWords accepts a string and options and returns the number of difficult words for Dale-Chall readability formula.
+// A word is counted as a difficult one if it isn't in the Dale–Chall list of familiar words (see `wordlists.IsFamiliar`),
+// or, if familiar words are configured (see `stats.WithFamiliarWords`), if it isn't familiar according to them.
+// If a lemmatizer is configured (see `stats.WithStemmer`), a word is also familiar if its stem is the stem of a word in the Dale–Chall list, so "walked" matches "walk".
+// The configured familiar words are used as they are: a `wordlists.WordList` with a stemmer matches the forms of its words itself.
+func CountDifficultWords(s string, opts ...stats.Option) uint {
+	return countDifficultWords(s, stats.NewConfig(opts...))
+}
+
+// countDifficultWords returns the number of difficult words of the string counted with the config. See `CountDifficultWords`.
+func countDifficultWords(s string, cfg stats.Config) uint {
+	isFamiliar := wordlists.IsFamiliar
+	switch {
+	case cfg.FamiliarWords != nil:
+		isFamiliar = cfg.FamiliarWords
+	case cfg.Lemmatizer != nil:
+		isFamiliar = stemmedFamiliar(cfg.Lemmatizer)
+	}
 	cleanedStr := cleanPossesives(s)
 
 	extractWord := func(c rune) bool {
@@ -241,2957 +533,30 @@ func countDifficultWords(s string) uint {
 	var difficultWords uint
 
 	for _, word := range words {
-		if _, ok := easyWords[strings.ToLower(word)]; !ok {
+		if !isFamiliar(word) {
 			difficultWords++
 		}
 	}
 	return difficultWords
 }
 
+// stemmedFamiliar returns the function that reports whether a word is in the Dale–Chall list of familiar words by itself or by its lemma (see `stats.WithLemmatizer`).
+func stemmedFamiliar(lemmatize func(word string) string) func(word string) bool {
+	list := wordlists.DaleChall(stats.StemmerFunc(lemmatize))
+	return func(word string) bool {
+		return wordlists.IsFamiliar(word) || list.Contains(word)
+	}
+}
+
+// IsFamiliarWord accepts a word and reports whether it is in the Dale–Chall list of familiar words. The check is case-insensitive, and possessives ("boy's") are familiar if the word is.
+// It can be passed to `stats.WithFamiliarWords`. It is the same as `wordlists.IsFamiliar`.
+func IsFamiliarWord(word string) bool {
+	return wordlists.IsFamiliar(word)
+}
+
 // cleanPossesives accepts a string and removes the possesives (suffixes "’s", "s'") from it.
 func cleanPossesives(s string) string {
 	cleanedStr := strings.ReplaceAll(s, "’s", "")
 	cleanedStr = strings.ReplaceAll(cleanedStr, "s'", "")
 	return cleanedStr
 }
-
-// The map represents the words considered easy ones for Dale-Chall readability formula.
-// TODO: add sillable numbers.
-var easyWords = map[string]uint{
-	"a":                  0,
-	"able":               0,
-	"aboard":             0,
-	"about":              0,
-	"above":              0,
-	"absent":             0,
-	"accept":             0,
-	"accident":           0,
-	"account":            0,
-	"ache":               0,
-	"aching":             0,
-	"acorn":              0,
-	"acre":               0,
-	"across":             0,
-	"act":                0,
-	"acts":               0,
-	"add":                0,
-	"address":            0,
-	"admire":             0,
-	"adventure":          0,
-	"afar":               0,
-	"afraid":             0,
-	"after":              0,
-	"afternoon":          0,
-	"afterward":          0,
-	"afterwards":         0,
-	"again":              0,
-	"against":            0,
-	"age":                0,
-	"aged":               0,
-	"ago":                0,
-	"agree":              0,
-	"ah":                 0,
-	"ahead":              0,
-	"aid":                0,
-	"aim":                0,
-	"air":                0,
-	"airfield":           0,
-	"airplane":           0,
-	"airport":            0,
-	"airship":            0,
-	"airy":               0,
-	"alarm":              0,
-	"alike":              0,
-	"alive":              0,
-	"all":                0,
-	"alley":              0,
-	"alligator":          0,
-	"allow":              0,
-	"almost":             0,
-	"alone":              0,
-	"along":              0,
-	"aloud":              0,
-	"already":            0,
-	"also":               0,
-	"always":             0,
-	"am":                 0,
-	"america":            0,
-	"american":           0,
-	"among":              0,
-	"amount":             0,
-	"an":                 0,
-	"and":                0,
-	"angel":              0,
-	"anger":              0,
-	"angry":              0,
-	"animal":             0,
-	"another":            0,
-	"answer":             0,
-	"ant":                0,
-	"any":                0,
-	"anybody":            0,
-	"anyhow":             0,
-	"anyone":             0,
-	"anything":           0,
-	"anyway":             0,
-	"anywhere":           0,
-	"apart":              0,
-	"apartment":          0,
-	"ape":                0,
-	"apiece":             0,
-	"appear":             0,
-	"apple":              0,
-	"april":              0,
-	"apron":              0,
-	"are":                0,
-	"aren't":             0,
-	"arise":              0,
-	"arithmetic":         0,
-	"arm":                0,
-	"armful":             0,
-	"army":               0,
-	"arose":              0,
-	"around":             0,
-	"arrange":            0,
-	"arrive":             0,
-	"arrived":            0,
-	"arrow":              0,
-	"art":                0,
-	"artist":             0,
-	"as":                 0,
-	"ash":                0,
-	"ashes":              0,
-	"aside":              0,
-	"ask":                0,
-	"asleep":             0,
-	"at":                 0,
-	"ate":                0,
-	"attack":             0,
-	"attend":             0,
-	"attention":          0,
-	"august":             0,
-	"aunt":               0,
-	"author":             0,
-	"auto":               0,
-	"automobile":         0,
-	"autumn":             0,
-	"avenue":             0,
-	"awake":              0,
-	"awaken":             0,
-	"away":               0,
-	"awful":              0,
-	"awfully":            0,
-	"awhile":             0,
-	"ax":                 0,
-	"axe":                0,
-	"baa":                0,
-	"babe":               0,
-	"babies":             0,
-	"back":               0,
-	"background":         0,
-	"backward":           0,
-	"backwards":          0,
-	"bacon":              0,
-	"bad":                0,
-	"badge":              0,
-	"badly":              0,
-	"bag":                0,
-	"bake":               0,
-	"baker":              0,
-	"bakery":             0,
-	"baking":             0,
-	"ball":               0,
-	"balloon":            0,
-	"banana":             0,
-	"band":               0,
-	"bandage":            0,
-	"bang":               0,
-	"banjo":              0,
-	"bank":               0,
-	"banker":             0,
-	"bar":                0,
-	"barber":             0,
-	"bare":               0,
-	"barefoot":           0,
-	"barely":             0,
-	"bark":               0,
-	"barn":               0,
-	"barrel":             0,
-	"base":               0,
-	"baseball":           0,
-	"basement":           0,
-	"basket":             0,
-	"bat":                0,
-	"batch":              0,
-	"bath":               0,
-	"bathe":              0,
-	"bathing":            0,
-	"bathroom":           0,
-	"bathtub":            0,
-	"battle":             0,
-	"battleship":         0,
-	"bay":                0,
-	"be":                 0,
-	"beach":              0,
-	"bead":               0,
-	"beam":               0,
-	"bean":               0,
-	"bear":               0,
-	"beard":              0,
-	"beast":              0,
-	"beat":               0,
-	"beating":            0,
-	"beautiful":          0,
-	"beautify":           0,
-	"beauty":             0,
-	"became":             0,
-	"because":            0,
-	"become":             0,
-	"becoming":           0,
-	"bed":                0,
-	"bedbug":             0,
-	"bedroom":            0,
-	"bedspread":          0,
-	"bedtime":            0,
-	"bee":                0,
-	"beech":              0,
-	"beef":               0,
-	"beefsteak":          0,
-	"beehive":            0,
-	"been":               0,
-	"beer":               0,
-	"beet":               0,
-	"before":             0,
-	"beg":                0,
-	"began":              0,
-	"beggar":             0,
-	"begged":             0,
-	"begin":              0,
-	"beginning":          0,
-	"begun":              0,
-	"behave":             0,
-	"behind":             0,
-	"being":              0,
-	"believe":            0,
-	"bell":               0,
-	"belong":             0,
-	"below":              0,
-	"belt":               0,
-	"bench":              0,
-	"bend":               0,
-	"beneath":            0,
-	"bent":               0,
-	"berries":            0,
-	"berry":              0,
-	"beside":             0,
-	"besides":            0,
-	"best":               0,
-	"bet":                0,
-	"better":             0,
-	"between":            0,
-	"bib":                0,
-	"bible":              0,
-	"bicycle":            0,
-	"bid":                0,
-	"big":                0,
-	"bigger":             0,
-	"bill":               0,
-	"billboard":          0,
-	"bin":                0,
-	"bind":               0,
-	"bird":               0,
-	"birth":              0,
-	"birthday":           0,
-	"biscuit":            0,
-	"bit":                0,
-	"bite":               0,
-	"biting":             0,
-	"bitter":             0,
-	"black":              0,
-	"blackberry":         0,
-	"blackbird":          0,
-	"blackboard":         0,
-	"blackness":          0,
-	"blacksmith":         0,
-	"blame":              0,
-	"blank":              0,
-	"blanket":            0,
-	"blast":              0,
-	"blaze":              0,
-	"bleed":              0,
-	"bless":              0,
-	"blessing":           0,
-	"blew":               0,
-	"blind":              0,
-	"blindfold":          0,
-	"blinds":             0,
-	"block":              0,
-	"blood":              0,
-	"bloom":              0,
-	"blossom":            0,
-	"blot":               0,
-	"blow":               0,
-	"blue":               0,
-	"blueberry":          0,
-	"bluebird":           0,
-	"blush":              0,
-	"board":              0,
-	"boast":              0,
-	"boat":               0,
-	"bob":                0,
-	"bobwhite":           0,
-	"bodies":             0,
-	"body":               0,
-	"boil":               0,
-	"boiler":             0,
-	"bold":               0,
-	"bone":               0,
-	"bonnet":             0,
-	"boo":                0,
-	"book":               0,
-	"bookcase":           0,
-	"bookkeeper":         0,
-	"boom":               0,
-	"boot":               0,
-	"born":               0,
-	"borrow":             0,
-	"boss":               0,
-	"both":               0,
-	"bother":             0,
-	"bottle":             0,
-	"bottom":             0,
-	"bought":             0,
-	"bounce":             0,
-	"bow":                0,
-	"bowl":               0,
-	"bow-wow":            0,
-	"box":                0,
-	"boxcar":             0,
-	"boxer":              0,
-	"boxes":              0,
-	"boy":                0,
-	"boyhood":            0,
-	"bracelet":           0,
-	"brain":              0,
-	"brake":              0,
-	"bran":               0,
-	"branch":             0,
-	"brass":              0,
-	"brave":              0,
-	"bread":              0,
-	"break":              0,
-	"breakfast":          0,
-	"breast":             0,
-	"breath":             0,
-	"breathe":            0,
-	"breeze":             0,
-	"brick":              0,
-	"bride":              0,
-	"bridge":             0,
-	"bright":             0,
-	"brightness":         0,
-	"bring":              0,
-	"broad":              0,
-	"broadcast":          0,
-	"broke":              0,
-	"broken":             0,
-	"brook":              0,
-	"broom":              0,
-	"brother":            0,
-	"brought":            0,
-	"brown":              0,
-	"brush":              0,
-	"bubble":             0,
-	"bucket":             0,
-	"buckle":             0,
-	"bud":                0,
-	"buffalo":            0,
-	"bug":                0,
-	"buggy":              0,
-	"build":              0,
-	"building":           0,
-	"built":              0,
-	"bulb":               0,
-	"bull":               0,
-	"bullet":             0,
-	"bum":                0,
-	"bumblebee":          0,
-	"bump":               0,
-	"bun":                0,
-	"bunch":              0,
-	"bundle":             0,
-	"bunny":              0,
-	"burn":               0,
-	"burst":              0,
-	"bury":               0,
-	"bus":                0,
-	"bush":               0,
-	"bushel":             0,
-	"business":           0,
-	"busy":               0,
-	"but":                0,
-	"butcher":            0,
-	"butt":               0,
-	"butter":             0,
-	"buttercup":          0,
-	"butterfly":          0,
-	"buttermilk":         0,
-	"butterscotch":       0,
-	"button":             0,
-	"buttonhole":         0,
-	"buy":                0,
-	"buzz":               0,
-	"by":                 0,
-	"bye":                0,
-	"cab":                0,
-	"cabbage":            0,
-	"cabin":              0,
-	"cabinet":            0,
-	"cackle":             0,
-	"cage":               0,
-	"cake":               0,
-	"calendar":           0,
-	"calf":               0,
-	"call":               0,
-	"caller":             0,
-	"calling":            0,
-	"came":               0,
-	"camel":              0,
-	"camp":               0,
-	"campfire":           0,
-	"can":                0,
-	"canal":              0,
-	"canary":             0,
-	"candle":             0,
-	"candlestick":        0,
-	"candy":              0,
-	"cane":               0,
-	"cannon":             0,
-	"cannot":             0,
-	"canoe":              0,
-	"can't":              0,
-	"canyon":             0,
-	"cap":                0,
-	"cape":               0,
-	"capital":            0,
-	"captain":            0,
-	"car":                0,
-	"card":               0,
-	"cardboard":          0,
-	"care":               0,
-	"careful":            0,
-	"careless":           0,
-	"carelessness":       0,
-	"carload":            0,
-	"carpenter":          0,
-	"carpet":             0,
-	"carriage":           0,
-	"carrot":             0,
-	"carry":              0,
-	"cart":               0,
-	"carve":              0,
-	"case":               0,
-	"cash":               0,
-	"cashier":            0,
-	"castle":             0,
-	"cat":                0,
-	"catbird":            0,
-	"catch":              0,
-	"catcher":            0,
-	"caterpillar":        0,
-	"catfish":            0,
-	"catsup":             0,
-	"cattle":             0,
-	"caught":             0,
-	"cause":              0,
-	"cave":               0,
-	"ceiling":            0,
-	"cell":               0,
-	"cellar":             0,
-	"cent":               0,
-	"center":             0,
-	"cereal":             0,
-	"certain":            0,
-	"certainly":          0,
-	"chain":              0,
-	"chair":              0,
-	"chalk":              0,
-	"champion":           0,
-	"chance":             0,
-	"change":             0,
-	"chap":               0,
-	"charge":             0,
-	"charm":              0,
-	"chart":              0,
-	"chase":              0,
-	"chatter":            0,
-	"cheap":              0,
-	"cheat":              0,
-	"check":              0,
-	"checkers":           0,
-	"cheek":              0,
-	"cheer":              0,
-	"cheese":             0,
-	"cherryhest":         0,
-	"chew":               0,
-	"chick":              0,
-	"chicken":            0,
-	"chief":              0,
-	"child":              0,
-	"childhood":          0,
-	"children":           0,
-	"chill":              0,
-	"chilly":             0,
-	"chimney":            0,
-	"chin":               0,
-	"china":              0,
-	"chip":               0,
-	"chipmunk":           0,
-	"chocolate":          0,
-	"choice":             0,
-	"choose":             0,
-	"chop":               0,
-	"chorus":             0,
-	"chose":              0,
-	"chosen":             0,
-	"christen":           0,
-	"christmas":          0,
-	"church":             0,
-	"churn":              0,
-	"cigarette":          0,
-	"circle":             0,
-	"circus":             0,
-	"citizen":            0,
-	"city":               0,
-	"clang":              0,
-	"clap":               0,
-	"class":              0,
-	"classmate":          0,
-	"classroom":          0,
-	"claw":               0,
-	"clay":               0,
-	"clean":              0,
-	"cleaner":            0,
-	"clear":              0,
-	"clerk":              0,
-	"clever":             0,
-	"click":              0,
-	"cliff":              0,
-	"climb":              0,
-	"clip":               0,
-	"cloak":              0,
-	"clock":              0,
-	"close":              0,
-	"closet":             0,
-	"cloth":              0,
-	"clothes":            0,
-	"clothing":           0,
-	"cloud":              0,
-	"cloudy":             0,
-	"clover":             0,
-	"clown":              0,
-	"club":               0,
-	"cluck":              0,
-	"clump":              0,
-	"coach":              0,
-	"coal":               0,
-	"coast":              0,
-	"coat":               0,
-	"cob":                0,
-	"cobbler":            0,
-	"cocoa":              0,
-	"coconut":            0,
-	"cocoon":             0,
-	"cod":                0,
-	"codfish":            0,
-	"coffee":             0,
-	"coffeepot":          0,
-	"coin":               0,
-	"cold":               0,
-	"collar":             0,
-	"college":            0,
-	"color":              0,
-	"colored":            0,
-	"colt":               0,
-	"column":             0,
-	"comb":               0,
-	"come":               0,
-	"comfort":            0,
-	"comic":              0,
-	"coming":             0,
-	"company":            0,
-	"compare":            0,
-	"conductor":          0,
-	"cone":               0,
-	"connect":            0,
-	"coo":                0,
-	"cook":               0,
-	"cooked":             0,
-	"cooking":            0,
-	"cookie":             0,
-	"cookies":            0,
-	"cool":               0,
-	"cooler":             0,
-	"coop":               0,
-	"copper":             0,
-	"copy":               0,
-	"cord":               0,
-	"cork":               0,
-	"corn":               0,
-	"corner":             0,
-	"correct":            0,
-	"cost":               0,
-	"cot":                0,
-	"cottage":            0,
-	"cotton":             0,
-	"couch":              0,
-	"cough":              0,
-	"could":              0,
-	"couldn't":           0,
-	"count":              0,
-	"counter":            0,
-	"country":            0,
-	"county":             0,
-	"course":             0,
-	"court":              0,
-	"cousin":             0,
-	"cover":              0,
-	"cow":                0,
-	"coward":             0,
-	"cowardly":           0,
-	"cowboy":             0,
-	"cozy":               0,
-	"crab":               0,
-	"crack":              0,
-	"cracker":            0,
-	"cradle":             0,
-	"cramps":             0,
-	"cranberry":          0,
-	"crank":              0,
-	"cranky":             0,
-	"crash":              0,
-	"crawl":              0,
-	"crazy":              0,
-	"cream":              0,
-	"creamy":             0,
-	"creek":              0,
-	"creep":              0,
-	"crept":              0,
-	"cried":              0,
-	"croak":              0,
-	"crook":              0,
-	"crooked":            0,
-	"crop":               0,
-	"cross":              0,
-	"crossing":           0,
-	"cross-eyed":         0,
-	"crow":               0,
-	"crowd":              0,
-	"crowded":            0,
-	"crown":              0,
-	"cruel":              0,
-	"crumb":              0,
-	"crumble":            0,
-	"crush":              0,
-	"crust":              0,
-	"cry":                0,
-	"cries":              0,
-	"cub":                0,
-	"cuff":               0,
-	"cup":                0,
-	"cupboard":           0,
-	"cupful":             0,
-	"cure":               0,
-	"curl":               0,
-	"curly":              0,
-	"curtain":            0,
-	"curve":              0,
-	"cushion":            0,
-	"custard":            0,
-	"customer":           0,
-	"cut":                0,
-	"cute":               0,
-	"cutting":            0,
-	"dab":                0,
-	"dad":                0,
-	"daddy":              0,
-	"daily":              0,
-	"dairy":              0,
-	"daisy":              0,
-	"dam":                0,
-	"damage":             0,
-	"dame":               0,
-	"damp":               0,
-	"dance":              0,
-	"dancer":             0,
-	"dancing":            0,
-	"dandy":              0,
-	"danger":             0,
-	"dangerous":          0,
-	"dare":               0,
-	"dark":               0,
-	"darkness":           0,
-	"darling":            0,
-	"darn":               0,
-	"dart":               0,
-	"dash":               0,
-	"date":               0,
-	"daughter":           0,
-	"dawn":               0,
-	"day":                0,
-	"daybreak":           0,
-	"daytime":            0,
-	"dead":               0,
-	"deaf":               0,
-	"deal":               0,
-	"dear":               0,
-	"death":              0,
-	"december":           0,
-	"decide":             0,
-	"deck":               0,
-	"deed":               0,
-	"deep":               0,
-	"deer":               0,
-	"defeat":             0,
-	"defend":             0,
-	"defense":            0,
-	"delight":            0,
-	"den":                0,
-	"dentist":            0,
-	"depend":             0,
-	"deposit":            0,
-	"describe":           0,
-	"desert":             0,
-	"deserve":            0,
-	"desire":             0,
-	"desk":               0,
-	"destroy":            0,
-	"devil":              0,
-	"dew":                0,
-	"diamond":            0,
-	"did":                0,
-	"didn't":             0,
-	"die":                0,
-	"died":               0,
-	"dies":               0,
-	"difference":         0,
-	"different":          0,
-	"dig":                0,
-	"dim":                0,
-	"dime":               0,
-	"dine":               0,
-	"ding-dong":          0,
-	"dinner":             0,
-	"dip":                0,
-	"direct":             0,
-	"direction":          0,
-	"dirt":               0,
-	"dirty":              0,
-	"discover":           0,
-	"dish":               0,
-	"dislike":            0,
-	"dismiss":            0,
-	"ditch":              0,
-	"dive":               0,
-	"diver":              0,
-	"divide":             0,
-	"do":                 0,
-	"dock":               0,
-	"doctor":             0,
-	"does":               0,
-	"doesn't":            0,
-	"dog":                0,
-	"doll":               0,
-	"dollar":             0,
-	"dolly":              0,
-	"done":               0,
-	"donkey":             0,
-	"don't":              0,
-	"door":               0,
-	"doorbell":           0,
-	"doorknob":           0,
-	"doorstep":           0,
-	"dopedot":            0,
-	"double":             0,
-	"dough":              0,
-	"dove":               0,
-	"down":               0,
-	"downstairs":         0,
-	"downtown":           0,
-	"dozen":              0,
-	"drag":               0,
-	"drain":              0,
-	"drank":              0,
-	"draw":               0,
-	"drawer":             0,
-	"drawing":            0,
-	"dream":              0,
-	"dress":              0,
-	"dresser":            0,
-	"dressmaker":         0,
-	"drew":               0,
-	"dried":              0,
-	"drift":              0,
-	"drill":              0,
-	"drink":              0,
-	"drip":               0,
-	"drive":              0,
-	"driven":             0,
-	"driver":             0,
-	"drop":               0,
-	"drove":              0,
-	"drown":              0,
-	"drowsy":             0,
-	"drub":               0,
-	"drum":               0,
-	"drunk":              0,
-	"dry":                0,
-	"duck":               0,
-	"due":                0,
-	"dug":                0,
-	"dull":               0,
-	"dumb":               0,
-	"dump":               0,
-	"during":             0,
-	"dust":               0,
-	"dusty":              0,
-	"duty":               0,
-	"dwarf":              0,
-	"dwell":              0,
-	"dwelt":              0,
-	"dying":              0,
-	"each":               0,
-	"eager":              0,
-	"eagle":              0,
-	"ear":                0,
-	"early":              0,
-	"earn":               0,
-	"earth":              0,
-	"east":               0,
-	"eastern":            0,
-	"easy":               0,
-	"eat":                0,
-	"eaten":              0,
-	"edge":               0,
-	"egg":                0,
-	"eh":                 0,
-	"eight":              0,
-	"eighteen":           0,
-	"eighth":             0,
-	"eighty":             0,
-	"either":             0,
-	"elbow":              0,
-	"elder":              0,
-	"eldest":             0,
-	"electric":           0,
-	"electricity":        0,
-	"elephant":           0,
-	"eleven":             0,
-	"elf":                0,
-	"elm":                0,
-	"else":               0,
-	"elsewhere":          0,
-	"empty":              0,
-	"end":                0,
-	"ending":             0,
-	"enemy":              0,
-	"engine":             0,
-	"engineer":           0,
-	"english":            0,
-	"enjoy":              0,
-	"enough":             0,
-	"enter":              0,
-	"envelope":           0,
-	"equal":              0,
-	"erase":              0,
-	"eraser":             0,
-	"errand":             0,
-	"escape":             0,
-	"eveeven":            0,
-	"evening":            0,
-	"ever":               0,
-	"every":              0,
-	"everybody":          0,
-	"everyday":           0,
-	"everyone":           0,
-	"everything":         0,
-	"everywhere":         0,
-	"evil":               0,
-	"exact":              0,
-	"except":             0,
-	"exchange":           0,
-	"excited":            0,
-	"exciting":           0,
-	"excuse":             0,
-	"exit":               0,
-	"expect":             0,
-	"explain":            0,
-	"extra":              0,
-	"eye":                0,
-	"eyebrow":            0,
-	"fable":              0,
-	"face":               0,
-	"facing":             0,
-	"fact":               0,
-	"factory":            0,
-	"fail":               0,
-	"faint":              0,
-	"fair":               0,
-	"fairy":              0,
-	"faith":              0,
-	"fake":               0,
-	"fall":               0,
-	"false":              0,
-	"family":             0,
-	"fan":                0,
-	"fancy":              0,
-	"far":                0,
-	"faraway":            0,
-	"fare":               0,
-	"farmer":             0,
-	"farm":               0,
-	"farming":            0,
-	"far-off":            0,
-	"farther":            0,
-	"fashion":            0,
-	"fast":               0,
-	"fasten":             0,
-	"fat":                0,
-	"father":             0,
-	"fault":              0,
-	"favor":              0,
-	"favorite":           0,
-	"fear":               0,
-	"feast":              0,
-	"feather":            0,
-	"february":           0,
-	"fed":                0,
-	"feed":               0,
-	"feel":               0,
-	"feet":               0,
-	"fell":               0,
-	"fellow":             0,
-	"felt":               0,
-	"fence":              0,
-	"fever":              0,
-	"few":                0,
-	"fib":                0,
-	"fiddle":             0,
-	"field":              0,
-	"fife":               0,
-	"fifteen":            0,
-	"fifth":              0,
-	"fifty":              0,
-	"fig":                0,
-	"fight":              0,
-	"figure":             0,
-	"file":               0,
-	"fill":               0,
-	"film":               0,
-	"finally":            0,
-	"find":               0,
-	"fine":               0,
-	"finger":             0,
-	"finish":             0,
-	"fire":               0,
-	"firearm":            0,
-	"firecracker":        0,
-	"fireplace":          0,
-	"fireworks":          0,
-	"firing":             0,
-	"first":              0,
-	"fish":               0,
-	"fisherman":          0,
-	"fist":               0,
-	"fit":                0,
-	"fits":               0,
-	"five":               0,
-	"fix":                0,
-	"flag":               0,
-	"flake":              0,
-	"flame":              0,
-	"flap":               0,
-	"flash":              0,
-	"flashlight":         0,
-	"flat":               0,
-	"flea":               0,
-	"flesh":              0,
-	"flew":               0,
-	"flies":              0,
-	"flight":             0,
-	"flip":               0,
-	"flip-flop":          0,
-	"float":              0,
-	"flock":              0,
-	"flood":              0,
-	"floor":              0,
-	"flop":               0,
-	"flour":              0,
-	"flow":               0,
-	"flower":             0,
-	"flowery":            0,
-	"flutter":            0,
-	"fly":                0,
-	"foam":               0,
-	"fog":                0,
-	"foggy":              0,
-	"fold":               0,
-	"folks":              0,
-	"follow":             0,
-	"following":          0,
-	"fond":               0,
-	"food":               0,
-	"fool":               0,
-	"foolish":            0,
-	"foot":               0,
-	"football":           0,
-	"footprint":          0,
-	"for":                0,
-	"forehead":           0,
-	"forest":             0,
-	"forget":             0,
-	"forgive":            0,
-	"forgot":             0,
-	"forgotten":          0,
-	"fork":               0,
-	"form":               0,
-	"fort":               0,
-	"forth":              0,
-	"fortune":            0,
-	"forty":              0,
-	"forward":            0,
-	"fought":             0,
-	"found":              0,
-	"fountain":           0,
-	"four":               0,
-	"fourteen":           0,
-	"fourth":             0,
-	"fox":                0,
-	"frame":              0,
-	"free":               0,
-	"freedom":            0,
-	"freeze":             0,
-	"freight":            0,
-	"french":             0,
-	"fresh":              0,
-	"fret":               0,
-	"friday":             0,
-	"fried":              0,
-	"friend":             0,
-	"friendly":           0,
-	"friendship":         0,
-	"frighten":           0,
-	"frog":               0,
-	"from":               0,
-	"front":              0,
-	"frost":              0,
-	"frown":              0,
-	"froze":              0,
-	"fruit":              0,
-	"fry":                0,
-	"fudge":              0,
-	"fuel":               0,
-	"full":               0,
-	"fully":              0,
-	"fun":                0,
-	"funny":              0,
-	"fur":                0,
-	"furniture":          0,
-	"further":            0,
-	"fuzzy":              0,
-	"gain":               0,
-	"gallon":             0,
-	"gallop":             0,
-	"game":               0,
-	"gang":               0,
-	"garage":             0,
-	"garbage":            0,
-	"garden":             0,
-	"gas":                0,
-	"gasoline":           0,
-	"gate":               0,
-	"gather":             0,
-	"gave":               0,
-	"gay":                0,
-	"gear":               0,
-	"geese":              0,
-	"general":            0,
-	"gentle":             0,
-	"gentleman":          0,
-	"gentlemen":          0,
-	"geography":          0,
-	"get":                0,
-	"getting":            0,
-	"giant":              0,
-	"gift":               0,
-	"gingerbread":        0,
-	"girl":               0,
-	"give":               0,
-	"given":              0,
-	"giving":             0,
-	"glad":               0,
-	"gladly":             0,
-	"glance":             0,
-	"glass":              0,
-	"glasses":            0,
-	"gleam":              0,
-	"glide":              0,
-	"glory":              0,
-	"glove":              0,
-	"glow":               0,
-	"glue":               0,
-	"go":                 0,
-	"going":              0,
-	"goes":               0,
-	"goal":               0,
-	"goat":               0,
-	"gobble":             0,
-	"God":                0,
-	"god":                0,
-	"godmother":          0,
-	"gold":               0,
-	"golden":             0,
-	"goldfish":           0,
-	"golf":               0,
-	"gone":               0,
-	"good":               0,
-	"goods":              0,
-	"goodbye":            0,
-	"good-by":            0,
-	"good-bye":           0,
-	"good-looking":       0,
-	"goodness":           0,
-	"goody":              0,
-	"goose":              0,
-	"gooseberry":         0,
-	"got":                0,
-	"govern":             0,
-	"government":         0,
-	"gown":               0,
-	"grab":               0,
-	"gracious":           0,
-	"grade":              0,
-	"grain":              0,
-	"grand":              0,
-	"grandchild":         0,
-	"grandchildren":      0,
-	"granddaughter":      0,
-	"grandfathergrandma": 0,
-	"grandmother":        0,
-	"grandpa":            0,
-	"grandson":           0,
-	"grandstand":         0,
-	"grape":              0,
-	"grapes":             0,
-	"grapefruit":         0,
-	"grass":              0,
-	"grasshopper":        0,
-	"grateful":           0,
-	"grave":              0,
-	"gravel":             0,
-	"graveyard":          0,
-	"gravy":              0,
-	"gray":               0,
-	"graze":              0,
-	"grease":             0,
-	"great":              0,
-	"green":              0,
-	"greet":              0,
-	"grew":               0,
-	"grind":              0,
-	"groan":              0,
-	"grocery":            0,
-	"ground":             0,
-	"group":              0,
-	"grove":              0,
-	"grow":               0,
-	"guard":              0,
-	"guess":              0,
-	"guest":              0,
-	"guide":              0,
-	"gulf":               0,
-	"gum":                0,
-	"gun":                0,
-	"gunpowder":          0,
-	"guyha":              0,
-	"habit":              0,
-	"had":                0,
-	"hadn't":             0,
-	"hail":               0,
-	"hair":               0,
-	"haircut":            0,
-	"hairpin":            0,
-	"half":               0,
-	"hall":               0,
-	"halt":               0,
-	"ham":                0,
-	"hammer":             0,
-	"hand":               0,
-	"handful":            0,
-	"handkerchief":       0,
-	"handle":             0,
-	"handwriting":        0,
-	"hang":               0,
-	"happen":             0,
-	"happily":            0,
-	"happiness":          0,
-	"happy":              0,
-	"harbor":             0,
-	"hard":               0,
-	"hardly":             0,
-	"hardship":           0,
-	"hardware":           0,
-	"hare":               0,
-	"hark":               0,
-	"harm":               0,
-	"harness":            0,
-	"harp":               0,
-	"harvest":            0,
-	"has":                0,
-	"hasn't":             0,
-	"haste":              0,
-	"hasten":             0,
-	"hasty":              0,
-	"hat":                0,
-	"hatch":              0,
-	"hatchet":            0,
-	"hate":               0,
-	"haul":               0,
-	"have":               0,
-	"haven't":            0,
-	"having":             0,
-	"hawk":               0,
-	"hay":                0,
-	"hayfield":           0,
-	"haystack":           0,
-	"he":                 0,
-	"head":               0,
-	"headache":           0,
-	"heal":               0,
-	"health":             0,
-	"healthy":            0,
-	"heap":               0,
-	"hear":               0,
-	"hearing":            0,
-	"heard":              0,
-	"heart":              0,
-	"heat":               0,
-	"heater":             0,
-	"heaven":             0,
-	"heavy":              0,
-	"he'd":               0,
-	"heel":               0,
-	"height":             0,
-	"held":               0,
-	"hell":               0,
-	"he'll":              0,
-	"hello":              0,
-	"helmet":             0,
-	"help":               0,
-	"helper":             0,
-	"helpful":            0,
-	"hem":                0,
-	"hen":                0,
-	"henhouse":           0,
-	"her":                0,
-	"hers":               0,
-	"herd":               0,
-	"here":               0,
-	"here's":             0,
-	"hero":               0,
-	"herself":            0,
-	"he's":               0,
-	"hey":                0,
-	"hickory":            0,
-	"hid":                0,
-	"hidden":             0,
-	"hide":               0,
-	"high":               0,
-	"highway":            0,
-	"hill":               0,
-	"hillside":           0,
-	"hilltop":            0,
-	"hilly":              0,
-	"him":                0,
-	"himself":            0,
-	"hind":               0,
-	"hint":               0,
-	"hip":                0,
-	"hire":               0,
-	"his":                0,
-	"hiss":               0,
-	"history":            0,
-	"hit":                0,
-	"hitch":              0,
-	"hive":               0,
-	"ho":                 0,
-	"hoe":                0,
-	"hog":                0,
-	"hold":               0,
-	"holder":             0,
-	"hole":               0,
-	"holiday":            0,
-	"hollow":             0,
-	"holy":               0,
-	"home":               0,
-	"homely":             0,
-	"homesick":           0,
-	"honest":             0,
-	"honey":              0,
-	"honeybee":           0,
-	"honeymoon":          0,
-	"honk":               0,
-	"honor":              0,
-	"hood":               0,
-	"hoof":               0,
-	"hook":               0,
-	"hoop":               0,
-	"hop":                0,
-	"hope":               0,
-	"hopeful":            0,
-	"hopeless":           0,
-	"horn":               0,
-	"horse":              0,
-	"horseback":          0,
-	"horseshoe":          0,
-	"hose":               0,
-	"hospital":           0,
-	"host":               0,
-	"hot":                0,
-	"hotel":              0,
-	"hound":              0,
-	"hour":               0,
-	"house":              0,
-	"housetop":           0,
-	"housewife":          0,
-	"housework":          0,
-	"how":                0,
-	"however":            0,
-	"howl":               0,
-	"hug":                0,
-	"huge":               0,
-	"hum":                0,
-	"humble":             0,
-	"hump":               0,
-	"hundred":            0,
-	"hung":               0,
-	"hunger":             0,
-	"hungry":             0,
-	"hunk":               0,
-	"hunt":               0,
-	"hunter":             0,
-	"hurrah":             0,
-	"hurried":            0,
-	"hurry":              0,
-	"hurt":               0,
-	"husband":            0,
-	"hush":               0,
-	"hut":                0,
-	"hymn":               0,
-	"i":                  0,
-	"ice":                0,
-	"icy":                0,
-	"i'd":                0,
-	"idea":               0,
-	"ideal":              0,
-	"if":                 0,
-	"ill":                0,
-	"i'll":               0,
-	"i'm":                0,
-	"important":          0,
-	"impossible":         0,
-	"improve":            0,
-	"in":                 0,
-	"inch":               0,
-	"inches":             0,
-	"income":             0,
-	"indeed":             0,
-	"indian":             0,
-	"indoors":            0,
-	"ink":                0,
-	"inn":                0,
-	"insect":             0,
-	"inside":             0,
-	"instant":            0,
-	"instead":            0,
-	"insult":             0,
-	"intend":             0,
-	"interested":         0,
-	"interesting":        0,
-	"into":               0,
-	"invite":             0,
-	"iron":               0,
-	"is":                 0,
-	"island":             0,
-	"isn't":              0,
-	"it":                 0,
-	"its":                0,
-	"it's":               0,
-	"itself":             0,
-	"i've":               0,
-	"ivory":              0,
-	"ivy":                0,
-	"jacket":             0,
-	"jacks":              0,
-	"jail":               0,
-	"jam":                0,
-	"january":            0,
-	"jar":                0,
-	"jaw":                0,
-	"jay":                0,
-	"jelly":              0,
-	"jellyfish":          0,
-	"jerk":               0,
-	"jig":                0,
-	"job":                0,
-	"jockey":             0,
-	"join":               0,
-	"joke":               0,
-	"joking":             0,
-	"jolly":              0,
-	"journey":            0,
-	"joy":                0,
-	"joyful":             0,
-	"joyous":             0,
-	"judge":              0,
-	"jug":                0,
-	"juice":              0,
-	"juicy":              0,
-	"july":               0,
-	"jump":               0,
-	"june":               0,
-	"junior":             0,
-	"junk":               0,
-	"just":               0,
-	"keen":               0,
-	"keep":               0,
-	"kept":               0,
-	"kettle":             0,
-	"key":                0,
-	"kick":               0,
-	"kid":                0,
-	"kill":               0,
-	"killed":             0,
-	"kind":               0,
-	"kindly":             0,
-	"kindness":           0,
-	"king":               0,
-	"kingdom":            0,
-	"kiss":               0,
-	"kitchen":            0,
-	"kite":               0,
-	"kitten":             0,
-	"kitty":              0,
-	"knee":               0,
-	"kneel":              0,
-	"knew":               0,
-	"knife":              0,
-	"knit":               0,
-	"knives":             0,
-	"knob":               0,
-	"knock":              0,
-	"knot":               0,
-	"know":               0,
-	"known":              0,
-	"lace":               0,
-	"lad":                0,
-	"ladder":             0,
-	"ladies":             0,
-	"lady":               0,
-	"laid":               0,
-	"lake":               0,
-	"lamb":               0,
-	"lame":               0,
-	"lamp":               0,
-	"land":               0,
-	"lane":               0,
-	"language":           0,
-	"lantern":            0,
-	"lap":                0,
-	"lard":               0,
-	"large":              0,
-	"lash":               0,
-	"lass":               0,
-	"last":               0,
-	"late":               0,
-	"laugh":              0,
-	"laundry":            0,
-	"law":                0,
-	"lawn":               0,
-	"lawyer":             0,
-	"lay":                0,
-	"lazy":               0,
-	"lead":               0,
-	"leader":             0,
-	"leaf":               0,
-	"leak":               0,
-	"lean":               0,
-	"leap":               0,
-	"learn":              0,
-	"learned":            0,
-	"least":              0,
-	"leather":            0,
-	"leave":              0,
-	"leaving":            0,
-	"led":                0,
-	"left":               0,
-	"leg":                0,
-	"lemon":              0,
-	"lemonade":           0,
-	"lend":               0,
-	"length":             0,
-	"less":               0,
-	"lesson":             0,
-	"let":                0,
-	"let's":              0,
-	"letter":             0,
-	"letting":            0,
-	"lettuce":            0,
-	"level":              0,
-	"liberty":            0,
-	"library":            0,
-	"lice":               0,
-	"lick":               0,
-	"lid":                0,
-	"lie":                0,
-	"life":               0,
-	"lift":               0,
-	"light":              0,
-	"lightness":          0,
-	"lightning":          0,
-	"like":               0,
-	"likely":             0,
-	"liking":             0,
-	"lily":               0,
-	"limb":               0,
-	"lime":               0,
-	"limp":               0,
-	"line":               0,
-	"linen":              0,
-	"lion":               0,
-	"lip":                0,
-	"list":               0,
-	"listen":             0,
-	"lit":                0,
-	"little":             0,
-	"live":               0,
-	"lives":              0,
-	"lively":             0,
-	"liver":              0,
-	"living":             0,
-	"lizard":             0,
-	"load":               0,
-	"loaf":               0,
-	"loan":               0,
-	"loaves":             0,
-	"lock":               0,
-	"locomotive":         0,
-	"log":                0,
-	"lone":               0,
-	"lonely":             0,
-	"lonesome":           0,
-	"long":               0,
-	"look":               0,
-	"lookout":            0,
-	"loop":               0,
-	"loose":              0,
-	"lord":               0,
-	"lose":               0,
-	"loser":              0,
-	"loss":               0,
-	"lost":               0,
-	"lot":                0,
-	"loud":               0,
-	"love":               0,
-	"lovely":             0,
-	"lover":              0,
-	"low":                0,
-	"luck":               0,
-	"lucky":              0,
-	"lumber":             0,
-	"lump":               0,
-	"lunch":              0,
-	"lying":              0,
-	"ma":                 0,
-	"machine":            0,
-	"machinery":          0,
-	"mad":                0,
-	"made":               0,
-	"magazine":           0,
-	"magic":              0,
-	"maid":               0,
-	"mail":               0,
-	"mailbox":            0,
-	"mailman":            0,
-	"major":              0,
-	"make":               0,
-	"making":             0,
-	"male":               0,
-	"mama":               0,
-	"mamma":              0,
-	"man":                0,
-	"manager":            0,
-	"mane":               0,
-	"manger":             0,
-	"many":               0,
-	"map":                0,
-	"maple":              0,
-	"marble":             0,
-	"march":              0,
-	"mare":               0,
-	"mark":               0,
-	"market":             0,
-	"marriage":           0,
-	"married":            0,
-	"marry":              0,
-	"mask":               0,
-	"mast":               0,
-	"master":             0,
-	"mat":                0,
-	"match":              0,
-	"matter":             0,
-	"mattress":           0,
-	"may":                0,
-	"maybe":              0,
-	"mayor":              0,
-	"maypole":            0,
-	"me":                 0,
-	"meadow":             0,
-	"meal":               0,
-	"mean":               0,
-	"means":              0,
-	"meant":              0,
-	"measure":            0,
-	"meat":               0,
-	"medicine":           0,
-	"meet":               0,
-	"meeting":            0,
-	"melt":               0,
-	"member":             0,
-	"men":                0,
-	"mend":               0,
-	"meow":               0,
-	"merry":              0,
-	"mess":               0,
-	"message":            0,
-	"met":                0,
-	"metal":              0,
-	"mew":                0,
-	"mice":               0,
-	"middle":             0,
-	"midnight":           0,
-	"might":              0,
-	"mighty":             0,
-	"mile":               0,
-	"milk":               0,
-	"milkman":            0,
-	"mill":               0,
-	"miler":              0,
-	"million":            0,
-	"mind":               0,
-	"mine":               0,
-	"miner":              0,
-	"mint":               0,
-	"minute":             0,
-	"mirror":             0,
-	"mischief":           0,
-	"miss":               0,
-	"misspell":           0,
-	"mistake":            0,
-	"misty":              0,
-	"mitt":               0,
-	"mitten":             0,
-	"mix":                0,
-	"moment":             0,
-	"monday":             0,
-	"money":              0,
-	"monkey":             0,
-	"month":              0,
-	"moo":                0,
-	"moon":               0,
-	"moonlight":          0,
-	"moose":              0,
-	"mop":                0,
-	"more":               0,
-	"morning":            0,
-	"morrow":             0,
-	"moss":               0,
-	"most":               0,
-	"mostly":             0,
-	"mother":             0,
-	"motor":              0,
-	"mount":              0,
-	"mountain":           0,
-	"mouse":              0,
-	"mouth":              0,
-	"move":               0,
-	"movie":              0,
-	"movies":             0,
-	"moving":             0,
-	"mow":                0,
-	"mr.":                0,
-	"mrs.":               0,
-	"much":               0,
-	"mud":                0,
-	"muddy":              0,
-	"mug":                0,
-	"mule":               0,
-	"multiply":           0,
-	"murder":             0,
-	"music":              0,
-	"must":               0,
-	"my":                 0,
-	"myself":             0,
-	"nail":               0,
-	"name":               0,
-	"nap":                0,
-	"napkin":             0,
-	"narrow":             0,
-	"nasty":              0,
-	"naughty":            0,
-	"navy":               0,
-	"near":               0,
-	"nearby":             0,
-	"nearly":             0,
-	"neat":               0,
-	"neck":               0,
-	"necktie":            0,
-	"need":               0,
-	"needle":             0,
-	"needn't":            0,
-	"negro":              0,
-	"neighbor":           0,
-	"neighborhood":       0,
-	"neither":            0,
-	"nerve":              0,
-	"nest":               0,
-	"net":                0,
-	"never":              0,
-	"nevermore":          0,
-	"new":                0,
-	"news":               0,
-	"newspaper":          0,
-	"next":               0,
-	"nibble":             0,
-	"nice":               0,
-	"nickel":             0,
-	"night":              0,
-	"nightgown":          0,
-	"nine":               0,
-	"nineteen":           0,
-	"ninety":             0,
-	"no":                 0,
-	"nobody":             0,
-	"nod":                0,
-	"noise":              0,
-	"noisy":              0,
-	"none":               0,
-	"noon":               0,
-	"nor":                0,
-	"north":              0,
-	"northern":           0,
-	"nose":               0,
-	"not":                0,
-	"note":               0,
-	"nothing":            0,
-	"notice":             0,
-	"november":           0,
-	"now":                0,
-	"nowhere":            0,
-	"number":             0,
-	"nurse":              0,
-	"nut":                0,
-	"oak":                0,
-	"oar":                0,
-	"oatmeal":            0,
-	"oats":               0,
-	"obey":               0,
-	"ocean":              0,
-	"o'clock":            0,
-	"october":            0,
-	"odd":                0,
-	"of":                 0,
-	"off":                0,
-	"offer":              0,
-	"office":             0,
-	"officer":            0,
-	"often":              0,
-	"oh":                 0,
-	"oil":                0,
-	"old":                0,
-	"old-fashioned":      0,
-	"on":                 0,
-	"once":               0,
-	"one":                0,
-	"onion":              0,
-	"only":               0,
-	"onward":             0,
-	"open":               0,
-	"or":                 0,
-	"orange":             0,
-	"orchard":            0,
-	"order":              0,
-	"ore":                0,
-	"organ":              0,
-	"other":              0,
-	"otherwise":          0,
-	"ouch":               0,
-	"ought":              0,
-	"our":                0,
-	"ours":               0,
-	"ourselves":          0,
-	"out":                0,
-	"outdoors":           0,
-	"outfit":             0,
-	"outlaw":             0,
-	"outline":            0,
-	"outside":            0,
-	"outward":            0,
-	"oven":               0,
-	"over":               0,
-	"overalls":           0,
-	"overcoat":           0,
-	"overeat":            0,
-	"overhead":           0,
-	"overhear":           0,
-	"overnight":          0,
-	"overturn":           0,
-	"owe":                0,
-	"owing":              0,
-	"owl":                0,
-	"own":                0,
-	"owner":              0,
-	"ox":                 0,
-	"pa":                 0,
-	"pace":               0,
-	"pack":               0,
-	"package":            0,
-	"pad":                0,
-	"page":               0,
-	"paid":               0,
-	"pail":               0,
-	"pain":               0,
-	"painful":            0,
-	"paint":              0,
-	"painter":            0,
-	"painting":           0,
-	"pair":               0,
-	"pal":                0,
-	"palace":             0,
-	"pale":               0,
-	"pan":                0,
-	"pancake":            0,
-	"pane":               0,
-	"pansy":              0,
-	"pants":              0,
-	"papa":               0,
-	"paper":              0,
-	"parade":             0,
-	"pardon":             0,
-	"parent":             0,
-	"park":               0,
-	"part":               0,
-	"partly":             0,
-	"partner":            0,
-	"party":              0,
-	"pass":               0,
-	"passenger":          0,
-	"past":               0,
-	"paste":              0,
-	"pasture":            0,
-	"pat":                0,
-	"patch":              0,
-	"path":               0,
-	"patter":             0,
-	"pave":               0,
-	"pavement":           0,
-	"paw":                0,
-	"pay":                0,
-	"payment":            0,
-	"pea":                0,
-	"peas":               0,
-	"peace":              0,
-	"peaceful":           0,
-	"peach":              0,
-	"peaches":            0,
-	"peak":               0,
-	"peanut":             0,
-	"pear":               0,
-	"pearl":              0,
-	"peck":               0,
-	"peek":               0,
-	"peel":               0,
-	"peep":               0,
-	"peg":                0,
-	"pen":                0,
-	"pencil":             0,
-	"penny":              0,
-	"people":             0,
-	"pepper":             0,
-	"peppermint":         0,
-	"perfume":            0,
-	"perhaps":            0,
-	"person":             0,
-	"pet":                0,
-	"phone":              0,
-	"piano":              0,
-	"pick":               0,
-	"pickle":             0,
-	"picnic":             0,
-	"picture":            0,
-	"pie":                0,
-	"piece":              0,
-	"pig":                0,
-	"pigeon":             0,
-	"piggy":              0,
-	"pile":               0,
-	"pill":               0,
-	"pillow":             0,
-	"pin":                0,
-	"pine":               0,
-	"pineapple":          0,
-	"pink":               0,
-	"pint":               0,
-	"pipe":               0,
-	"pistol":             0,
-	"pit":                0,
-	"pitch":              0,
-	"pitcher":            0,
-	"pity":               0,
-	"place":              0,
-	"plain":              0,
-	"plan":               0,
-	"plane":              0,
-	"plant":              0,
-	"plate":              0,
-	"platform":           0,
-	"platter":            0,
-	"play":               0,
-	"player":             0,
-	"playground":         0,
-	"playhouse":          0,
-	"playmate":           0,
-	"plaything":          0,
-	"pleasant":           0,
-	"please":             0,
-	"pleasure":           0,
-	"plenty":             0,
-	"plow":               0,
-	"plug":               0,
-	"plum":               0,
-	"pocket":             0,
-	"pocketbook":         0,
-	"poem":               0,
-	"point":              0,
-	"poison":             0,
-	"poke":               0,
-	"pole":               0,
-	"police":             0,
-	"policeman":          0,
-	"polish":             0,
-	"polite":             0,
-	"pond":               0,
-	"ponies":             0,
-	"pony":               0,
-	"pool":               0,
-	"poor":               0,
-	"pop":                0,
-	"popcorn":            0,
-	"popped":             0,
-	"porch":              0,
-	"pork":               0,
-	"possible":           0,
-	"post":               0,
-	"postage":            0,
-	"postman":            0,
-	"pot":                0,
-	"potato":             0,
-	"potatoes":           0,
-	"pound":              0,
-	"pour":               0,
-	"powder":             0,
-	"power":              0,
-	"powerful":           0,
-	"praise":             0,
-	"pray":               0,
-	"prayer":             0,
-	"prepare":            0,
-	"present":            0,
-	"pretty":             0,
-	"price":              0,
-	"prick":              0,
-	"prince":             0,
-	"princess":           0,
-	"print":              0,
-	"prison":             0,
-	"prize":              0,
-	"promise":            0,
-	"proper":             0,
-	"protect":            0,
-	"proud":              0,
-	"prove":              0,
-	"prune":              0,
-	"public":             0,
-	"puddle":             0,
-	"puff":               0,
-	"pull":               0,
-	"pump":               0,
-	"pumpkin":            0,
-	"punch":              0,
-	"punish":             0,
-	"pup":                0,
-	"pupil":              0,
-	"puppy":              0,
-	"pure":               0,
-	"purple":             0,
-	"purse":              0,
-	"push":               0,
-	"puss":               0,
-	"pussy":              0,
-	"pussycat":           0,
-	"put":                0,
-	"putting":            0,
-	"puzzle":             0,
-	"quack":              0,
-	"quart":              0,
-	"quarter":            0,
-	"queen":              0,
-	"queer":              0,
-	"question":           0,
-	"quick":              0,
-	"quickly":            0,
-	"quiet":              0,
-	"quilt":              0,
-	"quit":               0,
-	"quite":              0,
-	"rabbit":             0,
-	"race":               0,
-	"rack":               0,
-	"radio":              0,
-	"radish":             0,
-	"rag":                0,
-	"rail":               0,
-	"railroad":           0,
-	"railway":            0,
-	"rain":               0,
-	"rainy":              0,
-	"rainbow":            0,
-	"raise":              0,
-	"raisin":             0,
-	"rake":               0,
-	"ram":                0,
-	"ran":                0,
-	"ranch":              0,
-	"rang":               0,
-	"rap":                0,
-	"rapidly":            0,
-	"rat":                0,
-	"rate":               0,
-	"rather":             0,
-	"rattle":             0,
-	"raw":                0,
-	"ray":                0,
-	"reach":              0,
-	"read":               0,
-	"reader":             0,
-	"reading":            0,
-	"ready":              0,
-	"real":               0,
-	"really":             0,
-	"reap":               0,
-	"rear":               0,
-	"reason":             0,
-	"rebuild":            0,
-	"receive":            0,
-	"recess":             0,
-	"record":             0,
-	"red":                0,
-	"redbird":            0,
-	"redbreast":          0,
-	"refuse":             0,
-	"reindeer":           0,
-	"rejoice":            0,
-	"remain":             0,
-	"remember":           0,
-	"remind":             0,
-	"remove":             0,
-	"rent":               0,
-	"repair":             0,
-	"repay":              0,
-	"repeat":             0,
-	"report":             0,
-	"rest":               0,
-	"return":             0,
-	"review":             0,
-	"reward":             0,
-	"rib":                0,
-	"ribbon":             0,
-	"rice":               0,
-	"rich":               0,
-	"rid":                0,
-	"riddle":             0,
-	"ride":               0,
-	"rider":              0,
-	"riding":             0,
-	"right":              0,
-	"rim":                0,
-	"ring":               0,
-	"rip":                0,
-	"ripe":               0,
-	"rise":               0,
-	"rising":             0,
-	"river":              0,
-	"road":               0,
-	"roadside":           0,
-	"roar":               0,
-	"roast":              0,
-	"rob":                0,
-	"robber":             0,
-	"robe":               0,
-	"robin":              0,
-	"rock":               0,
-	"rocky":              0,
-	"rocket":             0,
-	"rode":               0,
-	"roll":               0,
-	"roller":             0,
-	"roof":               0,
-	"room":               0,
-	"rooster":            0,
-	"root":               0,
-	"rope":               0,
-	"rose":               0,
-	"rosebud":            0,
-	"rot":                0,
-	"rotten":             0,
-	"rough":              0,
-	"round":              0,
-	"route":              0,
-	"row":                0,
-	"rowboat":            0,
-	"royal":              0,
-	"rub":                0,
-	"rubbed":             0,
-	"rubber":             0,
-	"rubbish":            0,
-	"rug":                0,
-	"rule":               0,
-	"ruler":              0,
-	"rumble":             0,
-	"run":                0,
-	"rung":               0,
-	"runner":             0,
-	"running":            0,
-	"rush":               0,
-	"rust":               0,
-	"rusty":              0,
-	"rye":                0,
-	"sack":               0,
-	"sad":                0,
-	"saddle":             0,
-	"sadness":            0,
-	"safe":               0,
-	"safety":             0,
-	"said":               0,
-	"sail":               0,
-	"sailboat":           0,
-	"sailor":             0,
-	"saint":              0,
-	"salad":              0,
-	"sale":               0,
-	"salt":               0,
-	"same":               0,
-	"sand":               0,
-	"sandy":              0,
-	"sandwich":           0,
-	"sang":               0,
-	"sank":               0,
-	"sap":                0,
-	"sash":               0,
-	"sat":                0,
-	"satin":              0,
-	"satisfactory":       0,
-	"saturday":           0,
-	"sausage":            0,
-	"savage":             0,
-	"save":               0,
-	"savings":            0,
-	"saw":                0,
-	"say":                0,
-	"scab":               0,
-	"scales":             0,
-	"scare":              0,
-	"scarf":              0,
-	"school":             0,
-	"schoolboy":          0,
-	"schoolhouse":        0,
-	"schoolmaster":       0,
-	"schoolroom":         0,
-	"scorch":             0,
-	"score":              0,
-	"scrap":              0,
-	"scrape":             0,
-	"scratch":            0,
-	"scream":             0,
-	"screen":             0,
-	"screw":              0,
-	"scrub":              0,
-	"sea":                0,
-	"seal":               0,
-	"seam":               0,
-	"search":             0,
-	"season":             0,
-	"seat":               0,
-	"second":             0,
-	"secret":             0,
-	"see":                0,
-	"seeing":             0,
-	"seed":               0,
-	"seek":               0,
-	"seem":               0,
-	"seen":               0,
-	"seesaw":             0,
-	"select":             0,
-	"self":               0,
-	"selfish":            0,
-	"sell":               0,
-	"send":               0,
-	"sense":              0,
-	"sent":               0,
-	"sentence":           0,
-	"separate":           0,
-	"september":          0,
-	"servant":            0,
-	"serve":              0,
-	"service":            0,
-	"set":                0,
-	"setting":            0,
-	"settle":             0,
-	"settlement":         0,
-	"seven":              0,
-	"seventeen":          0,
-	"seventh":            0,
-	"seventy":            0,
-	"several":            0,
-	"sew":                0,
-	"shade":              0,
-	"shadow":             0,
-	"shady":              0,
-	"shake":              0,
-	"shaker":             0,
-	"shaking":            0,
-	"shall":              0,
-	"shame":              0,
-	"shan't":             0,
-	"shape":              0,
-	"share":              0,
-	"sharp":              0,
-	"shave":              0,
-	"she":                0,
-	"she'd":              0,
-	"she'll":             0,
-	"she's":              0,
-	"shear":              0,
-	"shears":             0,
-	"shed":               0,
-	"sheep":              0,
-	"sheet":              0,
-	"shelf":              0,
-	"shell":              0,
-	"shepherd":           0,
-	"shine":              0,
-	"shining":            0,
-	"shiny":              0,
-	"ship":               0,
-	"shirt":              0,
-	"shock":              0,
-	"shoe":               0,
-	"shoemaker":          0,
-	"shone":              0,
-	"shook":              0,
-	"shoot":              0,
-	"shop":               0,
-	"shopping":           0,
-	"shore":              0,
-	"short":              0,
-	"shot":               0,
-	"should":             0,
-	"shoulder":           0,
-	"shouldn't":          0,
-	"shout":              0,
-	"shovel":             0,
-	"showshower":         0,
-	"shut":               0,
-	"shy":                0,
-	"sick":               0,
-	"sickness":           0,
-	"side":               0,
-	"sidewalk":           0,
-	"sideways":           0,
-	"sigh":               0,
-	"sight":              0,
-	"sign":               0,
-	"silence":            0,
-	"silent":             0,
-	"silk":               0,
-	"sill":               0,
-	"silly":              0,
-	"silver":             0,
-	"simple":             0,
-	"sin":                0,
-	"since":              0,
-	"sing":               0,
-	"singer":             0,
-	"single":             0,
-	"sink":               0,
-	"sip":                0,
-	"sir":                0,
-	"sis":                0,
-	"sissy":              0,
-	"sister":             0,
-	"sit":                0,
-	"sitting":            0,
-	"six":                0,
-	"sixteen":            0,
-	"sixth":              0,
-	"sixty":              0,
-	"size":               0,
-	"skate":              0,
-	"skater":             0,
-	"ski":                0,
-	"skin":               0,
-	"skip":               0,
-	"skirt":              0,
-	"sky":                0,
-	"slam":               0,
-	"slap":               0,
-	"slate":              0,
-	"slave":              0,
-	"sled":               0,
-	"sleep":              0,
-	"sleepy":             0,
-	"sleeve":             0,
-	"sleigh":             0,
-	"slept":              0,
-	"slice":              0,
-	"slid":               0,
-	"slide":              0,
-	"sling":              0,
-	"slip":               0,
-	"slipped":            0,
-	"slipper":            0,
-	"slippery":           0,
-	"slit":               0,
-	"slow":               0,
-	"slowly":             0,
-	"sly":                0,
-	"smack":              0,
-	"small":              0,
-	"smart":              0,
-	"smell":              0,
-	"smile":              0,
-	"smoke":              0,
-	"smooth":             0,
-	"snail":              0,
-	"snake":              0,
-	"snap":               0,
-	"snapping":           0,
-	"sneeze":             0,
-	"snow":               0,
-	"snowy":              0,
-	"snowball":           0,
-	"snowflake":          0,
-	"snuff":              0,
-	"snug":               0,
-	"so":                 0,
-	"soak":               0,
-	"soap":               0,
-	"sob":                0,
-	"socks":              0,
-	"sod":                0,
-	"soda":               0,
-	"sofa":               0,
-	"soft":               0,
-	"soil":               0,
-	"sold":               0,
-	"soldier":            0,
-	"sole":               0,
-	"some":               0,
-	"somebody":           0,
-	"somehow":            0,
-	"someone":            0,
-	"something":          0,
-	"sometime":           0,
-	"sometimes":          0,
-	"somewhere":          0,
-	"son":                0,
-	"song":               0,
-	"soon":               0,
-	"sore":               0,
-	"sorrow":             0,
-	"sorry":              0,
-	"sort":               0,
-	"soul":               0,
-	"sound":              0,
-	"soup":               0,
-	"sour":               0,
-	"south":              0,
-	"southern":           0,
-	"space":              0,
-	"spade":              0,
-	"spank":              0,
-	"sparrow":            0,
-	"speak":              0,
-	"speaker":            0,
-	"spear":              0,
-	"speech":             0,
-	"speed":              0,
-	"spell":              0,
-	"spelling":           0,
-	"spend":              0,
-	"spent":              0,
-	"spider":             0,
-	"spike":              0,
-	"spill":              0,
-	"spin":               0,
-	"spinach":            0,
-	"spirit":             0,
-	"spit":               0,
-	"splash":             0,
-	"spoil":              0,
-	"spoke":              0,
-	"spook":              0,
-	"spoon":              0,
-	"sport":              0,
-	"spot":               0,
-	"spread":             0,
-	"spring":             0,
-	"springtime":         0,
-	"sprinkle":           0,
-	"square":             0,
-	"squash":             0,
-	"squeak":             0,
-	"squeeze":            0,
-	"squirrel":           0,
-	"stable":             0,
-	"stack":              0,
-	"stage":              0,
-	"stair":              0,
-	"stall":              0,
-	"stamp":              0,
-	"stand":              0,
-	"star":               0,
-	"stare":              0,
-	"start":              0,
-	"starve":             0,
-	"state":              0,
-	"station":            0,
-	"stay":               0,
-	"steak":              0,
-	"steal":              0,
-	"steam":              0,
-	"steamboat":          0,
-	"steamer":            0,
-	"steel":              0,
-	"steep":              0,
-	"steeple":            0,
-	"steer":              0,
-	"stem":               0,
-	"step":               0,
-	"stepping":           0,
-	"stick":              0,
-	"sticky":             0,
-	"stiff":              0,
-	"still":              0,
-	"stillness":          0,
-	"sting":              0,
-	"stir":               0,
-	"stitch":             0,
-	"stock":              0,
-	"stocking":           0,
-	"stole":              0,
-	"stone":              0,
-	"stood":              0,
-	"stool":              0,
-	"stoop":              0,
-	"stop":               0,
-	"stopped":            0,
-	"stopping":           0,
-	"store":              0,
-	"stork":              0,
-	"stories":            0,
-	"storm":              0,
-	"stormy":             0,
-	"story":              0,
-	"stove":              0,
-	"straight":           0,
-	"strange":            0,
-	"stranger":           0,
-	"strap":              0,
-	"straw":              0,
-	"strawberry":         0,
-	"stream":             0,
-	"street":             0,
-	"stretch":            0,
-	"string":             0,
-	"strip":              0,
-	"stripes":            0,
-	"strong":             0,
-	"stuck":              0,
-	"study":              0,
-	"stuff":              0,
-	"stump":              0,
-	"stung":              0,
-	"subject":            0,
-	"such":               0,
-	"suck":               0,
-	"sudden":             0,
-	"suffer":             0,
-	"sugar":              0,
-	"suit":               0,
-	"sum":                0,
-	"summer":             0,
-	"sun":                0,
-	"sunday":             0,
-	"sunflower":          0,
-	"sung":               0,
-	"sunk":               0,
-	"sunlight":           0,
-	"sunny":              0,
-	"sunrise":            0,
-	"sunset":             0,
-	"sunshine":           0,
-	"supper":             0,
-	"suppose":            0,
-	"sure":               0,
-	"surely":             0,
-	"surface":            0,
-	"surprise":           0,
-	"swallow":            0,
-	"swam":               0,
-	"swamp":              0,
-	"swan":               0,
-	"swat":               0,
-	"swear":              0,
-	"sweat":              0,
-	"sweater":            0,
-	"sweep":              0,
-	"sweet":              0,
-	"sweetness":          0,
-	"sweetheart":         0,
-	"swell":              0,
-	"swept":              0,
-	"swift":              0,
-	"swim":               0,
-	"swimming":           0,
-	"swing":              0,
-	"switch":             0,
-	"sword":              0,
-	"swore":              0,
-	"states":             0,
-	"table":              0,
-	"tablecloth":         0,
-	"tablespoon":         0,
-	"tablet":             0,
-	"tack":               0,
-	"tag":                0,
-	"tail":               0,
-	"tailor":             0,
-	"take":               0,
-	"taken":              0,
-	"taking":             0,
-	"tale":               0,
-	"talk":               0,
-	"talker":             0,
-	"tall":               0,
-	"tame":               0,
-	"tan":                0,
-	"tank":               0,
-	"tap":                0,
-	"tape":               0,
-	"tar":                0,
-	"tardy":              0,
-	"task":               0,
-	"taste":              0,
-	"taught":             0,
-	"tax":                0,
-	"tea":                0,
-	"teach":              0,
-	"teacher":            0,
-	"team":               0,
-	"tear":               0,
-	"tease":              0,
-	"teaspoon":           0,
-	"teeth":              0,
-	"telephone":          0,
-	"tell":               0,
-	"temper":             0,
-	"ten":                0,
-	"tennis":             0,
-	"tent":               0,
-	"term":               0,
-	"terrible":           0,
-	"test":               0,
-	"than":               0,
-	"thank":              0,
-	"thanks":             0,
-	"thankful":           0,
-	"thanksgiving":       0,
-	"that":               0,
-	"that's":             0,
-	"the":                0,
-	"theater":            0,
-	"thee":               0,
-	"their":              0,
-	"them":               0,
-	"then":               0,
-	"there":              0,
-	"these":              0,
-	"they":               0,
-	"they'd":             0,
-	"they'll":            0,
-	"they're":            0,
-	"they've":            0,
-	"thick":              0,
-	"thief":              0,
-	"thimble":            0,
-	"thin":               0,
-	"thing":              0,
-	"things":             0,
-	"think":              0,
-	"third":              0,
-	"thirsty":            0,
-	"thirteen":           0,
-	"thirty":             0,
-	"this":               0,
-	"thorn":              0,
-	"those":              0,
-	"though":             0,
-	"thought":            0,
-	"thousand":           0,
-	"thread":             0,
-	"three":              0,
-	"threw":              0,
-	"throat":             0,
-	"throne":             0,
-	"through":            0,
-	"throw":              0,
-	"thrown":             0,
-	"thumb":              0,
-	"thunder":            0,
-	"thursday":           0,
-	"thy":                0,
-	"tick":               0,
-	"ticket":             0,
-	"tickle":             0,
-	"tie":                0,
-	"tiger":              0,
-	"tight":              0,
-	"till":               0,
-	"time":               0,
-	"tin":                0,
-	"tinkle":             0,
-	"tiny":               0,
-	"tip":                0,
-	"tiptoe":             0,
-	"tire":               0,
-	"tired":              0,
-	"title":              0,
-	"to":                 0,
-	"toad":               0,
-	"toadstool":          0,
-	"toast":              0,
-	"tobacco":            0,
-	"today":              0,
-	"toe":                0,
-	"together":           0,
-	"toilet":             0,
-	"told":               0,
-	"tomato":             0,
-	"tomorrow":           0,
-	"ton":                0,
-	"tone":               0,
-	"tongue":             0,
-	"tonight":            0,
-	"too":                0,
-	"took":               0,
-	"tool":               0,
-	"toot":               0,
-	"tooth":              0,
-	"toothbrush":         0,
-	"toothpick":          0,
-	"top":                0,
-	"tore":               0,
-	"torn":               0,
-	"toss":               0,
-	"touch":              0,
-	"tow":                0,
-	"toward":             0,
-	"towards":            0,
-	"towel":              0,
-	"tower":              0,
-	"town":               0,
-	"toy":                0,
-	"trace":              0,
-	"track":              0,
-	"trade":              0,
-	"train":              0,
-	"tramp":              0,
-	"trap":               0,
-	"tray":               0,
-	"treasure":           0,
-	"treat":              0,
-	"tree":               0,
-	"trick":              0,
-	"tricycle":           0,
-	"tried":              0,
-	"trim":               0,
-	"trip":               0,
-	"trolley":            0,
-	"trouble":            0,
-	"truck":              0,
-	"true":               0,
-	"truly":              0,
-	"trunk":              0,
-	"trust":              0,
-	"truth":              0,
-	"try":                0,
-	"tub":                0,
-	"tuesday":            0,
-	"tug":                0,
-	"tulip":              0,
-	"tumble":             0,
-	"tune":               0,
-	"tunnel":             0,
-	"turkey":             0,
-	"turn":               0,
-	"turtle":             0,
-	"twelve":             0,
-	"twenty":             0,
-	"twice":              0,
-	"twig":               0,
-	"twin":               0,
-	"two":                0,
-	"ugly":               0,
-	"umbrella":           0,
-	"uncle":              0,
-	"under":              0,
-	"understand":         0,
-	"underwear":          0,
-	"undress":            0,
-	"unfair":             0,
-	"unfinished":         0,
-	"unfold":             0,
-	"unfriendly":         0,
-	"unhappy":            0,
-	"unhurt":             0,
-	"uniform":            0,
-	"united":             0,
-	"unkind":             0,
-	"unknown":            0,
-	"unless":             0,
-	"unpleasant":         0,
-	"until":              0,
-	"unwilling":          0,
-	"up":                 0,
-	"upon":               0,
-	"upper":              0,
-	"upset":              0,
-	"upside":             0,
-	"upstairs":           0,
-	"uptown":             0,
-	"upward":             0,
-	"us":                 0,
-	"use":                0,
-	"used":               0,
-	"useful":             0,
-	"valentine":          0,
-	"valley":             0,
-	"valuable":           0,
-	"value":              0,
-	"vase":               0,
-	"vegetable":          0,
-	"velvet":             0,
-	"very":               0,
-	"vessel":             0,
-	"victory":            0,
-	"view":               0,
-	"village":            0,
-	"vine":               0,
-	"violet":             0,
-	"visit":              0,
-	"visitor":            0,
-	"voice":              0,
-	"vote":               0,
-	"wag":                0,
-	"wagon":              0,
-	"waist":              0,
-	"wait":               0,
-	"wake":               0,
-	"waken":              0,
-	"walk":               0,
-	"wall":               0,
-	"walnut":             0,
-	"want":               0,
-	"war":                0,
-	"warm":               0,
-	"warn":               0,
-	"was":                0,
-	"wash":               0,
-	"washer":             0,
-	"washtub":            0,
-	"wasn't":             0,
-	"waste":              0,
-	"watch":              0,
-	"watchman":           0,
-	"water":              0,
-	"watermelon":         0,
-	"waterproof":         0,
-	"wave":               0,
-	"wax":                0,
-	"way":                0,
-	"wayside":            0,
-	"we":                 0,
-	"weak":               0,
-	"weakness":           0,
-	"weaken":             0,
-	"wealth":             0,
-	"weapon":             0,
-	"wear":               0,
-	"weary":              0,
-	"weather":            0,
-	"weave":              0,
-	"web":                0,
-	"we'd":               0,
-	"wedding":            0,
-	"wednesday":          0,
-	"wee":                0,
-	"weed":               0,
-	"week":               0,
-	"we'll":              0,
-	"weep":               0,
-	"weigh":              0,
-	"welcome":            0,
-	"well":               0,
-	"went":               0,
-	"were":               0,
-	"we're":              0,
-	"west":               0,
-	"western":            0,
-	"wet":                0,
-	"we've":              0,
-	"whale":              0,
-	"what":               0,
-	"what's":             0,
-	"wheat":              0,
-	"wheel":              0,
-	"when":               0,
-	"whenever":           0,
-	"where":              0,
-	"which":              0,
-	"while":              0,
-	"whip":               0,
-	"whipped":            0,
-	"whirl":              0,
-	"whisky":             0,
-	"whiskey":            0,
-	"whisper":            0,
-	"whistle":            0,
-	"white":              0,
-	"who":                0,
-	"who'd":              0,
-	"whole":              0,
-	"who'll":             0,
-	"whom":               0,
-	"who's":              0,
-	"whose":              0,
-	"why":                0,
-	"wicked":             0,
-	"wide":               0,
-	"wife":               0,
-	"wiggle":             0,
-	"wild":               0,
-	"wildcat":            0,
-	"will":               0,
-	"willing":            0,
-	"willow":             0,
-	"win":                0,
-	"wind":               0,
-	"windy":              0,
-	"windmill":           0,
-	"window":             0,
-	"wine":               0,
-	"wing":               0,
-	"wink":               0,
-	"winner":             0,
-	"winter":             0,
-	"wipe":               0,
-	"wire":               0,
-	"wise":               0,
-	"wish":               0,
-	"wit":                0,
-	"witch":              0,
-	"with":               0,
-	"without":            0,
-	"woke":               0,
-	"wolf":               0,
-	"woman":              0,
-	"women":              0,
-	"won":                0,
-	"wonder":             0,
-	"wonderful":          0,
-	"won't":              0,
-	"wood":               0,
-	"wooden":             0,
-	"woodpecker":         0,
-	"woods":              0,
-	"wool":               0,
-	"woolen":             0,
-	"word":               0,
-	"wore":               0,
-	"work":               0,
-	"worker":             0,
-	"workman":            0,
-	"world":              0,
-	"worm":               0,
-	"worn":               0,
-	"worry":              0,
-	"worse":              0,
-	"worst":              0,
-	"worth":              0,
-	"would":              0,
-	"wouldn't":           0,
-	"wound":              0,
-	"wove":               0,
-	"wrap":               0,
-	"wrapped":            0,
-	"wreck":              0,
-	"wren":               0,
-	"wring":              0,
-	"write":              0,
-	"writing":            0,
-	"written":            0,
-	"wrong":              0,
-	"wrote":              0,
-	"wrung":              0,
-	"yard":               0,
-	"yarn":               0,
-	"year":               0,
-	"yell":               0,
-	"yellow":             0,
-	"yes":                0,
-	"yesterday":          0,
-	"yet":                0,
-	"yolk":               0,
-	"yonder":             0,
-	"you":                0,
-	"you'd":              0,
-	"you'll":             0,
-	"young":              0,
-	"youngster":          0,
-	"your":               0,
-	"yours":              0,
-	"you're":             0,
-	"yourself":           0,
-	"yourselves":         0,
-}
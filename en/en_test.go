@@ -0,0 +1,33 @@
+package en_test
+
+import (
+	"goreadability/en"
+	"goreadability/stats"
+	"goreadability/wordlists"
+	"testing"
+)
+
+func TestCalcDCRStemmer(t *testing.T) {
+	// "boys", "jumped", "played", and "walked" are not in the Dale–Chall list, but their stems are.
+	text := "The boys jumped and played. They walked home quickly."
+	// The configured familiar words match the forms of the words by their own stemmer, and "quickly" has the stem "quickli".
+	familiar := wordlists.NewWordList([]string{"the", "boy", "jump", "and", "play", "they", "walk", "home", "quick"}, stats.PorterStemmer{})
+	tests := []struct {
+		name      string
+		opts      []stats.Option
+		difficult uint
+		want      float64
+	}{
+		{"without a stemmer", nil, 4, 10.88},
+		{"with the Porter stemmer", []stats.Option{stats.WithStemmer(stats.PorterStemmer{})}, 0, 0.22},
+		{"with familiar words", []stats.Option{stats.WithFamiliarWords(familiar.Contains), stats.WithStemmer(stats.PorterStemmer{})}, 1, 5.61},
+	}
+	for _, test := range tests {
+		if got := en.CountDifficultWords(text, test.opts...); got != test.difficult {
+			t.Errorf("CountDifficultWords() %s = %d, want %d", test.name, got, test.difficult)
+		}
+		if got, err := en.CalcDCR(text, test.opts...); err != nil || got != test.want {
+			t.Errorf("CalcDCR() %s = %v, %v, want %v", test.name, got, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Package `es` provides functions and types to calculate the readability for texts in Spanish language.
+// 1. Gutiérrez de Polini comprehensibility formula
+// 2. Crawford formula (years of schooling) for elementary texts
+package es
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+	"strings"
+)
+
+// CalcGutierrezDePolini accepts a non-empty string and returns the Gutiérrez de Polini comprehensibility score for it. The string must contain at least one word (a number is considered a word, for example `18.` is valid string) and at least one sentence.
+// The formula was designed for school texts and uses only letters, words, and sentences: 95.2 - 9.7 * (letters / words) - 0.35 * (words / sentences).
+// The calculated score is rounded to the first decimal point.
+func CalcGutierrezDePolini(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcGutierrezDePoliniDoc(newDocument(s, opts))
+}
+
+// CalcGutierrezDePoliniRaw accepts a non-empty string and options and returns the Gutiérrez de Polini comprehensibility score for it without rounding. See `CalcGutierrezDePolini`.
+func CalcGutierrezDePoliniRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return gutierrezDePoliniRaw(newDocument(s, opts))
+}
+
+// CalcGutierrezDePoliniDoc accepts a Document and returns the Gutiérrez de Polini comprehensibility score for it. See `CalcGutierrezDePolini`.
+func CalcGutierrezDePoliniDoc(doc *stats.Document) (float64, error) {
+	score, err := gutierrezDePoliniRaw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(score*10) / 10, nil
+}
+
+// gutierrezDePoliniRaw returns the Gutiérrez de Polini comprehensibility score without rounding.
+func gutierrezDePoliniRaw(doc *stats.Document) (float64, error) {
+	words := float64(doc.Words())
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate Gutiérrez de Polini formula.")
+	}
+	sentences := float64(doc.Sentences())
+	if sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate Gutiérrez de Polini formula.")
+	}
+	// The formula counts letters, so the digits and the apostrophes do not make the words longer (see `stats.CountLetters`).
+	letters := float64(doc.Letters())
+
+	score := 95.2 - 9.7*(letters/words) - 0.35*(words/sentences)
+	return score, nil
+}
+
+// CalcCrawford accepts a non-empty string and returns the Crawford formula for it, that is the number of years of schooling required to understand the text. The formula is designed for Spanish primary-level texts. The string must contain at least one word and at least one sentence.
+// The formula is -0.205 * OP + 0.049 * SP - 3.407, where OP is the number of sentences per 100 words and SP is the number of syllables per 100 words.
+// The calculated result is rounded to the first decimal point.
+func CalcCrawford(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcCrawfordDoc(newDocument(s, opts))
+}
+
+// CalcCrawfordRaw accepts a non-empty string and options and returns the Crawford formula for it without rounding. See `CalcCrawford`.
+func CalcCrawfordRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return crawfordRaw(newDocument(s, opts))
+}
+
+// CalcCrawfordDoc accepts a Document and returns the Crawford formula for it. See `CalcCrawford`.
+func CalcCrawfordDoc(doc *stats.Document) (float64, error) {
+	crawford, err := crawfordRaw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(crawford*10) / 10, nil
+}
+
+// crawfordRaw returns the Crawford formula without rounding.
+func crawfordRaw(doc *stats.Document) (float64, error) {
+	words := float64(doc.Words())
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate Crawford formula.")
+	}
+	sentences := float64(doc.Sentences())
+	if sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate Crawford formula.")
+	}
+	syllables := float64(doc.Syllables())
+
+	sentencesPer100 := sentences / words * 100
+	syllablesPer100 := syllables / words * 100
+
+	crawford := -0.205*sentencesPer100 + 0.049*syllablesPer100 - 3.407
+	return crawford, nil
+}
+
+// CountSyllables accepts a string that represents a Spanish word and returns the number of syllables in it.
+// Every group of adjacent vowels is counted as one syllable (a diphthong or a triphthong), except for two strong vowels (`a`, `e`, `o`) in a row and a stressed weak vowel (`í`, `ú`) next to a strong one, which are counted as a hiatus.
+// A word without vowels (for example, a number) counts as one syllable.
+func CountSyllables(s string) uint {
+	var syllables uint
+	var prev rune
+	for _, char := range strings.ToLower(s) {
+		if !isVowel(char) {
+			prev = 0
+			continue
+		}
+		if prev == 0 || isHiatus(prev, char) {
+			syllables++
+		}
+		prev = char
+	}
+
+	if syllables == 0 {
+		syllables++
+	}
+	return syllables
+}
+
+// isVowel reports whether the rune is a Spanish vowel.
+func isVowel(char rune) bool {
+	return strings.ContainsRune("aeiouáéíóúü", char)
+}
+
+// isStrongVowel reports whether the rune is a strong (open) Spanish vowel.
+func isStrongVowel(char rune) bool {
+	return strings.ContainsRune("aeoáéó", char)
+}
+
+// isHiatus reports whether two adjacent vowels belong to different syllables.
+func isHiatus(prev, char rune) bool {
+	if isStrongVowel(prev) && isStrongVowel(char) {
+		return true
+	}
+	stressedWeak := func(c rune) bool { return c == 'í' || c == 'ú' }
+	return (stressedWeak(prev) && isStrongVowel(char)) || (isStrongVowel(prev) && stressedWeak(char))
+}
+
+// init registers `CountSyllables` as the syllable counter of Spanish texts (see `stats.RegisterSyllableCounter`).
+func init() {
+	stats.RegisterSyllableCounter("es", stats.SyllableCounterFunc(CountSyllables))
+}
+
+// newDocument returns a Document for a Spanish text, with the given options applied over the language defaults.
+func newDocument(s string, opts []stats.Option) *stats.Document {
+	return stats.NewDocument(s, append([]stats.Option{stats.WithLanguage("es")}, opts...)...)
+}
@@ -0,0 +1,67 @@
+package es_test
+
+import (
+	"goreadability/es"
+	"goreadability/stats"
+	"testing"
+)
+
+func TestCalcGutierrezDePoliniLetters(t *testing.T) {
+	// 18 letters in 5 words: the digits of the numbers are not letters.
+	text := "En 2024 vinieron 15000 turistas."
+	if got, err := es.CalcGutierrezDePolini(text); err != nil || got != 58.5 {
+		t.Errorf("CalcGutierrezDePolini(%q) = %v, %v, want 58.5", text, got, err)
+	}
+	if got, err := es.CalcGutierrezDePoliniDoc(stats.NewDocument(text)); err != nil || got != 58.5 {
+		t.Errorf("CalcGutierrezDePoliniDoc(%q) = %v, %v, want 58.5", text, got, err)
+	}
+}
+
+func TestCountSyllables(t *testing.T) {
+	tests := []struct {
+		word string
+		want uint
+	}{
+		{"casa", 2},
+		{"cuidado", 3},  // a diphthong of two weak vowels
+		{"tiene", 2},    // a diphthong of a weak and a strong vowel
+		{"poeta", 3},    // a hiatus of two strong vowels
+		{"aéreo", 4},    // two hiatuses
+		{"país", 2},     // a hiatus with a stressed weak vowel
+		{"río", 2},      // a hiatus with a stressed weak vowel
+		{"buey", 1},     // "y" is not a vowel
+		{"queso", 2},    // the "u" of "qu" is silent
+		{"quiero", 2},   // as in "qui"
+		{"guerra", 2},   // and so is the "u" of "gue"
+		{"pingüino", 3}, // but not the "ü" of "güi"
+		{"Árbol", 2},
+		{"2024", 1},
+	}
+	for _, tt := range tests {
+		if got := es.CountSyllables(tt.word); got != tt.want {
+			t.Errorf("CountSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestCalcCrawford(t *testing.T) {
+	tests := []struct {
+		text string
+		want float64
+	}{
+		// 13 words, 1 sentence, and 22 syllables: -0.205 * 7.69 + 0.049 * 169.23 - 3.407 = 3.3.
+		{"Los niños pequeños juegan en el parque con sus amigos todos los días.", 3.3},
+		// 10 words, 2 sentences, and 26 syllables: -0.205 * 20 + 0.049 * 260 - 3.407 = 5.2.
+		{"Mi hermano estudia matemáticas en la universidad. Quiere ser ingeniero.", 5.2},
+	}
+	for _, tt := range tests {
+		if got, err := es.CalcCrawford(tt.text); err != nil || got != tt.want {
+			t.Errorf("CalcCrawford(%q) = %v, %v, want %v", tt.text, got, err, tt.want)
+		}
+	}
+	for _, text := range []string{"", "Hola"} {
+		if _, err := es.CalcCrawford(text); err == nil {
+			t.Errorf("CalcCrawford(%q) returned no error", text)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Package `fr` provides functions and types to calculate the readability for texts in French language.
+// 1. Kandel–Moles adaptation of Flesch reading ease score
+package fr
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+	"strings"
+)
+
+// CalcKandelMoles accepts a non-empty string and returns the Kandel–Moles readability score, the French adaptation of the Flesch reading ease score. The string must contain at least one word (a number is considered a word, for example `18.` is a valid string) and at least one sentence.
+// The formula is 207 - 1.015 * (words / sentences) - 73.6 * (syllables / words).
+// The calculated score is rounded to the first decimal point.
+func CalcKandelMoles(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcKandelMolesDoc(newDocument(s, opts))
+}
+
+// CalcKandelMolesRaw accepts a non-empty string and options and returns the Kandel–Moles readability score for it without rounding. See `CalcKandelMoles`.
+func CalcKandelMolesRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return kandelMolesRaw(newDocument(s, opts))
+}
+
+// CalcKandelMolesDoc accepts a Document and returns the Kandel–Moles readability score for it. See `CalcKandelMoles`.
+func CalcKandelMolesDoc(doc *stats.Document) (float64, error) {
+	score, err := kandelMolesRaw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(score*10) / 10, nil
+}
+
+// kandelMolesRaw returns the Kandel–Moles readability score without rounding.
+func kandelMolesRaw(doc *stats.Document) (float64, error) {
+	words := float64(doc.Words())
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate Kandel–Moles readability score.")
+	}
+	sentences := float64(doc.Sentences())
+	if sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate Kandel–Moles readability score.")
+	}
+	syllables := float64(doc.Syllables())
+
+	score := 207 - 1.015*(words/sentences) - 73.6*(syllables/words)
+	return score, nil
+}
+
+// CountSyllables accepts a string that represents a French word and returns the number of syllables in it.
+// Every group of adjacent vowels is counted as one syllable, and a vowel with a diaeresis starts a new one ("Noël").
+// A final mute `e` (also in `-es` and in the verb endings `-ssent`, `-nnent`, `-ttent`) does not form a syllable unless it is the only vowel group of the word.
+// The parts of a hyphenated word ("belle-mère") are counted separately, and an elided article or pronoun has no syllables of its own ("l'homme" has one syllable).
+// A word without vowels (for example, a number) counts as one syllable.
+func CountSyllables(s string) uint {
+	var syllables uint
+	parts := strings.FieldsFunc(strings.ToLower(s), func(c rune) bool { return c == '\'' || c == '’' })
+	for i, part := range parts {
+		if i < len(parts)-1 && isElision(part) {
+			continue
+		}
+		for _, word := range strings.Split(part, "-") {
+			syllables += countPartSyllables([]rune(word))
+		}
+	}
+
+	if syllables == 0 {
+		syllables++
+	}
+	return syllables
+}
+
+// countPartSyllables returns the number of syllables of a word without hyphens and apostrophes in lower case, 0 if it has no vowels. See `CountSyllables`.
+func countPartSyllables(word []rune) uint {
+	var syllables uint
+	prevIsVowel := false
+	for _, char := range word {
+		if isVowel(char) {
+			if !prevIsVowel || isDiaeresis(char) {
+				syllables++
+			}
+			prevIsVowel = true
+		} else {
+			prevIsVowel = false
+		}
+	}
+
+	if syllables > 1 && (hasMuteEnding(word) || hasMuteVerbEnding(word)) {
+		syllables--
+	}
+	return syllables
+}
+
+// isElision reports whether the word is an elided article or pronoun ("l", "d", "j", "qu") before an apostrophe, which has no vowel of its own.
+func isElision(word string) bool {
+	switch word {
+	case "c", "d", "j", "l", "m", "n", "s", "t", "qu":
+		return true
+	}
+	return false
+}
+
+// hasMuteEnding reports whether the word ends with a mute `e` or `es` preceded by a consonant.
+func hasMuteEnding(word []rune) bool {
+	n := len(word)
+	if n >= 2 && word[n-1] == 'e' && !isVowel(word[n-2]) {
+		return true
+	}
+	if n >= 3 && word[n-2] == 'e' && word[n-1] == 's' && !isVowel(word[n-3]) {
+		return true
+	}
+	return false
+}
+
+// hasMuteVerbEnding reports whether the word ends with the mute `ent` of a verb after "ss", "nn", or "tt" ("finissent", "viennent", "mettent").
+// The other `-ent` endings are pronounced in the nouns and adverbs ("moment", "souvent") and are not distinguished from the verbs ("parlent").
+func hasMuteVerbEnding(word []rune) bool {
+	n := len(word)
+	if n < 5 || string(word[n-3:]) != "ent" {
+		return false
+	}
+	switch string(word[n-5 : n-3]) {
+	case "ss", "nn", "tt":
+		return true
+	}
+	return false
+}
+
+// isDiaeresis reports whether the rune is a vowel with a diaeresis (tréma), which is pronounced apart from the previous vowel ("Noël", "naïf").
+func isDiaeresis(char rune) bool {
+	return strings.ContainsRune("ëïüÿ", char)
+}
+
+// isVowel reports whether the rune is a French vowel.
+func isVowel(char rune) bool {
+	return strings.ContainsRune("aeiouyàâäéèêëîïôöùûüÿæœ", char)
+}
+
+// init registers `CountSyllables` as the syllable counter of French texts (see `stats.RegisterSyllableCounter`).
+func init() {
+	stats.RegisterSyllableCounter("fr", stats.SyllableCounterFunc(CountSyllables))
+}
+
+// newDocument returns a Document for a French text, with the given options applied over the language defaults.
+func newDocument(s string, opts []stats.Option) *stats.Document {
+	return stats.NewDocument(s, append([]stats.Option{stats.WithLanguage("fr")}, opts...)...)
+}
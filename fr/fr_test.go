@@ -0,0 +1,60 @@
+package fr_test
+
+import (
+	"goreadability/fr"
+	"testing"
+)
+
+func TestCountSyllables(t *testing.T) {
+	tests := []struct {
+		word string
+		want uint
+	}{
+		{"chat", 1},
+		{"maison", 2}, // "ai" and "o" are vowel groups
+		{"oiseau", 2},
+		{"le", 1}, // a mute "e" that is the only vowel group
+		{"homme", 1},
+		{"tables", 1},
+		{"finissent", 2},
+		{"viennent", 1},
+		{"mettent", 1},
+		{"moment", 2}, // the "-ent" of nouns and adverbs is pronounced
+		{"souvent", 2},
+		{"l'homme", 1}, // elided articles and pronouns have no syllables
+		{"qu'il", 1},
+		{"j’aime", 1},
+		{"aujourd'hui", 3},
+		{"belle-mère", 2},
+		{"Noël", 2}, // a diaeresis separates the vowels
+		{"naïf", 2},
+		{"18", 1},
+	}
+	for _, tt := range tests {
+		if got := fr.CountSyllables(tt.word); got != tt.want {
+			t.Errorf("CountSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestCalcKandelMoles(t *testing.T) {
+	tests := []struct {
+		text string
+		want float64
+	}{
+		// 10 words, 2 sentences, and 12 syllables: 207 - 1.015 * 5 - 73.6 * 1.2 = 113.6.
+		{"Le chat dort sur le lit. Il fait beau aujourd'hui.", 113.6},
+		// 8 words, 1 sentence, and 13 syllables: 207 - 1.015 * 8 - 73.6 * 1.625 = 79.3.
+		{"Les enfants finissent leurs devoirs avant le dîner.", 79.3},
+	}
+	for _, tt := range tests {
+		if got, err := fr.CalcKandelMoles(tt.text); err != nil || got != tt.want {
+			t.Errorf("CalcKandelMoles(%q) = %v, %v, want %v", tt.text, got, err, tt.want)
+		}
+	}
+	for _, text := range []string{"", "Bonjour"} {
+		if _, err := fr.CalcKandelMoles(text); err == nil {
+			t.Errorf("CalcKandelMoles(%q) returned no error", text)
+		}
+	}
+}
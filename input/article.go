@@ -0,0 +1,313 @@
+package input
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// Article represents the main content of a web page: its title and the Content of the article without the boilerplate around it.
+type Article struct {
+	Title string `json:"title"`
+	Content
+}
+
+// articleNode represents an element of a web page while its content is scored, with the byte range of its content.
+type articleNode struct {
+	name   string
+	parent int
+	start  int
+	end    int
+	// weight is the bonus or penalty of the element by its tag, class, and ID.
+	weight float64
+	// text, links, and commas are the numbers of characters, characters in links, and commas in the element and its descendants.
+	text, links, commas int
+	// ownText and ownCommas are the numbers of characters and commas in the text of the block itself, not in its nested blocks.
+	ownText, ownCommas int
+	// score is the sum of the scores of the paragraphs in the element.
+	score float64
+}
+
+// articleUnlikely are the parts of the classes and IDs of the elements that are unlikely to be content: comments, sidebars, share buttons, and so on.
+var articleUnlikely = []string{
+	"banner", "breadcrumb", "combx", "comment", "community", "disqus", "footer", "gdpr", "header", "menu", "pager", "pagination", "popup", "related",
+	"remark", "replies", "rss", "share", "shoutbox", "sidebar", "skyscraper", "social", "sponsor", "subscribe",
+}
+
+// articleMaybe are the parts of the classes and IDs that keep an element with an unlikely class ("article-header", "main-comments") a candidate.
+var articleMaybe = []string{"and", "article", "body", "column", "content", "main"}
+
+// articlePositive and articleNegative are the parts of the classes and IDs that make an element more or less likely to hold the article.
+var (
+	articlePositive = []string{"article", "blog", "body", "content", "entry", "main", "page", "post", "story", "text"}
+	articleNegative = []string{
+		"banner", "comment", "contact", "foot", "masthead", "media", "meta", "outbrain", "promo", "related", "scroll", "share", "shoutbox", "sidebar",
+		"skyscraper", "sponsor", "shopping", "tags", "tool", "widget",
+	}
+)
+
+// ====== Functions ======
+
+// ExtractArticle accepts an HTML page and the options for its headings, list items, and table cells and returns its Article: the title of the page
+// (the "og:title" meta property, the "title" element, or the first heading) and the content of the element that most likely holds the article, extracted as in `ExtractHTML`.
+// Every block with at least 25 characters is a paragraph that scores its parent and, by half, its grandparent, by its length and commas.
+// The scores are weighted by the tags, classes, and IDs of the elements ("content" and "post" raise them, "sidebar" and "comment" lower them)
+// and by the share of the text outside of links, and the best element wins, together with its siblings that score at least a fifth of it.
+// The elements unlikely to be content (by their class or ID) and the boilerplate dropped by `ExtractHTML` are removed first.
+// A page without paragraphs is extracted as a whole.
+func ExtractArticle(s string, bo BlockOptions) Article {
+	article := Article{Title: pageTitle(s, bo)}
+	nodes, removed := scoreArticleNodes(s)
+
+	best := -1
+	scores := make([]float64, len(nodes))
+	for i, node := range nodes {
+		if node.score == 0 {
+			continue
+		}
+		scores[i] = (node.score + node.weight) * (1 - float64(node.links)/float64(max(node.text, 1)))
+		if best < 0 || scores[i] > scores[best] {
+			best = i
+		}
+	}
+	if best < 0 {
+		article.Content = ExtractHTML(s, bo)
+		return article
+	}
+
+	var b strings.Builder
+	threshold := max(10, scores[best]/5)
+	for i, node := range nodes {
+		if i == best || (node.parent == nodes[best].parent && node.parent >= 0 && scores[i] >= threshold) {
+			// An article element keeps the headers of the content (see `ExtractHTML`).
+			b.WriteString("<article>")
+			b.WriteString(cutRanges(s, node.start, node.end, removed))
+			b.WriteString("</article>")
+		}
+	}
+	article.Content = ExtractHTML(b.String(), bo)
+	return article
+}
+
+// scoreArticleNodes parses the HTML page and returns its elements in the order of the document with their scores,
+// and the byte ranges of the removed elements.
+func scoreArticleNodes(s string) ([]articleNode, [][2]int) {
+	var nodes []articleNode
+	var removed [][2]int
+	// open are the indices of the open elements, and skipped are the names of the open removed elements.
+	var open []int
+	var skipped []string
+	skippedStart, content, links := 0, 0, 0
+
+	closeNode := func(index, end int) {
+		node := &nodes[index]
+		node.end = end
+		if node.ownText < 25 || node.parent < 0 {
+			return
+		}
+		score := 1 + float64(node.ownCommas) + min(float64(node.ownText)/100, 3)
+		nodes[node.parent].score += score
+		if grandparent := nodes[node.parent].parent; grandparent >= 0 {
+			nodes[grandparent].score += score / 2
+		}
+	}
+	text := func(t string) {
+		if len(skipped) > 0 || len(open) == 0 {
+			return
+		}
+		t = html.UnescapeString(t)
+		length := utf8.RuneCountInString(strings.Join(strings.Fields(t), " "))
+		if length == 0 {
+			return
+		}
+		commas := strings.Count(t, ",") + strings.Count(t, "，")
+		block := -1
+		for _, index := range open {
+			node := &nodes[index]
+			node.text += length
+			node.commas += commas
+			if links > 0 {
+				node.links += length
+			}
+			if htmlBlocks[node.name] {
+				block = index
+			}
+		}
+		if block >= 0 {
+			nodes[block].ownText += length
+			nodes[block].ownCommas += commas
+		}
+	}
+
+	for i := 0; i < len(s); {
+		lt := strings.IndexByte(s[i:], '<')
+		if lt < 0 {
+			text(s[i:])
+			break
+		}
+		text(s[i : i+lt])
+		i += lt
+
+		switch {
+		case strings.HasPrefix(s[i:], "<!--"):
+			i = skipPast(s, i+4, "-->")
+			continue
+		case strings.HasPrefix(s[i:], "<!"), strings.HasPrefix(s[i:], "<?"):
+			i = skipPast(s, i, ">")
+			continue
+		}
+
+		tagStart := i
+		name, attrs, closing, end := parseTag(s, i)
+		if name == "" {
+			text("<")
+			i++
+			continue
+		}
+		i = end
+		selfClosing := strings.HasSuffix(attrs, "/") || htmlVoid[name]
+
+		if len(skipped) > 0 {
+			if name == skipped[len(skipped)-1] && closing {
+				skipped = skipped[:len(skipped)-1]
+				if len(skipped) == 0 {
+					removed = append(removed, [2]int{skippedStart, end})
+				}
+			} else if name == skipped[len(skipped)-1] && !selfClosing {
+				skipped = append(skipped, name)
+			}
+			continue
+		}
+		if closing {
+			for j := len(open) - 1; j >= 0; j-- {
+				if nodes[open[j]].name != name {
+					continue
+				}
+				// The elements left open inside the element are closed by it.
+				for k := len(open) - 1; k >= j; k-- {
+					closeNode(open[k], tagStart)
+					switch nodes[open[k]].name {
+					case "a":
+						links--
+					case "article", "main":
+						content--
+					}
+				}
+				open = open[:j]
+				break
+			}
+			continue
+		}
+
+		classes := strings.ToLower(htmlAttribute(attrs, "class") + " " + htmlAttribute(attrs, "id"))
+		switch {
+		case htmlRawText[name]:
+			i = skipRawText(s, i, name)
+			removed = append(removed, [2]int{tagStart, i})
+			continue
+		case htmlSkipped[name], name == "header" && content == 0, isHiddenHTML(attrs),
+			name != "html" && name != "body" && name != "article" && name != "main" && containsAny(classes, articleUnlikely) && !containsAny(classes, articleMaybe):
+			if selfClosing {
+				removed = append(removed, [2]int{tagStart, end})
+			} else {
+				skipped = append(skipped, name)
+				skippedStart = tagStart
+			}
+			continue
+		case selfClosing:
+			continue
+		case name == "a":
+			links++
+		case name == "article", name == "main":
+			content++
+		case name == "p" && len(open) > 0 && nodes[open[len(open)-1]].name == "p":
+			// A paragraph ends the open paragraph.
+			closeNode(open[len(open)-1], tagStart)
+			open = open[:len(open)-1]
+		}
+
+		parent := -1
+		if len(open) > 0 {
+			parent = open[len(open)-1]
+		}
+		nodes = append(nodes, articleNode{name: name, parent: parent, start: end, weight: articleWeight(name, classes)})
+		open = append(open, len(nodes)-1)
+	}
+	if len(skipped) > 0 {
+		removed = append(removed, [2]int{skippedStart, len(s)})
+	}
+	for k := len(open) - 1; k >= 0; k-- {
+		closeNode(open[k], len(s))
+	}
+	return nodes, removed
+}
+
+// articleWeight returns the bonus or penalty of the element for holding the article, by its tag and by its classes and ID in lower case.
+func articleWeight(name, classes string) float64 {
+	var weight float64
+	switch name {
+	case "div", "article", "main":
+		weight = 5
+	case "pre", "td", "blockquote":
+		weight = 3
+	case "address", "ol", "ul", "dl", "dd", "dt", "li", "form":
+		weight = -3
+	case "h1", "h2", "h3", "h4", "h5", "h6", "th":
+		weight = -5
+	}
+	if containsAny(classes, articlePositive) {
+		weight += 25
+	}
+	if containsAny(classes, articleNegative) {
+		weight -= 25
+	}
+	return weight
+}
+
+// containsAny reports whether the string contains any of the substrings.
+func containsAny(s string, substrs []string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// cutRanges returns the part of the string between the indices without the sorted byte ranges in it.
+func cutRanges(s string, start, end int, ranges [][2]int) string {
+	var b strings.Builder
+	for _, r := range ranges {
+		if r[1] <= start || r[0] >= end {
+			continue
+		}
+		b.WriteString(s[start:max(r[0], start)])
+		start = min(r[1], end)
+	}
+	b.WriteString(s[start:end])
+	return b.String()
+}
+
+// pageTitle returns the title of an HTML page: its "og:title" meta property, which usually has no site name, or the title of the document (see `htmlTitle`).
+func pageTitle(s string, bo BlockOptions) string {
+	for i := 0; i < len(s); {
+		lt := strings.IndexByte(s[i:], '<')
+		if lt < 0 {
+			break
+		}
+		i += lt
+		name, attrs, _, end := parseTag(s, i)
+		i = max(end, i+1)
+		if name == "body" {
+			break
+		}
+		if name == "meta" && htmlAttribute(attrs, "property") == "og:title" {
+			if content := strings.TrimSpace(htmlAttribute(attrs, "content")); content != "" {
+				return content
+			}
+		}
+	}
+	return htmlTitle(s, bo)
+}
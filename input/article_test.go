@@ -0,0 +1,29 @@
+package input_test
+
+import (
+	"goreadability/input"
+	"testing"
+)
+
+func TestExtractArticle(t *testing.T) {
+	page := string(readTestdata(t, "article.html"))
+	checkGolden(t, "article", input.ExtractArticle(page, input.BlockOptions{}))
+	checkGolden(t, "article_separated", input.ExtractArticle(page, input.BlockOptions{Headings: input.ExcludeBlocks, ListItems: input.SeparateBlocks}))
+
+	// A page without paragraphs is extracted as a whole.
+	short := "<title>Short</title><p>Hi.</p>"
+	if got := input.ExtractArticle(short, input.BlockOptions{}); got.Title != "Short" || got.Text != "Hi." {
+		t.Errorf("ExtractArticle(%q) = %+v, want the title \"Short\" and the text \"Hi.\"", short, got)
+	}
+}
+
+func FuzzExtractArticle(f *testing.F) {
+	f.Add(readTestdata(f, "article.html"))
+	f.Add(readTestdata(f, "page.html"))
+	f.Add([]byte(`<div class="content"><div><p>` + "A long enough paragraph, with commas, to score." + `</p><div><p>Nested, unclosed`))
+	f.Fuzz(func(t *testing.T, page []byte) {
+		article := input.ExtractArticle(string(page), input.BlockOptions{})
+		checkText(t, page, article.Title)
+		checkText(t, page, article.Text)
+	})
+}
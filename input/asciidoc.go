@@ -0,0 +1,328 @@
+package input
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// asciidocSkippedStyles are the AsciiDoc block styles ("[source,go]") whose blocks are not running text: code, math, and diagrams.
+var asciidocSkippedStyles = toSet("source", "listing", "literal", "stem", "latexmath", "asciimath", "plantuml", "graphviz", "ditaa", "mermaid", "comment", "pass")
+
+// asciidocAdmonitions are the labels of the AsciiDoc admonition paragraphs ("NOTE: text").
+var asciidocAdmonitions = []string{"NOTE: ", "TIP: ", "IMPORTANT: ", "WARNING: ", "CAUTION: "}
+
+// asciidocTextMacros are the AsciiDoc inline macros replaced with their texts ("link:url[text]" is "text"). Other macros are removed.
+var asciidocTextMacros = toSet("link", "mailto", "xref", "http", "https", "ftp", "irc", "pass", "kbd", "btn", "menu")
+
+// ====== Functions ======
+
+// FromAsciiDoc accepts an AsciiDoc document and the options for its headings, list items, and table cells and returns its text. See `ExtractAsciiDoc`.
+func FromAsciiDoc(s string, bo BlockOptions) string {
+	return ExtractAsciiDoc(s, bo).Text
+}
+
+// ExtractAsciiDoc accepts an AsciiDoc document and the options for its headings, list items, and table cells and returns its Content.
+// The comments, attribute entries, block attributes and anchors, block macros ("image::", "include::"), listing, literal, passthrough, and comment blocks,
+// literal (indented) paragraphs, and the blocks of the code and math styles ("[source]", "[stem]") are removed.
+// The content of the admonitions ("NOTE: text", "[NOTE]" blocks), examples, sidebars, and quotes is kept as paragraphs.
+// Inline monospace text, footnotes, images, math, attribute references, and cross references without a text are removed,
+// and links and cross references are replaced with their texts. The document and section titles and the block titles (".Title") are headings,
+// the items of lists (including description lists) are list items, and the cells of tables are table cells.
+func ExtractAsciiDoc(s string, bo BlockOptions) Content {
+	c := lineConverter{w: blockWriter{bo: bo}, inline: asciidocInline}
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+
+	// delimiter is the delimiter line of the removed block the line is in, or empty.
+	var delimiter string
+	// skipBlock is true if the next block has a style that is removed, and skipParagraph if the current paragraph is removed.
+	skipBlock, skipParagraph, inTable := false, false, false
+	for _, line := range lines {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		content := strings.TrimLeftFunc(line, unicode.IsSpace)
+		if delimiter != "" {
+			if content == delimiter {
+				delimiter = ""
+			}
+			continue
+		}
+		if inTable {
+			if strings.HasSuffix(content, "===") && len(content) == 4 {
+				inTable = false
+				c.flush()
+				continue
+			}
+			for _, cell := range asciidocTableCells(content) {
+				c.start(TableCellBlock, cell)
+			}
+			continue
+		}
+		if content == "" {
+			c.flush()
+			skipParagraph = false
+			continue
+		}
+		if skipParagraph || (strings.HasPrefix(content, "//") && !strings.HasPrefix(content, "////")) {
+			continue
+		}
+
+		switch {
+		case isAsciiDocDelimiter(content):
+			c.flush()
+			if skipBlock || strings.Contains("-.+/`", content[:1]) {
+				// A listing, literal, passthrough, or comment block, a fenced code block, or a block of a removed style.
+				delimiter = content
+			}
+			skipBlock = false
+			continue
+		case content == "'''" || content == "<<<":
+			// A thematic break or a page break.
+			c.flush()
+			continue
+		case len(content) == 4 && strings.HasSuffix(content, "===") && strings.ContainsRune("|,:!", rune(content[0])):
+			c.flush()
+			inTable = true
+			skipBlock = false
+			continue
+		case c.kind == "" && strings.HasPrefix(content, "[") && strings.HasSuffix(content, "]"):
+			// Block attributes ("[source,go]", "[NOTE]", "[quote, Author]") or an anchor ("[[id]]").
+			style, _, _ := strings.Cut(strings.Trim(content, "[]"), ",")
+			style, _, _ = strings.Cut(style, "#")
+			skipBlock = asciidocSkippedStyles[strings.ToLower(strings.TrimSpace(style))]
+			continue
+		case c.kind == "" && isAsciiDocBlockMacro(content):
+			continue
+		case isRSTFieldList(content):
+			// An attribute entry (":toc: left"), which has the syntax of a reStructuredText field.
+			continue
+		case c.kind == "" && (skipBlock || (line != content && !isAsciiDocListItem(content))):
+			// A paragraph of a removed style, or a literal paragraph.
+			skipParagraph = true
+			skipBlock = false
+			continue
+		case content == "+":
+			// A list continuation.
+			c.flush()
+			continue
+		}
+
+		if level := asciidocHeadingLevel(content); level > 0 && c.kind == "" {
+			c.start(HeadingBlock, strings.TrimSpace(content[level:]))
+			c.flush()
+			// The document title is followed by the header: the author, the revision, and the attribute entries.
+			skipParagraph = level == 1 && content[0] == '='
+			continue
+		}
+		if c.kind == "" && len(content) > 1 && content[0] == '.' && content[1] != '.' && content[1] != ' ' {
+			// A block title.
+			c.start(HeadingBlock, content[1:])
+			c.flush()
+			continue
+		}
+		for _, label := range asciidocAdmonitions {
+			if c.kind == "" && strings.HasPrefix(content, label) {
+				content = strings.TrimSpace(content[len(label):])
+			}
+		}
+		if item, ok := asciidocListItem(content); ok {
+			c.start(ListItemBlock, item)
+			continue
+		}
+		if c.kind == "" {
+			c.start(ParagraphBlock, content)
+			continue
+		}
+		c.continueBlock(content)
+	}
+	return c.w.content()
+}
+
+// isAsciiDocDelimiter reports whether the line delimits a block: four or more "-", ".", "+", "/", "=", "*", or "_", the open block ("--"), or a Markdown fence ("```").
+func isAsciiDocDelimiter(line string) bool {
+	if line == "--" || strings.HasPrefix(line, "```") {
+		return true
+	}
+	return len(line) >= 4 && strings.Contains("-.+/=*_", line[:1]) && strings.Trim(line, line[:1]) == ""
+}
+
+// isAsciiDocBlockMacro reports whether the line is a block macro ("image::diagram.png[]", "include::chapter.adoc[]", "toc::[]").
+func isAsciiDocBlockMacro(line string) bool {
+	name, target, ok := strings.Cut(line, "::")
+	if !ok || name == "" || !strings.HasSuffix(line, "]") || strings.IndexFunc(name, func(c rune) bool { return !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '-' && c != '_' }) >= 0 {
+		return false
+	}
+	bracket := strings.IndexByte(target, '[')
+	return bracket >= 0 && !strings.ContainsAny(target[:bracket], " \t")
+}
+
+// asciidocHeadingLevel returns the number of "=" (or "#") that start the document or section title ("== Section"), or 0 if the line is not a title.
+func asciidocHeadingLevel(line string) int {
+	if strings.HasPrefix(line, "#") {
+		return atxHeadingLevel(line)
+	}
+	level := len(line) - len(strings.TrimLeft(line, "="))
+	if level == 0 || level > 6 || level == len(line) || line[level] != ' ' {
+		return 0
+	}
+	return level
+}
+
+// asciidocListItem returns the text of the list item without its marker ("* ", "** ", "- ", ". ", "1. ") and checkbox ("[x] "), and false if the line is not a list item.
+// The term and the definition of a description list item ("CPU:: The processor") are separated by a colon.
+func asciidocListItem(line string) (string, bool) {
+	marker := len(line) - len(strings.TrimLeft(line, "*"))
+	if marker == 0 {
+		marker = len(line) - len(strings.TrimLeft(line, "."))
+	}
+	if marker > 0 && marker < len(line) && line[marker] == ' ' {
+		item := strings.TrimSpace(line[marker:])
+		for _, box := range []string{"[ ]", "[x]", "[X]", "[*]"} {
+			item = strings.TrimSpace(strings.TrimPrefix(item, box))
+		}
+		return item, true
+	}
+	if item, ok := listItem(line); ok {
+		return item, true
+	}
+	for _, separator := range []string{":::: ", "::: ", ":: ", ";; "} {
+		if term, definition, ok := strings.Cut(line+" ", separator); ok && term != "" && !strings.ContainsAny(term[len(term)-1:], ":;") {
+			if definition = strings.TrimSpace(definition); definition == "" {
+				return term, true
+			}
+			return term + ": " + definition, true
+		}
+	}
+	return "", false
+}
+
+// isAsciiDocListItem reports whether the line is a list item.
+func isAsciiDocListItem(line string) bool {
+	_, ok := asciidocListItem(line)
+	return ok
+}
+
+// asciidocTableCells returns the cells of the table row ("| One | Two"), without the cell specifiers ("2+|", "a|").
+func asciidocTableCells(row string) []string {
+	segments := strings.Split(row, "|")
+	var cells []string
+	for i, cell := range segments {
+		if i+1 < len(segments) && !strings.HasSuffix(cell, " ") {
+			// The specifier of the next cell is attached to its "|".
+			specifier := cell[strings.LastIndexByte(cell, ' ')+1:]
+			if strings.Trim(specifier, "0123456789.+*<>^adehlmsv") == "" {
+				cell = cell[:len(cell)-len(specifier)]
+			}
+		}
+		if cell = strings.TrimSpace(cell); cell != "" {
+			cells = append(cells, cell)
+		}
+	}
+	return cells
+}
+
+// asciidocInline returns the text of the line with the inline markup removed: monospace text, footnotes, images, math, attribute references, anchors, and emphasis.
+// Links and cross references are replaced with their texts.
+func asciidocInline(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && isASCIIPunctuation(s[i+1]):
+			b.WriteByte(s[i+1])
+			i += 2
+			continue
+		case c == '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end >= 0 {
+				i += 1 + end + 1
+				trimRemovedSpace(&b, s[i:])
+				continue
+			}
+		case strings.HasPrefix(s[i:], "<<"):
+			if end := strings.Index(s[i:], ">>"); end > 0 {
+				if _, text, ok := strings.Cut(s[i+2:i+end], ","); ok {
+					b.WriteString(asciidocInline(strings.TrimSpace(text)))
+				}
+				i += end + 2
+				continue
+			}
+		case strings.HasPrefix(s[i:], "[["):
+			if end := strings.Index(s[i:], "]]"); end > 0 {
+				i += end + 2
+				continue
+			}
+		case c == '{':
+			if end := strings.IndexByte(s[i:], '}'); end > 1 && strings.IndexFunc(s[i+1:i+end], func(c rune) bool {
+				return !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '-' && c != '_'
+			}) < 0 {
+				// An attribute reference.
+				i += end + 1
+				trimRemovedSpace(&b, s[i:])
+				continue
+			}
+		case isASCIILetter(c) && (i == 0 || !isASCIILetter(s[i-1])):
+			if name, target, text, end, ok := asciidocMacro(s, i); ok {
+				switch {
+				case name == "menu":
+					b.WriteString(target + " " + text)
+				case asciidocTextMacros[name]:
+					b.WriteString(asciidocInline(text))
+				default:
+					trimmed := strings.TrimRight(b.String(), " ")
+					b.Reset()
+					b.WriteString(trimmed)
+				}
+				i = end
+				continue
+			}
+		case c == '*' || c == '_':
+			run := len(s[i:]) - len(strings.TrimLeft(s[i:], string(c)))
+			if isEmphasisRun(s, i, run, c) {
+				i += run
+				continue
+			}
+			b.WriteString(s[i : i+run])
+			i += run
+			continue
+		}
+		b.WriteByte(c)
+		i++
+	}
+	return b.String()
+}
+
+// asciidocMacro parses the inline macro that starts at the index ("link:url[text]", "https://example.com[text]", "footnote:[text]")
+// and returns its name, target, and text (without the quotes and the named attributes), the index after the macro, and true.
+// Returns false if there is no macro at the index.
+func asciidocMacro(s string, i int) (string, string, string, int, bool) {
+	j := i
+	for j < len(s) && (isASCIILetter(s[j]) || (s[j] >= '0' && s[j] <= '9')) {
+		j++
+	}
+	if j == len(s) || s[j] != ':' {
+		return "", "", "", 0, false
+	}
+	name := s[i:j]
+	open := strings.IndexByte(s[j:], '[')
+	if open < 0 || strings.ContainsAny(s[j:j+open], " \t") {
+		return "", "", "", 0, false
+	}
+	target := strings.TrimPrefix(s[j+1:j+open], "//")
+	closing := strings.IndexByte(s[j+open:], ']')
+	if closing < 0 {
+		return "", "", "", 0, false
+	}
+	text := s[j+open+1 : j+open+closing]
+	if quoted := strings.HasPrefix(text, "\""); quoted {
+		if end := strings.IndexByte(text[1:], '"'); end >= 0 {
+			text = text[1 : end+1]
+		}
+	} else if comma := strings.IndexByte(text, ','); comma >= 0 && strings.Contains(text[comma:], "=") {
+		text = text[:comma]
+	}
+	if first, _ := utf8.DecodeRuneInString(text); first == '^' {
+		text = text[1:]
+	}
+	return strings.ToLower(name), target, strings.TrimSpace(text), j + open + closing + 1, true
+}
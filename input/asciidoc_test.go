@@ -0,0 +1,47 @@
+package input_test
+
+import (
+	"goreadability/input"
+	"testing"
+)
+
+func TestExtractAsciiDoc(t *testing.T) {
+	adoc := string(readTestdata(t, "guide.adoc"))
+	checkGolden(t, "guide_adoc", input.ExtractAsciiDoc(adoc, input.BlockOptions{}))
+	checkGolden(t, "guide_adoc_separated", input.ExtractAsciiDoc(adoc, input.BlockOptions{Headings: input.SeparateBlocks, ListItems: input.SeparateBlocks, TableCells: input.ExcludeBlocks}))
+}
+
+func TestFromAsciiDoc(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"paragraph lines", "One two\nthree.\n\nFour.", "One two three.\n\nFour."},
+		{"titles", "= Document\n\n== Section\n\nText.", "Document\n\nSection\n\nText."},
+		{"inline markup", "A *bold* and _italic_ word with `code`.", "A bold and italic word with."},
+		{"links", "See https://example.com[the site] and <<intro,the intro>>.", "See the site and the intro."},
+		{"comments and attributes", "// A comment\n:toc: left\nText.", "Text."},
+		{"listing block", "Text.\n\n----\ncode here\n----\n\nMore.", "Text.\n\nMore."},
+		{"source block", "[source,go]\n----\nfmt.Println()\n----\nText.", "Text."},
+		{"admonition", "NOTE: Be careful.", "Be careful."},
+		{"block title", ".Example\nText.", "Example\n\nText."},
+		{"list items", "* One\n* Two", "One\n\nTwo"},
+		{"table", "|===\n| A | B\n|===", "A\n\nB"},
+	}
+	for _, test := range tests {
+		if got := input.ExtractAsciiDoc(test.s, input.BlockOptions{}).Text; got != test.want {
+			t.Errorf("ExtractAsciiDoc() %s = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func FuzzExtractAsciiDoc(f *testing.F) {
+	f.Add(readTestdata(f, "guide.adoc"))
+	f.Add([]byte("= Title\n|===\n| a | b\n----\n"))
+	f.Add([]byte("link:open[<<x,\nfootnote:[`"))
+	f.Fuzz(func(t *testing.T, adoc []byte) {
+		content := input.ExtractAsciiDoc(string(adoc), input.BlockOptions{TableCells: input.SeparateBlocks})
+		checkText(t, adoc, content.Text)
+	})
+}
@@ -0,0 +1,81 @@
+package input
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ====== Types ======
+
+// chapterKeywords are the words that start the headings of the chapters of a plain text book, in lower case.
+var chapterKeywords = []string{"chapter", "book", "part"}
+
+// chapterHeadings are the headings of the chapters without numbers, in lower case.
+var chapterHeadings = toSet("prologue", "epilogue", "preface", "foreword", "introduction", "afterword", "interlude")
+
+// chapterNumbers are the English number words of the chapter headings ("Chapter Twenty-One"), in lower case.
+var chapterNumbers = toSet(
+	"one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen",
+	"sixteen", "seventeen", "eighteen", "nineteen", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety", "hundred",
+	"first", "second", "third", "fourth", "fifth", "sixth", "seventh", "eighth", "ninth", "tenth", "last",
+)
+
+// ====== Functions ======
+
+// SplitChapters accepts a plain text book and returns its chapters. A chapter starts with a heading on a line of its own: a keyword with a number
+// ("Chapter 1", "CHAPTER XII.", "Chapter Twenty-One: The Storm", "Part Two"), or a heading without a number ("Prologue", "Epilogue").
+// The number is Arabic, Roman, or English words. The headings are the titles of the chapters and are not in their texts,
+// and the text before the first heading (the title page) is a chapter without a title if it is not blank. Without headings, the whole text is one chapter.
+func SplitChapters(s string) []Chapter {
+	var chapters []Chapter
+	title, start := "", 0
+	flush := func(end int) {
+		if text := strings.TrimSpace(s[start:end]); text != "" {
+			chapters = append(chapters, Chapter{Title: title, Content: Content{Text: text}})
+		}
+	}
+	offset := 0
+	for _, line := range strings.SplitAfter(s, "\n") {
+		if heading := strings.TrimSpace(line); isChapterHeading(heading) {
+			flush(offset)
+			title, start = heading, offset+len(line)
+		}
+		offset += len(line)
+	}
+	flush(len(s))
+	return chapters
+}
+
+// isChapterHeading reports whether the trimmed line is the heading of a chapter. See `SplitChapters`.
+func isChapterHeading(line string) bool {
+	lower := strings.ToLower(strings.Trim(line, "#*_ "))
+	if len(strings.Fields(lower)) > 10 {
+		return false
+	}
+	if chapterHeadings[strings.TrimRight(lower, ".:")] {
+		return true
+	}
+	for _, keyword := range chapterKeywords {
+		rest, ok := strings.CutPrefix(lower, keyword+" ")
+		if !ok {
+			continue
+		}
+		field, after, _ := strings.Cut(strings.TrimSpace(rest), " ")
+		number := strings.TrimRight(field, ".:")
+		after = strings.TrimSpace(after)
+		// A title on the line of the number is separated from it: "Chapter 1: The Storm", "Chapter 1 — The Storm", but not "Chapter 1 was long".
+		if number == "" || after != "" && len(number) == len(field) && !strings.HasPrefix(after, "—") && !strings.HasPrefix(after, "–") && !strings.HasPrefix(after, "-") {
+			return false
+		}
+		if strings.IndexFunc(number, func(c rune) bool { return !unicode.IsDigit(c) }) < 0 || strings.Trim(number, "ivxlc") == "" {
+			return true
+		}
+		for _, word := range strings.Split(number, "-") {
+			if !chapterNumbers[word] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package input
+
+import "strings"
+
+// ====== Functions ======
+
+// StripCode accepts a text and returns it without the code: fenced code blocks ("```" or "~~~" lines and everything between them),
+// indented code blocks (the lines indented by four spaces or a tab after a blank line), and inline code spans ("`go test`").
+// Code tokens have no syllables or sentences of prose and distort the characters-per-word ratios of ARI and CLI, so the code should be stripped before counting.
+// The other text is kept as it is, and the code blocks are replaced with blank lines, so the paragraphs around them stay separated.
+// Unlike `FromMarkdown`, it can be used for any text with code, such as plain-text documentation, commit messages, or emails.
+func StripCode(s string) string {
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	kept := make([]string, 0, len(lines))
+	var fence string
+	inCode, prevBlank := false, true
+	for _, line := range lines {
+		indent, content := markdownIndent(line)
+		switch {
+		case fence != "":
+			if closesFence(line, fence) {
+				fence = ""
+				kept = append(kept, "")
+			}
+			continue
+		case indent < 4 && (strings.HasPrefix(content, "```") || strings.HasPrefix(content, "~~~")):
+			fence = content[:len(content)-len(strings.TrimLeft(content, content[:1]))]
+			kept = append(kept, "")
+			continue
+		case content == "":
+			prevBlank = true
+			kept = append(kept, "")
+			continue
+		case indent >= 4 && (prevBlank || inCode):
+			inCode = true
+			continue
+		}
+		inCode, prevBlank = false, false
+		kept = append(kept, stripInlineCode(line))
+	}
+	return strings.Join(kept, "\n")
+}
+
+// stripInlineCode returns the line without the inline code spans. A backtick without a matching one is kept.
+func stripInlineCode(line string) string {
+	if !strings.Contains(line, "`") {
+		return line
+	}
+	var b strings.Builder
+	for i := 0; i < len(line); {
+		if line[i] != '`' {
+			b.WriteByte(line[i])
+			i++
+			continue
+		}
+		run := len(line[i:]) - len(strings.TrimLeft(line[i:], "`"))
+		if end := strings.Index(line[i+run:], line[i:i+run]); end >= 0 {
+			i += run + end + run
+			continue
+		}
+		b.WriteString(line[i : i+run])
+		i += run
+	}
+	return b.String()
+}
@@ -0,0 +1,25 @@
+package input_test
+
+import (
+	"goreadability/input"
+	"strings"
+	"testing"
+)
+
+func TestStripCode(t *testing.T) {
+	checkGolden(t, "code", input.StripCode(string(readTestdata(t, "code.txt"))))
+}
+
+func FuzzStripCode(f *testing.F) {
+	f.Add(string(readTestdata(f, "code.txt")))
+	f.Add("```\nunclosed")
+	f.Add("``a`b``")
+	f.Fuzz(func(t *testing.T, s string) {
+		stripped := input.StripCode(s)
+		checkText(t, []byte(s), stripped)
+		// Only code is removed, so the lines are never more than in the text.
+		if strings.Count(stripped, "\n") > strings.Count(s, "\n") {
+			t.Errorf("StripCode(%q) = %q, has more lines", s, stripped)
+		}
+	})
+}
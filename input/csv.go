@@ -0,0 +1,108 @@
+package input
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ====== Types ======
+
+// CSVOptions represents how a column of a CSV file is read. Column and IDColumn are the names of the columns in the header (matched case-insensitively),
+// or their 1-based numbers ("3") if the file has no header or no column of the name. IDColumn is optional. Comma is the field delimiter, "," by default.
+type CSVOptions struct {
+	Column   string `json:"column"`
+	IDColumn string `json:"id_column,omitempty"`
+	Comma    rune   `json:"comma,omitempty"`
+	NoHeader bool   `json:"no_header,omitempty"`
+}
+
+// CSVRow represents the text of the column of a row of a CSV file, with the line of the row in the file and the value of its ID column.
+type CSVRow struct {
+	Line int    `json:"line"`
+	ID   string `json:"id,omitempty"`
+	Text string `json:"text"`
+}
+
+// ====== Functions ======
+
+// ExtractCSVColumnFile accepts a path to a CSV file and the options of its column and returns its rows. See `ExtractCSVColumn`.
+func ExtractCSVColumnFile(path string, co CSVOptions) ([]CSVRow, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ExtractCSVColumn(file, co)
+}
+
+// ExtractCSVColumn accepts a CSV file (RFC 4180) and the options of its column and returns the texts of the column, row by row. The rows with an empty text are skipped.
+// The reader is lenient: the rows may have different numbers of fields, and the quotes may appear in the unquoted fields. A byte order mark before the header is ignored.
+func ExtractCSVColumn(r io.Reader, co CSVOptions) ([]CSVRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	if co.Comma != 0 {
+		reader.Comma = co.Comma
+	}
+
+	var header []string
+	if !co.NoHeader {
+		var err error
+		if header, err = reader.Read(); err != nil {
+			if err == io.EOF {
+				return nil, errors.New("No header in the CSV file. Cannot extract the column.")
+			}
+			return nil, err
+		}
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	column, err := csvColumn(header, co.Column)
+	if err != nil {
+		return nil, err
+	}
+	idColumn := -1
+	if co.IDColumn != "" {
+		if idColumn, err = csvColumn(header, co.IDColumn); err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []CSVRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if column >= len(record) || strings.TrimSpace(record[column]) == "" {
+			continue
+		}
+		line, _ := reader.FieldPos(column)
+		row := CSVRow{Line: line, Text: strings.TrimSpace(record[column])}
+		if idColumn >= 0 && idColumn < len(record) {
+			row.ID = strings.TrimSpace(record[idColumn])
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// csvColumn returns the 0-based index of the column with the name in the header, or with the 1-based number.
+func csvColumn(header []string, name string) (int, error) {
+	for i, field := range header {
+		if strings.EqualFold(strings.TrimSpace(field), strings.TrimSpace(name)) {
+			return i, nil
+		}
+	}
+	if number, err := strconv.Atoi(strings.TrimSpace(name)); err == nil && number > 0 {
+		return number - 1, nil
+	}
+	return 0, fmt.Errorf("No column %q in the CSV header. Cannot extract the column.", name)
+}
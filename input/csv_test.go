@@ -0,0 +1,80 @@
+package input_test
+
+import (
+	"bytes"
+	"goreadability/input"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractCSVColumn(t *testing.T) {
+	for name, co := range map[string]input.CSVOptions{
+		"csv":           {Column: "review", IDColumn: "ID"},
+		"csv_no_header": {Column: "2", NoHeader: true},
+	} {
+		rows, err := input.ExtractCSVColumnFile("testdata/reviews.csv", co)
+		if err != nil {
+			t.Fatalf("ExtractCSVColumnFile(%+v) returned error: %v", co, err)
+		}
+		checkGolden(t, name, rows)
+	}
+
+	csv := readTestdata(t, "reviews.csv")
+	for _, co := range []input.CSVOptions{{Column: "missing"}, {Column: "review", IDColumn: "0"}} {
+		if _, err := input.ExtractCSVColumn(bytes.NewReader(csv), co); err == nil {
+			t.Errorf("ExtractCSVColumn(%+v) returned no error", co)
+		}
+	}
+	if _, err := input.ExtractCSVColumn(bytes.NewReader(nil), input.CSVOptions{Column: "1"}); err == nil {
+		t.Errorf("ExtractCSVColumn() of an empty file returned no error")
+	}
+}
+
+func TestExtractCSVColumnRows(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+		co   input.CSVOptions
+		want []input.CSVRow
+	}{
+		{
+			"header", "\ufeffId,Review\n1,Great product.\n2,\n3,\"Two\nlines, quoted.\"\n4,Fine\n",
+			input.CSVOptions{Column: "review", IDColumn: "id"},
+			// The empty row is skipped, and a quoted field spans lines.
+			[]input.CSVRow{{Line: 2, ID: "1", Text: "Great product."}, {Line: 4, ID: "3", Text: "Two\nlines, quoted."}, {Line: 6, ID: "4", Text: "Fine"}},
+		},
+		{
+			"numbers", "a;b\nc;d\n", input.CSVOptions{Column: "2", Comma: ';', NoHeader: true},
+			[]input.CSVRow{{Line: 1, Text: "b"}, {Line: 2, Text: "d"}},
+		},
+		{
+			"lenient", "name,text\nx,say \"hi\" now\ny\nz,last,extra\n", input.CSVOptions{Column: "text"},
+			[]input.CSVRow{{Line: 2, Text: `say "hi" now`}, {Line: 4, Text: "last"}},
+		},
+	}
+	for _, test := range tests {
+		got, err := input.ExtractCSVColumn(strings.NewReader(test.csv), test.co)
+		if err != nil {
+			t.Errorf("ExtractCSVColumn() %s returned error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ExtractCSVColumn() %s = %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
+
+func FuzzExtractCSVColumn(f *testing.F) {
+	f.Add(readTestdata(f, "reviews.csv"), false)
+	f.Add([]byte("a;\"b\nc\";\"\"\"\n;"), true)
+	f.Fuzz(func(t *testing.T, csv []byte, noHeader bool) {
+		rows, err := input.ExtractCSVColumn(bytes.NewReader(csv), input.CSVOptions{Column: "2", IDColumn: "1", NoHeader: noHeader})
+		if err != nil {
+			return
+		}
+		for _, row := range rows {
+			checkText(t, csv, row.Text)
+		}
+	})
+}
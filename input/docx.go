@@ -0,0 +1,228 @@
+package input
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// ====== Types ======
+
+// docxStyle represents how a paragraph style of a DOCX document is treated.
+type docxStyle struct {
+	heading  bool
+	listItem bool
+}
+
+// docxSkipped are the WordprocessingML elements whose content is not the text of the document: deleted and moved-away text of tracked changes,
+// field codes, and the fallbacks of alternate content, which repeat the text of the content.
+var docxSkipped = toSet("del", "moveFrom", "delText", "instrText", "Fallback")
+
+// ====== Functions ======
+
+// FromDOCX accepts a DOCX (Office Open XML) document and its size and returns its text. See `ExtractDOCX`.
+func FromDOCX(r io.ReaderAt, size int64) (string, error) {
+	content, err := ExtractDOCX(r, size, BlockOptions{})
+	return content.Text, err
+}
+
+// ExtractDOCXFile accepts a path to a DOCX document and the options for its headings, list items, and table cells and returns its Content. See `ExtractDOCX`.
+func ExtractDOCXFile(path string, bo BlockOptions) (Content, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Content{}, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return Content{}, err
+	}
+	return ExtractDOCX(file, info.Size(), bo)
+}
+
+// ExtractDOCX accepts a DOCX (Office Open XML) document, its size, and the options for its headings, list items, and table cells and returns its Content.
+// The paragraphs of the main document are separated by blank lines, the line breaks inside them are kept, and the tabs are spaces.
+// The headings are the paragraphs with the "Title", "Subtitle", or "Heading N" styles or an outline level, the list items are the numbered and bulleted paragraphs,
+// and the table cells are the paragraphs in tables. The headers, footers, footnotes, and comments are not read.
+// Tracked changes are read as accepted: the inserted text is kept, and the deleted text is dropped. Field codes are dropped, but their results are kept.
+func ExtractDOCX(r io.ReaderAt, size int64, bo BlockOptions) (Content, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return Content{}, err
+	}
+	var document, styles *zip.File
+	for _, file := range archive.File {
+		switch file.Name {
+		case "word/document.xml":
+			document = file
+		case "word/styles.xml":
+			styles = file
+		}
+	}
+	if document == nil {
+		return Content{}, errors.New("No word/document.xml in the archive. Cannot read DOCX.")
+	}
+
+	docxStyles := map[string]docxStyle{}
+	if styles != nil {
+		if docxStyles, err = readDOCXStyles(styles); err != nil {
+			return Content{}, err
+		}
+	}
+	reader, err := document.Open()
+	if err != nil {
+		return Content{}, err
+	}
+	defer reader.Close()
+	return readDOCXDocument(reader, docxStyles, bo)
+}
+
+// readDOCXDocument reads the main document part and returns its Content.
+func readDOCXDocument(r io.Reader, styles map[string]docxStyle, bo BlockOptions) (Content, error) {
+	w := blockWriter{bo: bo}
+	decoder := xml.NewDecoder(r)
+	skipped, cells := 0, 0
+	inText := false
+	// kind is the kind of the current paragraph, and started is true once the paragraph is started in the writer, after its properties.
+	var kind BlockKind
+	started := true
+	start := func() {
+		if !started {
+			w.start(kind)
+			started = true
+		}
+	}
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Content{}, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			if skipped > 0 || docxSkipped[name] {
+				skipped++
+				continue
+			}
+			switch name {
+			case "tc":
+				cells++
+			case "p":
+				kind, started = ParagraphBlock, false
+				if cells > 0 {
+					kind = TableCellBlock
+				}
+			case "pStyle":
+				style := styles[xmlAttribute(t, "val")]
+				if style.heading || isHeadingStyleID(xmlAttribute(t, "val")) {
+					kind = HeadingBlock
+				} else if style.listItem && kind == ParagraphBlock {
+					kind = ListItemBlock
+				}
+			case "outlineLvl":
+				if xmlAttribute(t, "val") != "9" {
+					kind = HeadingBlock
+				}
+			case "numPr":
+				if kind == ParagraphBlock {
+					kind = ListItemBlock
+				}
+			case "t":
+				start()
+				inText = true
+			case "tab":
+				start()
+				w.block.text("\t")
+			case "br", "cr":
+				start()
+				w.block.lineBreak()
+			}
+		case xml.EndElement:
+			if skipped > 0 {
+				skipped--
+				continue
+			}
+			switch t.Name.Local {
+			case "tc":
+				cells--
+			case "pPr":
+				start()
+			case "p":
+				start()
+				w.flush()
+			case "t":
+				inText = false
+			}
+		case xml.CharData:
+			if inText && skipped == 0 {
+				w.block.text(string(t))
+			}
+		}
+	}
+	return w.content(), nil
+}
+
+// readDOCXStyles reads the styles part and returns the paragraph styles by their IDs.
+func readDOCXStyles(file *zip.File) (map[string]docxStyle, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	styles := map[string]docxStyle{}
+	decoder := xml.NewDecoder(reader)
+	var id string
+	var style docxStyle
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return styles, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "style":
+				id, style = xmlAttribute(t, "styleId"), docxStyle{}
+			case "name":
+				name := strings.ToLower(xmlAttribute(t, "val"))
+				style.heading = style.heading || strings.HasPrefix(name, "heading") || name == "title" || name == "subtitle"
+				style.listItem = style.listItem || strings.HasPrefix(name, "list")
+			case "outlineLvl":
+				style.heading = style.heading || xmlAttribute(t, "val") != "9"
+			case "numPr":
+				style.listItem = true
+			}
+		case xml.EndElement:
+			if t.Name.Local == "style" && id != "" {
+				styles[id] = style
+				id = ""
+			}
+		}
+	}
+}
+
+// isHeadingStyleID reports whether the style ID is one of the built-in heading styles ("Heading1", "Title").
+func isHeadingStyleID(id string) bool {
+	return strings.HasPrefix(id, "Heading") || id == "Title" || id == "Subtitle"
+}
+
+// xmlAttribute returns the value of the attribute of the element by its local name, or an empty string if there is none.
+func xmlAttribute(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
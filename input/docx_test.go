@@ -0,0 +1,83 @@
+package input_test
+
+import (
+	"bytes"
+	"goreadability/input"
+	"testing"
+)
+
+// docxFiles are the names of the files of the test DOCX document in the archive.
+var docxFiles = []string{"word/document.xml", "word/styles.xml"}
+
+// docxArchive returns a DOCX archive of the document and the styles.
+func docxArchive(t testing.TB, document, styles []byte) []byte {
+	return zipFiles(t, docxFiles, map[string][]byte{"word/document.xml": document, "word/styles.xml": styles})
+}
+
+func TestExtractDOCX(t *testing.T) {
+	archive := docxArchive(t, readTestdata(t, "docx/document.xml"), readTestdata(t, "docx/styles.xml"))
+	for name, bo := range map[string]input.BlockOptions{
+		"docx":           {},
+		"docx_separated": {Headings: input.SeparateBlocks, ListItems: input.SeparateBlocks, TableCells: input.SeparateBlocks},
+	} {
+		content, err := input.ExtractDOCX(bytes.NewReader(archive), int64(len(archive)), bo)
+		if err != nil {
+			t.Fatalf("ExtractDOCX() returned error: %v", err)
+		}
+		checkGolden(t, name, content)
+	}
+
+	empty := zipFiles(t, []string{"word/other.xml"}, nil)
+	if _, err := input.ExtractDOCX(bytes.NewReader(empty), int64(len(empty)), input.BlockOptions{}); err == nil {
+		t.Errorf("ExtractDOCX() of an archive without a document returned no error")
+	}
+}
+
+func TestFromDOCX(t *testing.T) {
+	styles := readTestdata(t, "docx/styles.xml")
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"paragraphs", `<w:p><w:r><w:t>One </w:t></w:r><w:r><w:t>two.</w:t></w:r></w:p><w:p><w:r><w:t>Three.</w:t></w:r></w:p>`, "One two.\n\nThree."},
+		{"break and tab", `<w:p><w:r><w:t>One</w:t><w:br/><w:t>two</w:t><w:tab/><w:t>three.</w:t></w:r></w:p>`, "One\ntwo three."},
+		{"tracked changes", `<w:p><w:r><w:t>Kept </w:t></w:r><w:ins><w:r><w:t>inserted</w:t></w:r></w:ins><w:del><w:r><w:delText>deleted</w:delText></w:r></w:del><w:r><w:t>.</w:t></w:r></w:p>`, "Kept inserted."},
+		{
+			"field code",
+			`<w:p><w:r><w:t>Page </w:t></w:r><w:r><w:fldChar w:fldCharType="begin"/></w:r><w:r><w:instrText>PAGE</w:instrText></w:r>` +
+				`<w:r><w:fldChar w:fldCharType="separate"/></w:r><w:r><w:t>3</w:t></w:r><w:r><w:fldChar w:fldCharType="end"/></w:r><w:r><w:t>.</w:t></w:r></w:p>`,
+			"Page 3.",
+		},
+		{"styles", `<w:p><w:pPr><w:pStyle w:val="Title"/></w:pPr><w:r><w:t>Title</w:t></w:r></w:p><w:p><w:pPr><w:pStyle w:val="ListBullet"/></w:pPr><w:r><w:t>Item</w:t></w:r></w:p><w:p><w:r><w:t>Text.</w:t></w:r></w:p>`, "Text."},
+		{"table", `<w:tbl><w:tr><w:tc><w:p><w:r><w:t>A</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>B</w:t></w:r></w:p></w:tc></w:tr></w:tbl>`, ""},
+	}
+	for _, test := range tests {
+		document := `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` + test.body + `</w:body></w:document>`
+		archive := docxArchive(t, []byte(document), styles)
+		// The headings, the list items, and the table cells are excluded, so the styles and the tables are checked too.
+		bo := input.BlockOptions{Headings: input.ExcludeBlocks, ListItems: input.ExcludeBlocks, TableCells: input.ExcludeBlocks}
+		content, err := input.ExtractDOCX(bytes.NewReader(archive), int64(len(archive)), bo)
+		if err != nil {
+			t.Errorf("ExtractDOCX() %s returned error: %v", test.name, err)
+			continue
+		}
+		if content.Text != test.want {
+			t.Errorf("ExtractDOCX() %s = %q, want %q", test.name, content.Text, test.want)
+		}
+	}
+}
+
+func FuzzExtractDOCX(f *testing.F) {
+	f.Add(readTestdata(f, "docx/document.xml"))
+	f.Add([]byte("<w:p><w:r><w:t>unclosed"))
+	f.Add([]byte("<w:tbl><w:tc><w:tc><w:p></w:tbl>"))
+	styles := readTestdata(f, "docx/styles.xml")
+	f.Fuzz(func(t *testing.T, document []byte) {
+		archive := docxArchive(t, document, styles)
+		content, err := input.ExtractDOCX(bytes.NewReader(archive), int64(len(archive)), input.BlockOptions{ListItems: input.SeparateBlocks})
+		if err == nil {
+			checkText(t, document, content.Text)
+		}
+	})
+}
@@ -0,0 +1,233 @@
+package input
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"strings"
+)
+
+// ====== Types ======
+
+// Email represents an email message (RFC 5322): its sender, recipients, subject, and date as they are written in the headers,
+// and the Content of its body without the quoted replies and the signature.
+type Email struct {
+	From    string `json:"from"`
+	To      string `json:"to,omitempty"`
+	Subject string `json:"subject"`
+	Date    string `json:"date,omitempty"`
+	Content
+}
+
+// emailQuoteClasses are the parts of the classes and IDs of the HTML elements that mail clients wrap the quoted messages and signatures in.
+var emailQuoteClasses = []string{"gmail_quote", "gmail_signature", "moz-cite-prefix", "moz-signature", "divrplyfwdmsg", "yahoo_quoted", "appendonsend"}
+
+// emailReplySuffixes are the endings of the lines that introduce a quoted message ("On Mon, 5 Jan 2026, Ann <ann@example.com> wrote:") in several languages.
+var emailReplySuffixes = []string{"wrote:", "schrieb:", "a écrit :", "a écrit:", "escribió:", "ha scritto:", "schreef:", "написал:", "написала:"}
+
+// ====== Functions ======
+
+// ExtractEmailFile accepts a path to an email message (".eml") and the options for its headings, list items, and table cells and returns its Email. See `ExtractEmail`.
+func ExtractEmailFile(path string, bo BlockOptions) (Email, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Email{}, err
+	}
+	defer file.Close()
+	return ExtractEmail(file, bo)
+}
+
+// ExtractEmail accepts an email message (RFC 5322 with MIME) and the options for its headings, list items, and table cells and returns its Email.
+// The body is the first "text/plain" part of the message that is not an attachment, or its first "text/html" part extracted as in `ExtractHTML`.
+// The transfer encodings (base64, quoted-printable) and the charsets (UTF-8, ISO-8859-1, Windows-1252) are decoded, and so are the encoded words of the headers.
+// The invalid UTF-8 sequences are replaced with U+FFFD.
+// The quoted replies are dropped: the lines starting with ">", the quoted HTML elements ("blockquote", Gmail and Thunderbird quotes), and everything after
+// the line that introduces a quoted or forwarded message ("On ... wrote:", "-----Original Message-----", an Outlook "From:" header block).
+// So is the signature after the "-- " delimiter, and the "Sent from my ..." lines. The lines of a paragraph of a plain text body are joined.
+func ExtractEmail(r io.Reader, bo BlockOptions) (Email, error) {
+	message, err := mail.ReadMessage(r)
+	if err != nil {
+		return Email{}, err
+	}
+	decoder := mime.WordDecoder{CharsetReader: charsetReader}
+	header := func(name string) string {
+		value := message.Header.Get(name)
+		if decoded, err := decoder.DecodeHeader(value); err == nil {
+			value = decoded
+		}
+		return strings.ToValidUTF8(strings.Join(strings.Fields(value), " "), "\ufffd")
+	}
+	email := Email{From: header("From"), To: header("To"), Subject: header("Subject"), Date: header("Date")}
+
+	plain, html, err := emailBodies(textproto.MIMEHeader(message.Header), message.Body)
+	if err != nil {
+		return Email{}, err
+	}
+	switch {
+	case plain != "":
+		c := lineConverter{w: blockWriter{bo: bo, kind: ParagraphBlock}, inline: strings.TrimSpace}
+		for _, line := range stripEmailReplies(strings.Split(strings.ReplaceAll(plain, "\r\n", "\n"), "\n")) {
+			switch {
+			case strings.TrimSpace(line) == "":
+				c.flush()
+			case c.kind == "":
+				c.start(ParagraphBlock, line)
+			default:
+				c.continueBlock(line)
+			}
+		}
+		email.Content = c.w.content()
+	case html != "":
+		email.Content = ExtractHTML(dropHTMLElements(html, isEmailQuote), bo)
+		email.Text = strings.TrimSpace(strings.Join(stripEmailReplies(strings.Split(email.Text, "\n")), "\n"))
+	default:
+		return Email{}, errors.New("No text part in the message. Cannot read the email.")
+	}
+	return email, nil
+}
+
+// emailBodies returns the first plain text body and the first HTML body of the MIME entity with the header, decoded to UTF-8, skipping the attachments.
+func emailBodies(header textproto.MIMEHeader, body io.Reader) (plain, html string, err error) {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", nil
+	}
+	if disposition, _, _ := mime.ParseMediaType(header.Get("Content-Disposition")); disposition == "attachment" {
+		return "", "", nil
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		parts := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := parts.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", "", err
+			}
+			partPlain, partHTML, err := emailBodies(part.Header, part)
+			if err != nil {
+				return "", "", err
+			}
+			if plain == "" {
+				plain = partPlain
+			}
+			if html == "" {
+				html = partHTML
+			}
+		}
+		return plain, html, nil
+	}
+	if mediaType != "text/plain" && mediaType != "text/html" {
+		return "", "", nil
+	}
+
+	switch strings.ToLower(header.Get("Content-Transfer-Encoding")) {
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	}
+	body, err = charsetReader(params["charset"], body)
+	if err != nil {
+		return "", "", err
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "", "", err
+	}
+	// A part in UTF-8 can be malformed once its transfer encoding is decoded.
+	text := strings.ToValidUTF8(string(data), "\ufffd")
+	if mediaType == "text/html" {
+		return "", text, nil
+	}
+	return text, "", nil
+}
+
+// stripEmailReplies returns the lines of an email body up to the quoted message or the signature, without the quoted lines and the "Sent from my ..." lines.
+func stripEmailReplies(lines []string) []string {
+	var kept []string
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		next := ""
+		if i+1 < len(lines) {
+			next = strings.TrimSpace(lines[i+1])
+		}
+		switch {
+		case trimmed == "--", strings.HasPrefix(trimmed, "-----") && strings.Contains(strings.ToLower(trimmed), "message"),
+			strings.HasPrefix(trimmed, "__________"), strings.EqualFold(trimmed, "Begin forwarded message:"):
+			return kept
+		case strings.HasPrefix(trimmed, "On ") && (hasReplySuffix(trimmed) || hasReplySuffix(trimmed+" "+next)),
+			strings.HasPrefix(trimmed, "Am ") && hasReplySuffix(trimmed), strings.HasPrefix(trimmed, "Le ") && hasReplySuffix(trimmed):
+			return kept
+		case strings.HasPrefix(trimmed, "From:") && (strings.HasPrefix(next, "Sent:") || strings.HasPrefix(next, "Date:") || strings.HasPrefix(next, "To:")):
+			return kept
+		case strings.HasPrefix(trimmed, ">"), strings.HasPrefix(trimmed, "Sent from my "):
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return kept
+}
+
+// hasReplySuffix reports whether the line ends like a line that introduces a quoted message.
+func hasReplySuffix(line string) bool {
+	for _, suffix := range emailReplySuffixes {
+		if strings.HasSuffix(line, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmailQuote reports whether the HTML element is a quoted message or a signature.
+func isEmailQuote(name, attrs string) bool {
+	return name == "blockquote" || containsAny(strings.ToLower(htmlAttribute(attrs, "class")+" "+htmlAttribute(attrs, "id")), emailQuoteClasses)
+}
+
+// dropHTMLElements returns the HTML document without the elements for which drop returns true, together with their content.
+func dropHTMLElements(s string, drop func(name, attrs string) bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		lt := strings.IndexByte(s[i:], '<')
+		if lt < 0 {
+			b.WriteString(s[i:])
+			break
+		}
+		b.WriteString(s[i : i+lt])
+		i += lt
+
+		name, attrs, closing, end := parseTag(s, i)
+		if name == "" || closing || strings.HasSuffix(attrs, "/") || htmlVoid[name] || !drop(name, attrs) {
+			b.WriteString(s[i:max(end, i+1)])
+			i = max(end, i+1)
+			continue
+		}
+		// The element ends at its end tag, after the nested elements of the same name.
+		i = end
+		for depth := 1; depth > 0 && i < len(s); {
+			lt := strings.IndexByte(s[i:], '<')
+			if lt < 0 {
+				i = len(s)
+				break
+			}
+			nested, nestedAttrs, nestedClosing, nestedEnd := parseTag(s, i+lt)
+			switch {
+			case nested != name:
+			case nestedClosing:
+				depth--
+			case !strings.HasSuffix(nestedAttrs, "/"):
+				depth++
+			}
+			i = max(nestedEnd, i+lt+1)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,77 @@
+package input_test
+
+import (
+	"bytes"
+	"goreadability/input"
+	"strings"
+	"testing"
+)
+
+func TestExtractEmail(t *testing.T) {
+	for name, file := range map[string]string{"email_plain": "testdata/plain.eml", "email_html": "testdata/html.eml"} {
+		email, err := input.ExtractEmailFile(file, input.BlockOptions{Headings: input.SeparateBlocks})
+		if err != nil {
+			t.Fatalf("ExtractEmailFile(%q) returned error: %v", file, err)
+		}
+		checkGolden(t, name, email)
+	}
+
+	attachment := "Subject: Files\nContent-Type: application/pdf\n\n%PDF"
+	if _, err := input.ExtractEmail(strings.NewReader(attachment), input.BlockOptions{}); err == nil {
+		t.Errorf("ExtractEmail() of a message without a text part returned no error")
+	}
+}
+
+func TestExtractEmailBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		subject string
+		want    string
+	}{
+		{"plain", "Subject: Hello\n\nFirst line\nof a paragraph.\n\nSecond one.\n", "Hello", "First line of a paragraph.\n\nSecond one."},
+		{"encoded subject", "Subject: =?UTF-8?Q?Caf=C3=A9?=\n\nText.\n", "Café", "Text."},
+		{"quoted lines", "Subject: Re\n\nI agree.\n\n> Do you agree?\n> Yes or no.\n", "Re", "I agree."},
+		{"reply header", "Subject: Re\n\nSee you.\n\nOn Mon, 5 Jan 2026, Ann <ann@example.com> wrote:\nThe old text.\n", "Re", "See you."},
+		{"original message", "Subject: Re\n\nDone.\n\n-----Original Message-----\nFrom: Bob\nThe old text.\n", "Re", "Done."},
+		{"signature", "Subject: Hi\n\nThanks.\n\n-- \nAnn\nExample Inc.\n", "Hi", "Thanks."},
+		{"sent from", "Subject: Hi\n\nOn my way.\n\nSent from my phone\n", "Hi", "On my way."},
+		{"quoted-printable", "Subject: Hi\nContent-Type: text/plain; charset=iso-8859-1\nContent-Transfer-Encoding: quoted-printable\n\nCaf=E9 au lait, a long li=\nne.\n", "Hi", "Café au lait, a long line."},
+		{"base64", "Subject: Hi\nContent-Transfer-Encoding: base64\n\nSGVsbG8gd29ybGQu\n", "Hi", "Hello world."},
+		{
+			"html part",
+			"Subject: Hi\nContent-Type: multipart/alternative; boundary=b\n\n--b\nContent-Type: text/html\n\n<p>Hello.</p><blockquote>Quoted.</blockquote>\n--b--\n",
+			"Hi", "Hello.",
+		},
+		{
+			"plain part first",
+			"Subject: Hi\nContent-Type: multipart/alternative; boundary=b\n\n--b\nContent-Type: text/html\n\n<p>HTML.</p>\n--b\nContent-Type: text/plain\n\nPlain.\n--b--\n",
+			"Hi", "Plain.",
+		},
+	}
+	for _, test := range tests {
+		got, err := input.ExtractEmail(strings.NewReader(test.message), input.BlockOptions{})
+		if err != nil {
+			t.Errorf("ExtractEmail() %s returned error: %v", test.name, err)
+			continue
+		}
+		if got.Subject != test.subject || got.Text != test.want {
+			t.Errorf("ExtractEmail() %s = %q, %q, want %q, %q", test.name, got.Subject, got.Text, test.subject, test.want)
+		}
+	}
+}
+
+func FuzzExtractEmail(f *testing.F) {
+	f.Add(readTestdata(f, "plain.eml"))
+	f.Add(readTestdata(f, "html.eml"))
+	f.Add([]byte("Content-Type: multipart/alternative; boundary=b\n\n--b\nContent-Type: text/plain; charset=windows-1252\n\n\x93Hi\x94\n--b"))
+	f.Add([]byte("Subject: =?UTF-8?Q?=80?=\nContent-Transfer-Encoding: quoted-printable\n\n=80"))
+	f.Fuzz(func(t *testing.T, message []byte) {
+		email, err := input.ExtractEmail(bytes.NewReader(message), input.BlockOptions{})
+		if err != nil {
+			return
+		}
+		checkText(t, message, email.Subject)
+		checkText(t, message, email.Text)
+	})
+}
@@ -0,0 +1,162 @@
+package input
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+)
+
+// ====== Types ======
+
+// Chapter represents a chapter of a book: a document of the reading order of an EPUB publication, with its title and extracted content.
+type Chapter struct {
+	Title string `json:"title"`
+	Content
+}
+
+// epubContainer represents META-INF/container.xml of an EPUB publication.
+type epubContainer struct {
+	Rootfiles []struct {
+		FullPath string `xml:"full-path,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+// epubPackage represents the package document (OPF) of an EPUB publication: its manifest and spine (reading order).
+type epubPackage struct {
+	Items []struct {
+		ID        string `xml:"id,attr"`
+		Href      string `xml:"href,attr"`
+		MediaType string `xml:"media-type,attr"`
+	} `xml:"manifest>item"`
+	ItemRefs []struct {
+		IDRef  string `xml:"idref,attr"`
+		Linear string `xml:"linear,attr"`
+	} `xml:"spine>itemref"`
+}
+
+// ====== Functions ======
+
+// ExtractEPUBFile accepts a path to an EPUB publication and the options for its headings, list items, and table cells and returns its chapters. See `ExtractEPUB`.
+func ExtractEPUBFile(path string, bo BlockOptions) ([]Chapter, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractEPUB(file, info.Size(), bo)
+}
+
+// ExtractEPUB accepts an EPUB publication (EPUB 2 or 3), its size, and the options for its headings, list items, and table cells and returns its chapters in the reading order.
+// Every XHTML document of the spine is a chapter, and its text is extracted as in `ExtractHTML`. The documents marked as non-linear (`linear="no"`)
+// and the documents without text (such as a cover image) are skipped. The title of a chapter is the title of its document, or its first heading if it has no title.
+func ExtractEPUB(r io.ReaderAt, size int64, bo BlockOptions) ([]Chapter, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+
+	var container epubContainer
+	if err := decodeZipXML(files, "META-INF/container.xml", &container); err != nil {
+		return nil, err
+	}
+	if len(container.Rootfiles) == 0 {
+		return nil, errors.New("No rootfile in META-INF/container.xml. Cannot read EPUB.")
+	}
+	opfPath := container.Rootfiles[0].FullPath
+	var pkg epubPackage
+	if err := decodeZipXML(files, opfPath, &pkg); err != nil {
+		return nil, err
+	}
+
+	hrefs := map[string]string{}
+	for _, item := range pkg.Items {
+		if item.MediaType == "application/xhtml+xml" || item.MediaType == "text/html" {
+			hrefs[item.ID] = item.Href
+		}
+	}
+	var chapters []Chapter
+	for _, ref := range pkg.ItemRefs {
+		href, ok := hrefs[ref.IDRef]
+		if !ok || ref.Linear == "no" {
+			continue
+		}
+		name, err := url.PathUnescape(href)
+		if err != nil {
+			name = href
+		}
+		name = path.Join(path.Dir(opfPath), name)
+		document, err := readZipFile(files, name)
+		if err != nil {
+			return nil, err
+		}
+
+		content := ExtractHTML(document, bo)
+		if strings.TrimSpace(content.Text) == "" && len(content.Separated) == 0 {
+			continue
+		}
+		chapters = append(chapters, Chapter{htmlTitle(document, bo), content})
+	}
+	return chapters, nil
+}
+
+// htmlTitle returns the text of the title element of the HTML document, or the text of its first heading if the title is empty.
+func htmlTitle(document string, bo BlockOptions) string {
+	lower := strings.ToLower(document)
+	if start := strings.Index(lower, "<title"); start >= 0 {
+		if end := strings.Index(lower[start:], "</title"); end >= 0 {
+			// The content of the title element is skipped by `ExtractHTML`, so only the content is extracted.
+			content := document[start : start+end]
+			if open := strings.IndexByte(content, '>'); open >= 0 {
+				content = content[open+1:]
+			}
+			if title := strings.TrimSpace(FromHTML(content, BlockOptions{})); title != "" {
+				return title
+			}
+		}
+	}
+	bo.Headings = SeparateBlocks
+	for _, block := range ExtractHTML(document, bo).Separated {
+		if block.Kind == HeadingBlock {
+			return block.Text
+		}
+	}
+	return ""
+}
+
+// decodeZipXML decodes the XML file of the archive into v.
+func decodeZipXML(files map[string]*zip.File, name string, v any) error {
+	document, err := readZipFile(files, name)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal([]byte(document), v)
+}
+
+// readZipFile returns the content of the file of the archive.
+func readZipFile(files map[string]*zip.File, name string) (string, error) {
+	file, ok := files[name]
+	if !ok {
+		return "", fmt.Errorf("No %s in the archive.", name)
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	return string(data), err
+}
@@ -0,0 +1,107 @@
+package input_test
+
+import (
+	"bytes"
+	"goreadability/input"
+	"reflect"
+	"testing"
+)
+
+// epubFiles are the names of the files of the test EPUB publication in the archive, mapped to their names in testdata/epub.
+var epubFiles = map[string]string{
+	"META-INF/container.xml":       "container.xml",
+	"OEBPS/content.opf":            "content.opf",
+	"OEBPS/cover.xhtml":            "cover.xhtml",
+	"OEBPS/text/one.xhtml":         "one.xhtml",
+	"OEBPS/text/chapter two.xhtml": "two.xhtml",
+	"OEBPS/text/notes.xhtml":       "notes.xhtml",
+}
+
+// epubArchive returns an EPUB archive of the test publication with the package document.
+func epubArchive(t testing.TB, opf []byte) []byte {
+	var names []string
+	contents := map[string][]byte{}
+	for name, file := range epubFiles {
+		names = append(names, name)
+		contents[name] = readTestdata(t, "epub/"+file)
+	}
+	contents["OEBPS/content.opf"] = opf
+	return zipFiles(t, names, contents)
+}
+
+func TestExtractEPUB(t *testing.T) {
+	archive := epubArchive(t, readTestdata(t, "epub/content.opf"))
+	for name, bo := range map[string]input.BlockOptions{
+		"epub":           {},
+		"epub_separated": {Headings: input.SeparateBlocks, ListItems: input.ExcludeBlocks},
+	} {
+		chapters, err := input.ExtractEPUB(bytes.NewReader(archive), int64(len(archive)), bo)
+		if err != nil {
+			t.Fatalf("ExtractEPUB() returned error: %v", err)
+		}
+		checkGolden(t, name, chapters)
+	}
+
+	missing := epubArchive(t, []byte(`<package><manifest><item id="a" href="missing.xhtml" media-type="application/xhtml+xml"/></manifest><spine><itemref idref="a"/></spine></package>`))
+	if _, err := input.ExtractEPUB(bytes.NewReader(missing), int64(len(missing)), input.BlockOptions{}); err == nil {
+		t.Errorf("ExtractEPUB() of a publication with a missing document returned no error")
+	}
+}
+
+func TestExtractEPUBChapters(t *testing.T) {
+	files := map[string][]byte{
+		"META-INF/container.xml": readTestdata(t, "epub/container.xml"),
+		"OEBPS/content.opf": []byte(`<package><manifest>
+<item id="a" href="a.xhtml" media-type="application/xhtml+xml"/><item id="b" href="b.xhtml" media-type="application/xhtml+xml"/>
+<item id="c" href="c.xhtml" media-type="application/xhtml+xml"/><item id="d" href="d.xhtml" media-type="application/xhtml+xml"/>
+</manifest><spine><itemref idref="d"/><itemref idref="a"/><itemref idref="b" linear="no"/><itemref idref="c"/></spine></package>`),
+		"OEBPS/a.xhtml": []byte(`<html><head><title>First</title></head><body><h1>Chapter 1</h1><p>One &amp; only.</p></body></html>`),
+		"OEBPS/b.xhtml": []byte(`<html><head><title>Notes</title></head><body><p>Skipped.</p></body></html>`),
+		"OEBPS/c.xhtml": []byte(`<html><body><h2>Second</h2><p>Two.</p></body></html>`),
+		"OEBPS/d.xhtml": []byte(`<html><head><title>Cover</title></head><body><img src="cover.png" alt=""/></body></html>`),
+	}
+	names := []string{"META-INF/container.xml", "OEBPS/content.opf", "OEBPS/a.xhtml", "OEBPS/b.xhtml", "OEBPS/c.xhtml", "OEBPS/d.xhtml"}
+	archive := zipFiles(t, names, files)
+
+	tests := []struct {
+		bo   input.BlockOptions
+		want []input.Chapter
+	}{
+		// The cover without text and the non-linear notes are skipped, and the chapters are in the order of the spine.
+		{input.BlockOptions{}, []input.Chapter{
+			{Title: "First", Content: input.Content{Text: "Chapter 1\n\nOne & only."}},
+			{Title: "Second", Content: input.Content{Text: "Second\n\nTwo."}},
+		}},
+		{input.BlockOptions{Headings: input.ExcludeBlocks}, []input.Chapter{
+			{Title: "First", Content: input.Content{Text: "One & only."}},
+			{Title: "Second", Content: input.Content{Text: "Two."}},
+		}},
+	}
+	for _, test := range tests {
+		got, err := input.ExtractEPUB(bytes.NewReader(archive), int64(len(archive)), test.bo)
+		if err != nil {
+			t.Errorf("ExtractEPUB(%+v) returned error: %v", test.bo, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ExtractEPUB(%+v) = %+v, want %+v", test.bo, got, test.want)
+		}
+	}
+}
+
+func FuzzExtractEPUB(f *testing.F) {
+	f.Add(readTestdata(f, "epub/content.opf"))
+	f.Add([]byte(`<package><manifest><item id="a" href="../../one.xhtml" media-type="text/html"/></manifest><spine><itemref idref="a"/></spine></package>`))
+	f.Add([]byte(`<package><manifest><item id="a" href="%zz" media-type="text/html"/></manifest><spine><itemref idref="a"/>`))
+	f.Fuzz(func(t *testing.T, opf []byte) {
+		archive := epubArchive(t, opf)
+		chapters, err := input.ExtractEPUB(bytes.NewReader(archive), int64(len(archive)), input.BlockOptions{})
+		if err != nil {
+			return
+		}
+		for _, chapter := range chapters {
+			checkText(t, opf, chapter.Title)
+			checkText(t, opf, chapter.Text)
+		}
+	})
+}
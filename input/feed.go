@@ -0,0 +1,161 @@
+package input
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"strings"
+)
+
+// ====== Types ======
+
+// FeedItem represents an item of an RSS feed or an entry of an Atom feed: its title, link, publication date as it is written in the feed, and the Content of its body.
+type FeedItem struct {
+	Title     string `json:"title"`
+	Link      string `json:"link,omitempty"`
+	Published string `json:"published,omitempty"`
+	Content
+}
+
+// Feed represents an RSS or Atom feed: its title and its items in the order of the feed.
+type Feed struct {
+	Title string     `json:"title"`
+	Items []FeedItem `json:"items"`
+}
+
+// feedDocument represents an RSS 2.0 ("rss"), RSS 1.0 ("RDF"), or Atom ("feed") document.
+type feedDocument struct {
+	XMLName xml.Name
+	Title   feedText `xml:"title"`
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+	// Items are the items of RSS 1.0, which are outside of its channel.
+	Items   []rssItem   `xml:"item"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+// rssItem represents an item of an RSS feed. The full body ("content:encoded") is preferred to the description.
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Links       []string `xml:"link"`
+	Description string   `xml:"description"`
+	Encoded     string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	PubDate     string   `xml:"pubDate"`
+	Date        string   `xml:"http://purl.org/dc/elements/1.1/ date"`
+}
+
+// atomEntry represents an entry of an Atom feed. The content is preferred to the summary.
+type atomEntry struct {
+	Title feedText `xml:"title"`
+	Links []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	} `xml:"link"`
+	Content   feedText `xml:"content"`
+	Summary   feedText `xml:"summary"`
+	Published string   `xml:"published"`
+	Updated   string   `xml:"updated"`
+}
+
+// feedText represents an Atom text construct: plain text, escaped HTML, or inline XHTML by its type.
+type feedText struct {
+	Type  string `xml:"type,attr"`
+	Text  string `xml:",chardata"`
+	Inner string `xml:",innerxml"`
+}
+
+// ====== Methods ======
+
+// html returns the text construct as HTML.
+func (t feedText) html() string {
+	switch t.Type {
+	case "html", "text/html":
+		return t.Text
+	case "xhtml", "application/xhtml+xml":
+		return t.Inner
+	}
+	return html.EscapeString(t.Text)
+}
+
+// ====== Functions ======
+
+// ExtractFeedFile accepts a path to an RSS or Atom feed and the options for its headings, list items, and table cells and returns its Feed. See `ExtractFeed`.
+func ExtractFeedFile(path string, bo BlockOptions) (Feed, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Feed{}, err
+	}
+	defer file.Close()
+	return ExtractFeed(file, bo)
+}
+
+// ExtractFeed accepts an RSS (0.9x, 1.0, or 2.0) or Atom feed and the options for its headings, list items, and table cells and returns its Feed.
+// The bodies of the items are their full contents ("content:encoded", "content") if the feed has them, or their descriptions or summaries,
+// extracted as in `ExtractHTML`. The titles are plain text, and the links are the first links of the items (the "alternate" link of an Atom entry).
+// The HTML entities ("&nbsp;") of the lenient feeds are decoded, and the feeds in ISO-8859-1 and Windows-1252 are read too.
+func ExtractFeed(r io.Reader, bo BlockOptions) (Feed, error) {
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+	decoder.CharsetReader = charsetReader
+	var document feedDocument
+	if err := decoder.Decode(&document); err != nil {
+		return Feed{}, err
+	}
+
+	var feed Feed
+	switch document.XMLName.Local {
+	case "rss", "RDF":
+		feed.Title = strings.TrimSpace(document.Channel.Title)
+		for _, item := range append(document.Channel.Items, document.Items...) {
+			body := item.Encoded
+			if strings.TrimSpace(body) == "" {
+				body = item.Description
+			}
+			published := item.PubDate
+			if published == "" {
+				published = item.Date
+			}
+			feedItem := FeedItem{Title: feedTitle(item.Title), Published: strings.TrimSpace(published), Content: ExtractHTML(body, bo)}
+			for _, link := range item.Links {
+				if link = strings.TrimSpace(link); link != "" {
+					feedItem.Link = link
+					break
+				}
+			}
+			feed.Items = append(feed.Items, feedItem)
+		}
+	case "feed":
+		feed.Title = FromHTML(document.Title.html(), BlockOptions{})
+		for _, entry := range document.Entries {
+			body := entry.Content
+			if strings.TrimSpace(body.Text) == "" && strings.TrimSpace(body.Inner) == "" {
+				body = entry.Summary
+			}
+			published := entry.Published
+			if published == "" {
+				published = entry.Updated
+			}
+			item := FeedItem{Title: FromHTML(entry.Title.html(), BlockOptions{}), Published: strings.TrimSpace(published), Content: ExtractHTML(body.html(), bo)}
+			for _, link := range entry.Links {
+				if link.Rel == "" || link.Rel == "alternate" {
+					item.Link = link.Href
+					break
+				}
+			}
+			feed.Items = append(feed.Items, item)
+		}
+	default:
+		return Feed{}, fmt.Errorf("Unknown feed element %q. Cannot read the feed.", document.XMLName.Local)
+	}
+	return feed, nil
+}
+
+// feedTitle returns the title of an RSS item as plain text. Some feeds escape the markup of their titles twice ("&amp;amp;"), which is decoded.
+func feedTitle(title string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(title)), " ")
+}
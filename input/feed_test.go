@@ -0,0 +1,79 @@
+package input_test
+
+import (
+	"bytes"
+	"goreadability/input"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractFeed(t *testing.T) {
+	for name, file := range map[string]string{"feed_rss": "testdata/feed.rss", "feed_atom": "testdata/feed.atom"} {
+		feed, err := input.ExtractFeedFile(file, input.BlockOptions{Headings: input.SeparateBlocks})
+		if err != nil {
+			t.Fatalf("ExtractFeedFile(%q) returned error: %v", file, err)
+		}
+		checkGolden(t, name, feed)
+	}
+
+	if _, err := input.ExtractFeed(strings.NewReader("<html><body>Not a feed.</body></html>"), input.BlockOptions{}); err == nil {
+		t.Errorf("ExtractFeed() of an HTML page returned no error")
+	}
+}
+
+func TestExtractFeedItems(t *testing.T) {
+	tests := []struct {
+		name string
+		feed string
+		want input.Feed
+	}{
+		{
+			"rss",
+			`<rss version="2.0" xmlns:content="http://purl.org/rss/1.0/modules/content/"><channel><title>News &amp; views</title>
+<item><title>First</title><link>https://example.com/1</link><pubDate>Mon, 01 Jan 2024 00:00:00 GMT</pubDate>
+<description>A summary.</description><content:encoded><![CDATA[<p>The full <b>text</b>.</p><p>Two&nbsp;parts.</p>]]></content:encoded></item>
+<item><title>Second</title><description>&lt;p&gt;Only a description.&lt;/p&gt;</description></item></channel></rss>`,
+			input.Feed{Title: "News & views", Items: []input.FeedItem{
+				{Title: "First", Link: "https://example.com/1", Published: "Mon, 01 Jan 2024 00:00:00 GMT", Content: input.Content{Text: "The full text.\n\nTwo parts."}},
+				{Title: "Second", Content: input.Content{Text: "Only a description."}},
+			}},
+		},
+		{
+			"atom",
+			`<feed xmlns="http://www.w3.org/2005/Atom"><title>Blog</title>
+<entry><title>Post</title><link rel="edit" href="https://example.com/edit"/><link rel="alternate" href="https://example.com/post"/>
+<updated>2024-01-01T00:00:00Z</updated><summary>A summary.</summary><content type="html">&lt;h1&gt;Heading&lt;/h1&gt;&lt;p&gt;Body.&lt;/p&gt;</content></entry></feed>`,
+			input.Feed{Title: "Blog", Items: []input.FeedItem{
+				{Title: "Post", Link: "https://example.com/post", Published: "2024-01-01T00:00:00Z", Content: input.Content{Text: "Body.", Separated: []input.Block{{Kind: input.HeadingBlock, Text: "Heading"}}}},
+			}},
+		},
+	}
+	for _, test := range tests {
+		got, err := input.ExtractFeed(strings.NewReader(test.feed), input.BlockOptions{Headings: input.SeparateBlocks})
+		if err != nil {
+			t.Errorf("ExtractFeed() %s returned error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ExtractFeed() %s = %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
+
+func FuzzExtractFeed(f *testing.F) {
+	f.Add(readTestdata(f, "feed.rss"))
+	f.Add(readTestdata(f, "feed.atom"))
+	f.Add([]byte(`<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"><item><title>T</title><description>D</description></item></rdf:RDF>`))
+	f.Fuzz(func(t *testing.T, document []byte) {
+		feed, err := input.ExtractFeed(bytes.NewReader(document), input.BlockOptions{})
+		if err != nil {
+			return
+		}
+		checkText(t, document, feed.Title)
+		for _, item := range feed.Items {
+			checkText(t, document, item.Title)
+			checkText(t, document, item.Text)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package input
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ====== Types ======
+
+// Field represents a string extracted from a structured document (JSON, YAML) with its path in the document ("items[2].help_text").
+type Field struct {
+	Path string `json:"path"`
+	Text string `json:"text"`
+}
+
+// ====== Functions ======
+
+// ExtractJSONFields accepts a JSON document and the paths of its fields and returns the strings at the paths. See `ExtractFields`.
+func ExtractJSONFields(r io.Reader, paths []string) ([]Field, error) {
+	var document any
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, err
+	}
+	return ExtractFields(document, paths), nil
+}
+
+// ExtractYAMLFields accepts a YAML document and the paths of its fields and returns the strings at the paths. See `ExtractFields`.
+// Only the first document of a stream is read. The block and flow collections, the plain, quoted, literal ("|"), and folded (">") scalars, and the anchors and aliases are supported.
+func ExtractYAMLFields(r io.Reader, paths []string) ([]Field, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	document, err := parseYAML(string(data))
+	if err != nil {
+		return nil, err
+	}
+	return ExtractFields(document, paths), nil
+}
+
+// ExtractFields accepts a decoded JSON or YAML document (maps, slices, and scalars, as decoded by `encoding/json` into `any`) and the paths of its fields
+// and returns the non-empty strings at the paths, path by path. The keys of a path are separated by points ("info.title"), "[]" after a key selects all the elements
+// of an array ("items[].help_text"), "[n]" selects one element ("items[0].title"), and "*" selects all the keys of an object ("paths.*.*.summary").
+// The paths of the fields are the matched paths, with the indexes of the elements and the keys matched by "*" ("items[2].help_text").
+// The keys of an object matched by "*" are in alphabetical order, and the strings in an array at the end of a path are all extracted ("tags" is "tags[]").
+func ExtractFields(document any, paths []string) []Field {
+	var fields []Field
+	for _, path := range paths {
+		fields = appendFields(fields, document, fieldPathSegments(path), "")
+	}
+	return fields
+}
+
+// fieldPathSegments splits the path into its segments: keys, "*", "[]", and "[n]".
+func fieldPathSegments(path string) []string {
+	var segments []string
+	for _, part := range strings.Split(path, ".") {
+		key, rest, _ := strings.Cut(part, "[")
+		if key != "" {
+			segments = append(segments, key)
+		}
+		for rest != "" {
+			index, after, _ := strings.Cut(rest, "]")
+			segments = append(segments, "["+index+"]")
+			_, rest, _ = strings.Cut(after, "[")
+		}
+	}
+	return segments
+}
+
+// appendFields appends the strings of the value at the segments of a path to the fields. The prefix is the path of the value.
+func appendFields(fields []Field, value any, segments []string, prefix string) []Field {
+	if len(segments) == 0 {
+		switch value := value.(type) {
+		case string:
+			if strings.TrimSpace(value) != "" {
+				fields = append(fields, Field{prefix, value})
+			}
+		case []any:
+			for i, element := range value {
+				if text, ok := element.(string); ok && strings.TrimSpace(text) != "" {
+					fields = append(fields, Field{fmt.Sprintf("%s[%d]", prefix, i), text})
+				}
+			}
+		}
+		return fields
+	}
+
+	segment, rest := segments[0], segments[1:]
+	switch value := value.(type) {
+	case map[string]any:
+		if segment != "*" {
+			if child, ok := value[segment]; ok {
+				fields = appendFields(fields, child, rest, joinFieldPath(prefix, segment))
+			}
+			return fields
+		}
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fields = appendFields(fields, value[key], rest, joinFieldPath(prefix, key))
+		}
+	case []any:
+		if segment == "[]" || segment == "*" {
+			for i, element := range value {
+				fields = appendFields(fields, element, rest, fmt.Sprintf("%s[%d]", prefix, i))
+			}
+			return fields
+		}
+		index, err := strconv.Atoi(strings.Trim(segment, "[]"))
+		if err == nil && index >= 0 && index < len(value) && strings.HasPrefix(segment, "[") {
+			fields = appendFields(fields, value[index], rest, fmt.Sprintf("%s[%d]", prefix, index))
+		}
+	}
+	return fields
+}
+
+// joinFieldPath returns the path of the key of the object at the prefix.
+func joinFieldPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
@@ -0,0 +1,79 @@
+package input_test
+
+import (
+	"bytes"
+	"goreadability/input"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fieldPaths are the paths of the fields of testdata/fields.json and testdata/fields.yaml.
+var fieldPaths = []string{"title", "info.summary", "info.empty", "items[].help_text", "items[1].name", "tags", "pages.*.title", "missing.path"}
+
+func TestExtractFields(t *testing.T) {
+	jsonFields, err := input.ExtractJSONFields(bytes.NewReader(readTestdata(t, "fields.json")), fieldPaths)
+	if err != nil {
+		t.Fatalf("ExtractJSONFields() returned error: %v", err)
+	}
+	checkGolden(t, "fields", jsonFields)
+
+	yamlFields, err := input.ExtractYAMLFields(bytes.NewReader(readTestdata(t, "fields.yaml")), fieldPaths)
+	if err != nil {
+		t.Fatalf("ExtractYAMLFields() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(yamlFields, jsonFields) {
+		t.Errorf("ExtractYAMLFields() = %v, want the fields of the JSON document %v", yamlFields, jsonFields)
+	}
+}
+
+func TestExtractJSONFields(t *testing.T) {
+	document := `{"title": "Hello", "info": {"summary": "A text.", "empty": "", "count": 3},
+"items": [{"help": "First."}, {"help": "Second."}, {"other": "x"}], "tags": ["one", "two"], "pages": {"b": {"title": "B"}, "a": {"title": "A"}}}`
+	tests := []struct {
+		path string
+		want []input.Field
+	}{
+		{"title", []input.Field{{"title", "Hello"}}},
+		// The empty strings and the other values are not extracted.
+		{"info.empty", nil},
+		{"info.count", nil},
+		{"info", nil},
+		{"items[].help", []input.Field{{"items[0].help", "First."}, {"items[1].help", "Second."}}},
+		{"items[1].help", []input.Field{{"items[1].help", "Second."}}},
+		{"items[5].help", nil},
+		{"tags", []input.Field{{"tags[0]", "one"}, {"tags[1]", "two"}}},
+		{"pages.*.title", []input.Field{{"pages.a.title", "A"}, {"pages.b.title", "B"}}},
+		{"missing.path", nil},
+	}
+	for _, test := range tests {
+		got, err := input.ExtractJSONFields(strings.NewReader(document), []string{test.path})
+		if err != nil {
+			t.Errorf("ExtractJSONFields(%q) returned error: %v", test.path, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ExtractJSONFields(%q) = %v, want %v", test.path, got, test.want)
+		}
+	}
+
+	if _, err := input.ExtractJSONFields(strings.NewReader(`{"a": `), []string{"a"}); err == nil {
+		t.Errorf("ExtractJSONFields() of an invalid document returned no error")
+	}
+}
+
+func FuzzExtractYAMLFields(f *testing.F) {
+	f.Add(readTestdata(f, "fields.yaml"))
+	f.Add([]byte("a: [b, {c: \"d\\u00e9\", e: [f}]\n- g\n  h: |\n   i\n"))
+	f.Add([]byte(":\n\"\": x"))
+	f.Add([]byte("? key\n: &x *x\n---\n'a''b'"))
+	f.Fuzz(func(t *testing.T, yaml []byte) {
+		fields, err := input.ExtractYAMLFields(bytes.NewReader(yaml), []string{"*", "*.*", "*[]", "*[].*"})
+		if err != nil {
+			return
+		}
+		for _, field := range fields {
+			checkText(t, yaml, field.Text)
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package input
+
+import (
+	"go/ast"
+	"go/doc/comment"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ====== Types ======
+
+// DocComment represents the doc comment of a Go declaration with its extracted content.
+// Symbol is the name of the package, the type, the function, the constant, or the variable, or "Type.Method" for a method.
+// The doc comment of a group of constants or variables has the names of the group separated by ", ".
+type DocComment struct {
+	Package  string `json:"package"`
+	Symbol   string `json:"symbol"`
+	Kind     string `json:"kind"`
+	Exported bool   `json:"exported"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Content
+}
+
+// ====== Functions ======
+
+// ExtractGoDocDir accepts a path to a directory of a Go package and the options for the headings and list items of the doc comments
+// and returns the doc comments of the Go files of the directory, except the tests, in the order of the files. See `ExtractGoDoc`.
+func ExtractGoDocDir(dir string, bo BlockOptions) ([]DocComment, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if name := entry.Name(); !entry.IsDir() && strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go") {
+			files = append(files, filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(files)
+
+	var docs []DocComment
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		fileDocs, err := ExtractGoDoc(file, src, bo)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, fileDocs...)
+	}
+	return docs, nil
+}
+
+// ExtractGoDoc accepts the name and the source of a Go file and the options for the headings and list items of the doc comments and returns the doc comments
+// of the package and of the top-level declarations (types, functions, methods, constants, and variables) in the order of the file. The declarations without a doc comment are skipped.
+// The comments are parsed as Go doc comments (see `go/doc/comment`): the code blocks are removed, the links are replaced with their texts,
+// the headings ("# Heading") are headings, and the items of lists are list items. Returns an error if the source cannot be parsed.
+func ExtractGoDoc(filename string, src []byte, bo BlockOptions) ([]DocComment, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []DocComment
+	add := func(group *ast.CommentGroup, symbol, kind string, exported bool) {
+		if group == nil {
+			return
+		}
+		docs = append(docs, DocComment{
+			Package:  file.Name.Name,
+			Symbol:   symbol,
+			Kind:     kind,
+			Exported: exported,
+			File:     filename,
+			Line:     fset.Position(group.Pos()).Line,
+			Content:  extractDocComment(group.Text(), bo),
+		})
+	}
+
+	add(file.Doc, file.Name.Name, "package", true)
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			symbol, kind := decl.Name.Name, "func"
+			exported := decl.Name.IsExported()
+			if decl.Recv != nil && len(decl.Recv.List) > 0 {
+				receiver := receiverName(decl.Recv.List[0].Type)
+				symbol, kind = receiver+"."+symbol, "method"
+				exported = exported && token.IsExported(receiver)
+			}
+			add(decl.Doc, symbol, kind, exported)
+		case *ast.GenDecl:
+			if decl.Tok == token.IMPORT {
+				continue
+			}
+			var names []string
+			exported := false
+			for _, spec := range decl.Specs {
+				var specNames []*ast.Ident
+				var doc *ast.CommentGroup
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					specNames, doc = []*ast.Ident{spec.Name}, spec.Doc
+				case *ast.ValueSpec:
+					specNames, doc = spec.Names, spec.Doc
+				}
+				var specSymbols []string
+				specExported := false
+				for _, name := range specNames {
+					specSymbols = append(specSymbols, name.Name)
+					specExported = specExported || name.IsExported()
+				}
+				names = append(names, specSymbols...)
+				exported = exported || specExported
+				if decl.Lparen.IsValid() {
+					add(doc, strings.Join(specSymbols, ", "), decl.Tok.String(), specExported)
+				}
+			}
+			add(decl.Doc, strings.Join(names, ", "), decl.Tok.String(), exported)
+		}
+	}
+	sort.SliceStable(docs, func(i, j int) bool { return docs[i].Line < docs[j].Line })
+	return docs, nil
+}
+
+// extractDocComment returns the Content of the text of a doc comment.
+func extractDocComment(text string, bo BlockOptions) Content {
+	// The declarations are not resolved, so every identifier in brackets ("[Analyzer]") is taken as a doc link and replaced with its name.
+	docParser := comment.Parser{LookupSym: func(recv, name string) bool { return true }}
+	w := blockWriter{bo: bo}
+	for _, block := range docParser.Parse(text).Content {
+		switch block := block.(type) {
+		case *comment.Paragraph:
+			w.start(ParagraphBlock)
+			w.block.text(docCommentText(block.Text))
+		case *comment.Heading:
+			w.start(HeadingBlock)
+			w.block.text(docCommentText(block.Text))
+		case *comment.List:
+			for _, item := range block.Items {
+				w.start(ListItemBlock)
+				for _, content := range item.Content {
+					if paragraph, ok := content.(*comment.Paragraph); ok {
+						w.block.text(docCommentText(paragraph.Text) + " ")
+					}
+				}
+			}
+		}
+	}
+	return w.content()
+}
+
+// docCommentText returns the plain text of the inline text of a doc comment, with the links replaced with their texts.
+func docCommentText(texts []comment.Text) string {
+	var b strings.Builder
+	for _, text := range texts {
+		switch text := text.(type) {
+		case comment.Plain:
+			b.WriteString(string(text))
+		case comment.Italic:
+			b.WriteString(string(text))
+		case *comment.Link:
+			b.WriteString(docCommentText(text.Text))
+		case *comment.DocLink:
+			b.WriteString(docCommentText(text.Text))
+		}
+	}
+	return b.String()
+}
+
+// receiverName returns the name of the type of a method receiver, without the pointer and the type parameters.
+func receiverName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(expr.X)
+	case *ast.IndexExpr:
+		return receiverName(expr.X)
+	case *ast.IndexListExpr:
+		return receiverName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
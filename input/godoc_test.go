@@ -0,0 +1,38 @@
+package input_test
+
+import (
+	"goreadability/input"
+	"testing"
+)
+
+func TestExtractGoDoc(t *testing.T) {
+	docs, err := input.ExtractGoDocDir("testdata/godoc", input.BlockOptions{})
+	if err != nil {
+		t.Fatalf("ExtractGoDocDir() returned error: %v", err)
+	}
+	checkGolden(t, "godoc", docs)
+
+	docs, err = input.ExtractGoDoc("shapes.go", readTestdata(t, "godoc/shapes.go"), input.BlockOptions{Headings: input.SeparateBlocks, ListItems: input.ExcludeBlocks})
+	if err != nil {
+		t.Fatalf("ExtractGoDoc() returned error: %v", err)
+	}
+	checkGolden(t, "godoc_separated", docs[0])
+
+	if _, err := input.ExtractGoDoc("broken.go", []byte("package"), input.BlockOptions{}); err == nil {
+		t.Errorf("ExtractGoDoc() of an invalid source returned no error")
+	}
+}
+
+func FuzzExtractGoDoc(f *testing.F) {
+	f.Add(readTestdata(f, "godoc/shapes.go"))
+	f.Add([]byte("// Package p\n//\n//   - [x]\n// #\npackage p\n\n// T [T.M].\ntype T int"))
+	f.Fuzz(func(t *testing.T, src []byte) {
+		docs, err := input.ExtractGoDoc("fuzz.go", src, input.BlockOptions{})
+		if err != nil {
+			return
+		}
+		for _, doc := range docs {
+			checkText(t, src, doc.Text)
+		}
+	})
+}
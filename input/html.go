@@ -0,0 +1,310 @@
+package input
+
+import (
+	"html"
+	"strings"
+)
+
+// ====== Types ======
+
+// htmlBlock represents an open HTML block element and the kind of its block.
+type htmlBlock struct {
+	name string
+	kind BlockKind
+}
+
+// htmlBlocks are the HTML elements that start and end a block of text, so their texts are separated by blank lines.
+var htmlBlocks = toSet(
+	"address", "article", "aside", "blockquote", "body", "caption", "dd", "details", "dialog", "div", "dl", "dt", "fieldset", "figcaption", "figure",
+	"footer", "form", "h1", "h2", "h3", "h4", "h5", "h6", "header", "hgroup", "hr", "html", "li", "main", "nav", "ol", "p", "pre", "section",
+	"summary", "table", "tbody", "td", "tfoot", "th", "thead", "tr", "ul",
+)
+
+// htmlSkipped are the HTML elements whose content is not the text of the document: the metadata, the embedded objects, the forms, and the boilerplate
+// (navigation, sidebars, and page footers).
+var htmlSkipped = toSet(
+	"head", "nav", "aside", "footer", "form", "button", "select", "iframe", "object", "svg", "math", "canvas", "video", "audio", "map", "template", "noscript",
+)
+
+// htmlRawText are the HTML elements whose content is not markup, so it is skipped up to the end tag.
+var htmlRawText = toSet("script", "style", "textarea", "title", "xmp")
+
+// htmlVoid are the HTML elements without content and end tags.
+var htmlVoid = toSet("area", "base", "br", "col", "embed", "hr", "img", "input", "link", "meta", "param", "source", "track", "wbr")
+
+// htmlBoilerplateRoles are the ARIA roles of the boilerplate elements.
+var htmlBoilerplateRoles = toSet("navigation", "banner", "contentinfo", "complementary", "search", "menu", "menubar", "toolbar")
+
+// ====== Functions ======
+
+// FromHTML accepts an HTML document or fragment and the options for its headings, list items, and table cells and returns its text. See `ExtractHTML`.
+func FromHTML(s string, bo BlockOptions) string {
+	return ExtractHTML(s, bo).Text
+}
+
+// ExtractHTML accepts an HTML document or fragment and the options for its headings, list items, and table cells and returns its Content.
+// The tags, comments, scripts, and styles are stripped, the character references are decoded ("&amp;" is "&"), and the whitespaces are collapsed.
+// The boilerplate is dropped: the head and the title, navigation, sidebars, footers, forms, embedded objects, the header of a page (but not of an article or the main content),
+// and the elements with the navigation ARIA roles, the "hidden" attribute, or `aria-hidden="true"`.
+// The blocks (paragraphs, headings, list items, table cells, and so on) are separated by blank lines, the line breaks ("<br>") are kept,
+// and the text of "<pre>" elements is kept as it is. The blocks inside a list item or a table cell ("<li><p>Text</p></li>") belong to it.
+// The parser is lenient and doesn't need a well-formed document.
+func ExtractHTML(s string, bo BlockOptions) Content {
+	w := blockWriter{bo: bo, kind: ParagraphBlock}
+	var blocks []htmlBlock
+	var skipped []string
+	content, pre := 0, 0
+	text := func(t string) {
+		if len(skipped) > 0 || t == "" {
+			return
+		}
+		if pre > 0 {
+			w.block.preformatted(html.UnescapeString(t))
+		} else {
+			w.block.text(html.UnescapeString(t))
+		}
+	}
+
+	for i := 0; i < len(s); {
+		lt := strings.IndexByte(s[i:], '<')
+		if lt < 0 {
+			text(s[i:])
+			break
+		}
+		text(s[i : i+lt])
+		i += lt
+
+		switch {
+		case strings.HasPrefix(s[i:], "<!--"):
+			i = skipPast(s, i+4, "-->")
+			continue
+		case strings.HasPrefix(s[i:], "<![CDATA["):
+			end := strings.Index(s[i:], "]]>")
+			if end < 0 {
+				end = len(s) - i
+			}
+			text(s[i+9 : i+end])
+			i = min(i+end+3, len(s))
+			continue
+		case strings.HasPrefix(s[i:], "<!"), strings.HasPrefix(s[i:], "<?"):
+			i = skipPast(s, i, ">")
+			continue
+		}
+
+		name, attrs, closing, end := parseTag(s, i)
+		if name == "" {
+			// A "<" that doesn't start a tag: "a < b".
+			text("<")
+			i++
+			continue
+		}
+		i = end
+		selfClosing := strings.HasSuffix(attrs, "/") || htmlVoid[name]
+
+		if len(skipped) > 0 {
+			if name == skipped[len(skipped)-1] && closing {
+				skipped = skipped[:len(skipped)-1]
+			} else if name == skipped[len(skipped)-1] && !selfClosing {
+				skipped = append(skipped, name)
+			}
+			continue
+		}
+		if closing {
+			switch name {
+			case "article", "main":
+				content = max(content-1, 0)
+			case "pre":
+				pre = max(pre-1, 0)
+			}
+			if htmlBlocks[name] {
+				blocks = closeHTMLBlock(blocks, name)
+				w.start(currentBlockKind(blocks))
+			}
+			continue
+		}
+
+		switch {
+		case htmlRawText[name]:
+			i = skipRawText(s, i, name)
+			continue
+		case htmlSkipped[name], name == "header" && content == 0, isHiddenHTML(attrs):
+			if !selfClosing {
+				skipped = append(skipped, name)
+			}
+			continue
+		case name == "article", name == "main":
+			content++
+		case name == "pre":
+			pre++
+		case name == "br":
+			w.block.lineBreak()
+		}
+		if htmlBlocks[name] {
+			kind := htmlBlockKind(name, currentBlockKind(blocks))
+			if !selfClosing {
+				blocks = append(blocks, htmlBlock{name, kind})
+			}
+			w.start(kind)
+		}
+	}
+	return w.content()
+}
+
+// htmlBlockKind returns the kind of the block of the element inside a block of the parent kind.
+func htmlBlockKind(name string, parent BlockKind) BlockKind {
+	switch name {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		return HeadingBlock
+	case "li", "dt", "dd":
+		return ListItemBlock
+	case "td", "th":
+		return TableCellBlock
+	case "ul", "ol", "dl", "table", "thead", "tbody", "tfoot", "tr":
+		return ParagraphBlock
+	}
+	return parent
+}
+
+// currentBlockKind returns the kind of the innermost open block, or ParagraphBlock if there is none.
+func currentBlockKind(blocks []htmlBlock) BlockKind {
+	if len(blocks) == 0 {
+		return ParagraphBlock
+	}
+	return blocks[len(blocks)-1].kind
+}
+
+// closeHTMLBlock returns the open blocks without the innermost block of the element and the blocks inside it. The blocks are unchanged if the element is not open.
+func closeHTMLBlock(blocks []htmlBlock, name string) []htmlBlock {
+	for i := len(blocks) - 1; i >= 0; i-- {
+		if blocks[i].name == name {
+			return blocks[:i]
+		}
+	}
+	return blocks
+}
+
+// parseTag parses the tag at the index of the string and returns its name in lower case, its attributes, whether it is an end tag, and the index after the tag.
+// The name is empty if there is no tag at the index.
+func parseTag(s string, i int) (name, attrs string, closing bool, end int) {
+	j := i + 1
+	if j < len(s) && s[j] == '/' {
+		closing = true
+		j++
+	}
+	start := j
+	for j < len(s) && (isASCIILetter(s[j]) || (j > start && (s[j] >= '0' && s[j] <= '9' || s[j] == '-' || s[j] == ':'))) {
+		j++
+	}
+	if j == start {
+		return "", "", false, i
+	}
+	name = strings.ToLower(s[start:j])
+
+	// The attributes end at the first ">" outside of quotes.
+	var quote byte
+	k := j
+	for ; k < len(s); k++ {
+		switch {
+		case quote != 0:
+			if s[k] == quote {
+				quote = 0
+			}
+		case s[k] == '"' || s[k] == '\'':
+			quote = s[k]
+		case s[k] == '>':
+			return name, strings.TrimSpace(s[j:k]), closing, k + 1
+		}
+	}
+	return name, strings.TrimSpace(s[j:]), closing, len(s)
+}
+
+// skipRawText returns the index after the end tag of the raw text element that starts at the index, or the end of the string if there is no end tag.
+func skipRawText(s string, i int, name string) int {
+	lower := strings.ToLower(s[i:])
+	end := strings.Index(lower, "</"+name)
+	if end < 0 {
+		return len(s)
+	}
+	return skipPast(s, i+end, ">")
+}
+
+// skipPast returns the index after the first occurrence of the substring starting from the index, or the end of the string if there is none.
+func skipPast(s string, i int, substr string) int {
+	end := strings.Index(s[i:], substr)
+	if end < 0 {
+		return len(s)
+	}
+	return i + end + len(substr)
+}
+
+// isHiddenHTML reports whether the attributes of an element hide it or mark it as boilerplate.
+func isHiddenHTML(attrs string) bool {
+	if attrs == "" {
+		return false
+	}
+	if htmlBoilerplateRoles[strings.ToLower(htmlAttribute(attrs, "role"))] || strings.EqualFold(htmlAttribute(attrs, "aria-hidden"), "true") {
+		return true
+	}
+	_, hidden := htmlAttributeValue(attrs, "hidden")
+	return hidden
+}
+
+// htmlAttribute returns the value of the attribute, or an empty string if there is none.
+func htmlAttribute(attrs, name string) string {
+	value, _ := htmlAttributeValue(attrs, name)
+	return value
+}
+
+// htmlAttributeValue returns the value of the attribute and true if the element has the attribute.
+func htmlAttributeValue(attrs, name string) (string, bool) {
+	for len(attrs) > 0 {
+		attrs = strings.TrimLeft(attrs, " \t\r\n/")
+		end := strings.IndexAny(attrs, "= \t\r\n/")
+		if end < 0 {
+			end = len(attrs)
+		}
+		key := strings.ToLower(attrs[:end])
+		attrs = strings.TrimLeft(attrs[end:], " \t\r\n")
+		value := ""
+		if strings.HasPrefix(attrs, "=") {
+			attrs = strings.TrimLeft(attrs[1:], " \t\r\n")
+			if len(attrs) > 0 && (attrs[0] == '"' || attrs[0] == '\'') {
+				quote := attrs[0]
+				closing := strings.IndexByte(attrs[1:], quote)
+				if closing < 0 {
+					closing = len(attrs) - 1
+				}
+				value = attrs[1 : closing+1]
+				attrs = attrs[min(closing+2, len(attrs)):]
+			} else {
+				end := strings.IndexAny(attrs, " \t\r\n")
+				if end < 0 {
+					end = len(attrs)
+				}
+				value = attrs[:end]
+				attrs = attrs[end:]
+			}
+		}
+		if key == name {
+			return html.UnescapeString(value), true
+		}
+		if key == "" && value == "" {
+			break
+		}
+	}
+	return "", false
+}
+
+// isASCIILetter reports whether the byte is an ASCII letter.
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// toSet returns the set of the strings.
+func toSet(values ...string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
@@ -0,0 +1,65 @@
+package input_test
+
+import (
+	"goreadability/input"
+	"reflect"
+	"testing"
+)
+
+func TestExtractHTML(t *testing.T) {
+	page := string(readTestdata(t, "page.html"))
+	tests := map[string]input.BlockOptions{
+		"page":           {},
+		"page_sentences": {Headings: input.SentenceBlocks, ListItems: input.SentenceBlocks, TableCells: input.SentenceBlocks},
+		"page_separated": {Headings: input.SeparateBlocks, ListItems: input.ExcludeBlocks, TableCells: input.SeparateBlocks},
+	}
+	for name, bo := range tests {
+		checkGolden(t, name, input.ExtractHTML(page, bo))
+	}
+}
+
+func FuzzExtractHTML(f *testing.F) {
+	f.Add(string(readTestdata(f, "page.html")))
+	f.Add("<p>Unclosed <b>tags <li>and &amp items")
+	f.Add("<script>no end")
+	f.Add("<<>>&#xFFFFFF;&#0;</p></div>")
+	f.Fuzz(func(t *testing.T, s string) {
+		content := input.ExtractHTML(s, input.BlockOptions{Headings: input.SeparateBlocks})
+		checkText(t, []byte(s), content.Text)
+	})
+}
+
+func TestFromHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		bo   input.BlockOptions
+		want string
+	}{
+		{"paragraphs", "<p>One  two\nthree.</p><p>Four.</p>", input.BlockOptions{}, "One two three.\n\nFour."},
+		{"inline tags", "<p>A <b>bold</b> and <a href=\"x\">linked</a> word.</p>", input.BlockOptions{}, "A bold and linked word."},
+		{"character references", "<p>Fish &amp; chips &lt;3 &#8212; &#x41;&nbsp;B.</p>", input.BlockOptions{}, "Fish & chips <3 — A B."},
+		{"line break", "<p>First line<br>second line.</p>", input.BlockOptions{}, "First line\nsecond line."},
+		{"boilerplate", "<head><title>T</title></head><nav>Menu</nav><header>Site</header><p>Text.</p><footer>Footer</footer><form>Search</form>", input.BlockOptions{}, "Text."},
+		{"scripts and comments", "<p>Text.<script>var a = '<p>';</script><!-- <p>comment</p> --><style>p {}</style></p>", input.BlockOptions{}, "Text."},
+		{"hidden elements", "<p>Seen.</p><div hidden>Unseen.</div><p aria-hidden=\"true\">Unseen.</p><div role=\"navigation\">Menu</div>", input.BlockOptions{}, "Seen."},
+		{"article header", "<article><header><h1>Title</h1></header><p>Text.</p></article>", input.BlockOptions{}, "Title\n\nText."},
+		{"pre", "<pre>a  b\n  c</pre>", input.BlockOptions{}, "a  b\n  c"},
+		{"heading sentence", "<h2>Title</h2><p>Text.</p>", input.BlockOptions{Headings: input.SentenceBlocks}, "Title.\n\nText."},
+		{"heading excluded", "<h2>Title</h2><p>Text.</p>", input.BlockOptions{Headings: input.ExcludeBlocks}, "Text."},
+		{"list items", "<ul><li>One</li><li><p>Two</p></li></ul>", input.BlockOptions{ListItems: input.SentenceBlocks}, "One.\n\nTwo."},
+		{"table cells", "<table><tr><td>A</td><td>B!</td></tr></table>", input.BlockOptions{TableCells: input.SentenceBlocks}, "A.\n\nB!"},
+		{"unclosed tags", "<p>Unclosed <b>tags <li>and &amp items", input.BlockOptions{}, "Unclosed tags\n\nand & items"},
+	}
+	for _, test := range tests {
+		if got := input.FromHTML(test.s, test.bo); got != test.want {
+			t.Errorf("FromHTML() %s = %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	content := input.ExtractHTML("<h1>Title</h1><p>Text.</p><ul><li>Item</li></ul>", input.BlockOptions{Headings: input.SeparateBlocks, ListItems: input.SeparateBlocks})
+	want := []input.Block{{Kind: input.HeadingBlock, Text: "Title"}, {Kind: input.ListItemBlock, Text: "Item"}}
+	if content.Text != "Text." || !reflect.DeepEqual(content.Separated, want) {
+		t.Errorf("ExtractHTML() = %q, %+v, want %q, %+v", content.Text, content.Separated, "Text.", want)
+	}
+}
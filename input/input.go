@@ -0,0 +1,266 @@
+// Package `input` provides functions to extract the plain text of documents in other formats (HTML, Markdown, and so on),
+// so it can be passed to the readability formulas and the analyzers.
+//
+// The extracted paragraphs, headings, list items, and table cells are separated by blank lines, which end sentences (see `stats.Sentences`),
+// so a heading without a terminator is not merged with the next sentence. How the headings, list items, and table cells are counted is set by `BlockOptions`.
+package input
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// BlockPolicy represents how the blocks that usually have no terminator (headings, list items, and table cells) are treated.
+// Without a terminator, a block is a sentence of its own that is not counted (see `stats.CountSentences`), but its words are,
+// which inflates the average sentence length of the text.
+type BlockPolicy int
+
+const (
+	// KeepBlocks keeps the blocks in the text as they are, as paragraphs of their own.
+	KeepBlocks BlockPolicy = iota
+	// SentenceBlocks adds a point to the blocks without a terminator, so every block counts as a sentence.
+	SentenceBlocks
+	// ExcludeBlocks drops the blocks from the text.
+	ExcludeBlocks
+	// SeparateBlocks moves the blocks out of the text to `Content.Separated`, so they can be scored separately.
+	SeparateBlocks
+)
+
+// BlockOptions represents how the headings, the list items, and the table cells of a document are treated. The zero value keeps all of them.
+type BlockOptions struct {
+	Headings   BlockPolicy `json:"headings"`
+	ListItems  BlockPolicy `json:"list_items"`
+	TableCells BlockPolicy `json:"table_cells"`
+}
+
+// BlockKind represents the kind of a block of a document.
+type BlockKind string
+
+const (
+	ParagraphBlock BlockKind = "paragraph"
+	HeadingBlock   BlockKind = "heading"
+	ListItemBlock  BlockKind = "list_item"
+	TableCellBlock BlockKind = "table_cell"
+)
+
+// Block represents a block of a document with its text.
+type Block struct {
+	Kind BlockKind `json:"kind"`
+	Text string    `json:"text"`
+}
+
+// Content represents the text extracted from a document and the blocks separated from it by the `SeparateBlocks` policy, in the order of the document.
+type Content struct {
+	Text      string  `json:"text"`
+	Separated []Block `json:"separated"`
+}
+
+// textWriter builds a text, collapsing the whitespaces and separating the blocks by blank lines.
+type textWriter struct {
+	b strings.Builder
+	// pending is the separator to write before the next text: "", " ", "\n", or "\n\n".
+	pending string
+}
+
+// blockWriter builds the Content of a document block by block, applying the BlockOptions to the blocks.
+type blockWriter struct {
+	out       textWriter
+	bo        BlockOptions
+	kind      BlockKind
+	block     textWriter
+	separated []Block
+}
+
+// lineConverter converts a line-based markup document (Markdown, reStructuredText, AsciiDoc) into text block by block.
+type lineConverter struct {
+	w blockWriter
+	// kind is the kind of the current block, or empty if there is none.
+	kind BlockKind
+	// inline removes the inline markup of the format from a line.
+	inline func(string) string
+}
+
+// windows1252 are the characters of the bytes 0x80–0x9F in Windows-1252, or 0 for the undefined bytes.
+var windows1252 = [32]rune{
+	'€', 0, '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', 0, 'Ž', 0,
+	0, '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', 0, 'ž', 'Ÿ',
+}
+
+// ====== Methods ======
+
+// SeparatedText returns the texts of the separated blocks of the kind, separated by blank lines, so they can be scored as one text.
+func (c Content) SeparatedText(kind BlockKind) string {
+	var texts []string
+	for _, block := range c.Separated {
+		if block.Kind == kind {
+			texts = append(texts, block.Text)
+		}
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+// text writes the text with its whitespaces collapsed into single spaces.
+func (w *textWriter) text(s string) {
+	if s == "" {
+		return
+	}
+	if first, _ := utf8.DecodeRuneInString(s); unicode.IsSpace(first) && w.pending == "" {
+		w.pending = " "
+	}
+	fields := strings.Fields(s)
+	for i, field := range fields {
+		if i > 0 {
+			w.pending = " "
+		}
+		w.write(field)
+	}
+	if last, _ := utf8.DecodeLastRuneInString(s); unicode.IsSpace(last) && len(fields) > 0 {
+		w.pending = " "
+	}
+}
+
+// preformatted writes the text as it is, with its whitespaces and line breaks.
+func (w *textWriter) preformatted(s string) {
+	if strings.TrimSpace(s) == "" {
+		return
+	}
+	w.write(strings.Trim(s, "\n"))
+}
+
+// write writes the string after the pending separator.
+func (w *textWriter) write(s string) {
+	if w.b.Len() > 0 {
+		w.b.WriteString(w.pending)
+	}
+	w.pending = ""
+	w.b.WriteString(s)
+}
+
+// lineBreak separates the next text by a line break, unless it is already separated by a blank line.
+func (w *textWriter) lineBreak() {
+	if w.pending != "\n\n" {
+		w.pending = "\n"
+	}
+}
+
+// block separates the next text by a blank line.
+func (w *textWriter) block() {
+	w.pending = "\n\n"
+}
+
+// String returns the text written so far.
+func (w *textWriter) String() string {
+	return w.b.String()
+}
+
+// start ends the current block and starts a block of the kind.
+func (w *blockWriter) start(kind BlockKind) {
+	w.flush()
+	w.kind = kind
+}
+
+// flush ends the current block and writes it according to the options.
+func (w *blockWriter) flush() {
+	text := strings.Trim(w.block.String(), "\n")
+	w.block = textWriter{}
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	policy := KeepBlocks
+	switch w.kind {
+	case HeadingBlock:
+		policy = w.bo.Headings
+	case ListItemBlock:
+		policy = w.bo.ListItems
+	case TableCellBlock:
+		policy = w.bo.TableCells
+	}
+
+	switch policy {
+	case ExcludeBlocks:
+		return
+	case SeparateBlocks:
+		w.separated = append(w.separated, Block{w.kind, text})
+		return
+	case SentenceBlocks:
+		text = terminate(text)
+	}
+	w.out.block()
+	w.out.write(text)
+	w.out.block()
+}
+
+// content ends the current block and returns the Content.
+func (w *blockWriter) content() Content {
+	w.flush()
+	return Content{w.out.String(), w.separated}
+}
+
+// start ends the current block and starts a block of the kind with the line.
+func (c *lineConverter) start(kind BlockKind, line string) {
+	c.w.start(kind)
+	c.kind = kind
+	c.w.block.text(c.inline(line))
+}
+
+// continueBlock adds the line to the current block.
+func (c *lineConverter) continueBlock(line string) {
+	c.w.block.text(" " + c.inline(line))
+}
+
+// flush ends the current block.
+func (c *lineConverter) flush() {
+	c.w.flush()
+	c.kind = ""
+}
+
+// ====== Functions ======
+
+// trimRemovedSpace trims the trailing spaces of the text written so far if the rest of the line starts with a punctuation mark,
+// so the markup removed between a word and the punctuation ("with `code`.") doesn't leave a space before it.
+func trimRemovedSpace(b *strings.Builder, rest string) {
+	if rest == "" || !strings.ContainsRune(".,;:!?)", rune(rest[0])) {
+		return
+	}
+	trimmed := strings.TrimRight(b.String(), " ")
+	b.Reset()
+	b.WriteString(trimmed)
+}
+
+// terminate returns the text with a point added if it doesn't end with a sentence terminator (".", "!", "?", "…"), so it counts as a sentence.
+func terminate(text string) string {
+	last, _ := utf8.DecodeLastRuneInString(strings.TrimRight(text, "\"')]”’»"))
+	if strings.ContainsRune(".!?…‽。！？", last) {
+		return text
+	}
+	return text + "."
+}
+
+// charsetReader returns a reader that decodes the text in the charset to UTF-8 (see `xml.Decoder.CharsetReader`). An undeclared charset is UTF-8,
+// and ISO-8859-1 is read as Windows-1252, its superset.
+func charsetReader(charset string, r io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "", "utf-8", "utf8", "us-ascii", "ascii":
+		return r, nil
+	case "iso-8859-1", "latin1", "windows-1252", "cp1252":
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		decoded := make([]byte, 0, len(data))
+		for _, b := range data {
+			char := rune(b)
+			if b >= 0x80 && b < 0xA0 && windows1252[b-0x80] != 0 {
+				char = windows1252[b-0x80]
+			}
+			decoded = utf8.AppendRune(decoded, char)
+		}
+		return strings.NewReader(string(decoded)), nil
+	}
+	return nil, fmt.Errorf("Unsupported charset %q. Cannot decode the text.", charset)
+}
@@ -0,0 +1,84 @@
+package input_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"unicode/utf8"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// readTestdata returns the content of the file in testdata.
+func readTestdata(t testing.TB, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// checkGolden compares the value with the golden file in testdata, or writes the file with -update.
+// A string is compared as it is, and other values are marshaled to indented JSON.
+func checkGolden(t testing.TB, name string, value any) {
+	t.Helper()
+	var b bytes.Buffer
+	if text, ok := value.(string); ok {
+		b.WriteString(text)
+	} else {
+		encoder := json.NewEncoder(&b)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := b.Bytes()
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s: got\n%s\nwant\n%s", name, got, want)
+	}
+}
+
+// checkText reports an error if the extracted text of a valid UTF-8 input is not valid UTF-8.
+func checkText(t testing.TB, input []byte, text string) {
+	t.Helper()
+	if utf8.Valid(input) && !utf8.ValidString(text) {
+		t.Errorf("the text of %q is not valid UTF-8: %q", input, text)
+	}
+}
+
+// zipFiles returns a ZIP archive of the files, mapped from their names in the archive to their contents, in the order of the names.
+func zipFiles(t testing.TB, names []string, contents map[string][]byte) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	w := zip.NewWriter(&b)
+	for _, name := range names {
+		file, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := file.Write(contents[name]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,342 @@
+package input
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// latexConverter converts a LaTeX document into text.
+type latexConverter struct {
+	w blockWriter
+	// run is the text since the last block boundary, written to the block at the next boundary.
+	run strings.Builder
+}
+
+// latexSkippedEnvironments are the LaTeX environments whose content is not running text: math, code, drawings, tables, and the bibliography.
+var latexSkippedEnvironments = toSet(
+	"equation", "equation*", "align", "align*", "alignat", "alignat*", "flalign", "flalign*", "gather", "gather*", "multline", "multline*",
+	"eqnarray", "eqnarray*", "displaymath", "math", "split", "verbatim", "verbatim*", "Verbatim", "lstlisting", "minted", "comment",
+	"tikzpicture", "picture", "tabular", "tabular*", "tabularx", "longtable", "array", "thebibliography",
+)
+
+// latexHeadings are the LaTeX sectioning commands, whose arguments are headings.
+var latexHeadings = toSet("part", "chapter", "section", "subsection", "subsubsection", "paragraph", "subparagraph", "title", "caption")
+
+// latexDropped maps the LaTeX commands whose arguments are not running text to the number of their mandatory arguments:
+// citations, references, labels, URLs, graphics, spacing, and the preamble commands.
+var latexDropped = map[string]int{
+	"cite": 1, "citep": 1, "citet": 1, "citealp": 1, "citeauthor": 1, "citeyear": 1, "parencite": 1, "textcite": 1, "autocite": 1, "nocite": 1,
+	"ref": 1, "eqref": 1, "pageref": 1, "autoref": 1, "cref": 1, "Cref": 1, "label": 1, "url": 1, "includegraphics": 1, "footnote": 1, "index": 1,
+	"vspace": 1, "hspace": 1, "vspace*": 1, "hspace*": 1, "input": 1, "include": 1, "bibliography": 1, "bibliographystyle": 1, "usepackage": 1,
+	"documentclass": 1, "author": 1, "date": 1, "pagestyle": 1, "thispagestyle": 1, "setlength": 2, "setcounter": 2, "addtocounter": 2,
+	"newcommand": 2, "renewcommand": 2, "providecommand": 2, "newenvironment": 3, "renewenvironment": 3,
+}
+
+// latexSymbols maps the LaTeX commands of text symbols to the symbols.
+var latexSymbols = map[string]string{
+	"ldots": "…", "dots": "…", "textellipsis": "…", "LaTeX": "LaTeX", "TeX": "TeX", "textendash": "–", "textemdash": "—", "S": "§", "P": "¶",
+	"ss": "ß", "ae": "æ", "AE": "Æ", "oe": "œ", "OE": "Œ", "o": "ø", "O": "Ø", "aa": "å", "AA": "Å", "l": "ł", "L": "Ł", "i": "ı",
+	"textquoteleft": "‘", "textquoteright": "’", "textquotedblleft": "“", "textquotedblright": "”", "textasciitilde": "~", "textbackslash": "\\",
+}
+
+// latexAccents maps the LaTeX accent commands to the combining marks, so "\'e" is "é" once normalized.
+var latexAccents = map[byte]string{
+	'\'': "́", '`': "̀", '^': "̂", '"': "̈", '~': "̃", '=': "̄", '.': "̇", 'c': "̧", 'v': "̌", 'u': "̆", 'H': "̋",
+}
+
+// ====== Methods ======
+
+// start writes the run and starts a block of the kind.
+func (c *latexConverter) start(kind BlockKind) {
+	c.flushRun()
+	c.w.start(kind)
+}
+
+// flushRun writes the run to the current block.
+func (c *latexConverter) flushRun() {
+	c.w.block.text(c.run.String())
+	c.run.Reset()
+}
+
+// convert converts the LaTeX source into the text of the blocks.
+func (c *latexConverter) convert(s string) {
+	for i := 0; i < len(s); {
+		switch ch := s[i]; ch {
+		case '%':
+			// A comment runs to the end of the line and joins the lines.
+			i = skipPast(s, i, "\n")
+			i = skipSpacesAndTabs(s, i)
+		case '\n':
+			j := i + 1
+			for j < len(s) && (s[j] == ' ' || s[j] == '\t' || s[j] == '\r' || s[j] == '\n') {
+				j++
+			}
+			if strings.Count(s[i:j], "\n") >= 2 {
+				c.start(ParagraphBlock)
+			} else {
+				c.run.WriteByte(' ')
+			}
+			i = j
+		case '$':
+			if strings.HasPrefix(s[i:], "$$") {
+				i = skipPast(s, i+2, "$$")
+			} else {
+				i = skipInlineMath(s, i+1)
+			}
+		case '{', '}':
+			i++
+		case '~':
+			c.run.WriteByte(' ')
+			i++
+		case '-':
+			switch {
+			case strings.HasPrefix(s[i:], "---"):
+				c.run.WriteString("—")
+				i += 3
+			case strings.HasPrefix(s[i:], "--"):
+				c.run.WriteString("–")
+				i += 2
+			default:
+				c.run.WriteByte(ch)
+				i++
+			}
+		case '`', '\'':
+			if strings.HasPrefix(s[i:], "``") || strings.HasPrefix(s[i:], "''") {
+				c.run.WriteByte('"')
+				i += 2
+			} else {
+				c.run.WriteByte(ch)
+				i++
+			}
+		case '\\':
+			i = c.command(s, i)
+		default:
+			c.run.WriteByte(ch)
+			i++
+		}
+	}
+}
+
+// command converts the command that starts at the index and returns the index after it.
+func (c *latexConverter) command(s string, i int) int {
+	if i+1 >= len(s) {
+		return len(s)
+	}
+	next := s[i+1]
+	switch {
+	case next == '\\':
+		c.flushRun()
+		c.w.block.lineBreak()
+		return skipOptionalArgs(s, i+2)
+	case next == '(':
+		return skipPast(s, i+2, "\\)")
+	case next == '[':
+		// The display math separates the words around it.
+		c.run.WriteByte(' ')
+		return skipPast(s, i+2, "\\]")
+	case strings.IndexByte("&%$#_{}", next) >= 0:
+		c.run.WriteByte(next)
+		return i + 2
+	case next == ' ' || next == ',' || next == ';' || next == ':' || next == '\n':
+		c.run.WriteByte(' ')
+		return i + 2
+	case next == '-' || next == '/' || next == '@':
+		return i + 2
+	}
+	if mark, ok := latexAccents[next]; ok && (!isASCIILetter(next) || !isASCIILetter(byteAt(s, i+2))) {
+		j := skipSpacesAndTabs(s, i+2)
+		braced := byteAt(s, j) == '{'
+		if braced {
+			j++
+		}
+		letter, size := utf8.DecodeRuneInString(s[j:])
+		if unicode.IsLetter(letter) {
+			c.run.WriteRune(letter)
+			c.run.WriteString(mark)
+			j += size
+			if braced && byteAt(s, j) == '}' {
+				j++
+			}
+			return j
+		}
+	}
+
+	j := i + 1
+	for j < len(s) && isASCIILetter(s[j]) {
+		j++
+	}
+	if j == i+1 {
+		// An unknown control symbol, which can be a multibyte character.
+		_, size := utf8.DecodeRuneInString(s[i+1:])
+		return i + 1 + size
+	}
+	name := s[i+1 : j]
+	if byteAt(s, j) == '*' {
+		name += "*"
+		j++
+	}
+
+	switch {
+	case name == "begin":
+		env, end := latexArgument(s, j)
+		if latexSkippedEnvironments[env] {
+			c.run.WriteByte(' ')
+			return skipPast(s, end, "\\end{"+env+"}")
+		}
+		c.start(ParagraphBlock)
+		return skipOptionalArgs(s, end)
+	case name == "end":
+		_, end := latexArgument(s, j)
+		c.start(ParagraphBlock)
+		return end
+	case latexHeadings[strings.TrimSuffix(name, "*")]:
+		title, end := latexArgument(s, skipOptionalArgs(s, j))
+		c.start(HeadingBlock)
+		c.convert(title)
+		c.start(ParagraphBlock)
+		return end
+	case name == "item":
+		c.start(ListItemBlock)
+		j = skipSpacesAndTabs(s, j)
+		if byteAt(s, j) == '[' {
+			if end := strings.IndexByte(s[j:], ']'); end > 0 {
+				c.convert(s[j+1 : j+end])
+				c.run.WriteByte(' ')
+				return j + end + 1
+			}
+		}
+		return j
+	case name == "href":
+		_, end := latexArgument(s, j)
+		text, end := latexArgument(s, end)
+		c.convert(text)
+		return end
+	}
+	if args, ok := latexDropped[name]; ok {
+		j = skipOptionalArgs(s, j)
+		for range args {
+			_, j = latexArgument(s, j)
+			j = skipOptionalArgs(s, j)
+		}
+		if strings.IndexByte(".,;:)!?", byteAt(s, j)) >= 0 {
+			// "Smith~\cite{smith}, who" is "Smith, who".
+			run := strings.TrimRight(c.run.String(), " ")
+			c.run.Reset()
+			c.run.WriteString(run)
+			if byteAt(s, j) == '.' && strings.HasSuffix(run, ".") {
+				// "See Fig.~\ref{f}." is "See Fig.", and the point of the abbreviation ends the sentence.
+				j++
+			}
+		}
+		return j
+	}
+	if symbol, ok := latexSymbols[name]; ok {
+		c.run.WriteString(symbol)
+		if byteAt(s, j) == '{' && byteAt(s, j+1) == '}' {
+			j += 2
+		}
+		return j
+	}
+	// Other commands are dropped, and their arguments are kept as text: "\emph{word}" is "word".
+	return skipOptionalArgs(s, j)
+}
+
+// ====== Functions ======
+
+// FromLaTeX accepts a LaTeX document or fragment and the options for its headings and list items and returns its running text. See `ExtractLaTeX`.
+func FromLaTeX(s string, bo BlockOptions) string {
+	return ExtractLaTeX(s, bo).Text
+}
+
+// ExtractLaTeX accepts a LaTeX document or fragment and the options for its headings and list items and returns its Content.
+// Only the body of a document (between "\begin{document}" and "\end{document}") is read. The comments, math (inline and display, "$...$", "\[...\]",
+// and the math environments), code and tables environments, citations, references, labels, footnotes, URLs, graphics, and the bibliography are removed.
+// The arguments of other commands are kept as text ("\emph{word}" is "word"), the sectioning commands ("\section{...}") are headings,
+// and the items of lists are list items. The special characters, dashes, quotes, and accents are converted ("\&" is "&", "---" is "—", "\'e" is "é").
+func ExtractLaTeX(s string, bo BlockOptions) Content {
+	if start := strings.Index(s, "\\begin{document}"); start >= 0 {
+		s = s[start+len("\\begin{document}"):]
+		if end := strings.Index(s, "\\end{document}"); end >= 0 {
+			s = s[:end]
+		}
+	}
+	c := latexConverter{w: blockWriter{bo: bo, kind: ParagraphBlock}}
+	c.convert(strings.ReplaceAll(s, "\r\n", "\n"))
+	c.flushRun()
+	return c.w.content()
+}
+
+// latexArgument returns the content of the braced argument after the index (after spaces) and the index after it.
+// If there is no braced argument, the next character is the argument.
+func latexArgument(s string, i int) (string, int) {
+	i = skipSpacesAndTabs(s, i)
+	if i >= len(s) {
+		return "", i
+	}
+	if s[i] != '{' {
+		_, size := utf8.DecodeRuneInString(s[i:])
+		return s[i : i+size], i + size
+	}
+	depth := 0
+	for j := i; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return s[i+1 : j], j + 1
+			}
+		}
+	}
+	return s[i+1:], len(s)
+}
+
+// skipOptionalArgs returns the index after the optional arguments ("[...]") at the index.
+func skipOptionalArgs(s string, i int) int {
+	for {
+		j := skipSpacesAndTabs(s, i)
+		if byteAt(s, j) != '[' {
+			return i
+		}
+		end := strings.IndexByte(s[j:], ']')
+		if end < 0 {
+			return i
+		}
+		i = j + end + 1
+	}
+}
+
+// skipInlineMath returns the index after the "$" that closes the inline math starting at the index.
+func skipInlineMath(s string, i int) int {
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '$':
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// skipSpacesAndTabs returns the index of the first character after the index that is not a space or a tab.
+func skipSpacesAndTabs(s string, i int) int {
+	for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+		i++
+	}
+	return i
+}
+
+// byteAt returns the byte at the index, or 0 if the index is out of the string.
+func byteAt(s string, i int) byte {
+	if i < 0 || i >= len(s) {
+		return 0
+	}
+	return s[i]
+}
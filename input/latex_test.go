@@ -0,0 +1,50 @@
+package input_test
+
+import (
+	"goreadability/input"
+	"testing"
+)
+
+func TestFromLaTeX(t *testing.T) {
+	tests := map[string]string{
+		`See Fig.~\ref{f}.`:       "See Fig.",
+		`See Fig.~\ref{f}. Next.`: "See Fig. Next.",
+		`As in Fig.~\ref{f}, the`: "As in Fig., the",
+		`Smith~\cite{smith}, who`: "Smith, who",
+		`The end~\cite{a}.`:       "The end.",
+		`\documentclass{article}\begin{document}Body.\end{document}After.`: "Body.",
+		`An \emph{important} \textbf{word}.`:                               "An important word.",
+		`Text % a comment` + "\n" + `more.`:                                "Text more.",
+		`Energy $E = mc^2$ is \[ x \] conserved.`:                          "Energy is conserved.",
+		`Fish \& chips --- 10\% off.`:                                      "Fish & chips — 10% off.",
+		"``Quoted'' caf\\'e.":                                              "\"Quoted\" cafe\u0301.",
+		`Text\footnote{A note.} here\label{l}.`:                            "Text here.",
+		`\section{Intro}Text.`:                                             "Intro\n\nText.",
+		`\begin{itemize}\item One.\item Two.\end{itemize}`:                 "One.\n\nTwo.",
+		`Before.\begin{equation}x = 1\end{equation}After.`:                 "Before. After.",
+		`Before.\[x = 1\]After.`:                                           "Before. After.",
+		`See \url{https://example.com} now.`:                               "See now.",
+	}
+	for latex, want := range tests {
+		if got := input.FromLaTeX(latex, input.BlockOptions{}); got != want {
+			t.Errorf("FromLaTeX(%q) = %q, want %q", latex, got, want)
+		}
+	}
+}
+
+func TestExtractLaTeX(t *testing.T) {
+	latex := string(readTestdata(t, "paper.tex"))
+	checkGolden(t, "paper", input.ExtractLaTeX(latex, input.BlockOptions{}))
+	checkGolden(t, "paper_separated", input.ExtractLaTeX(latex, input.BlockOptions{Headings: input.SeparateBlocks, ListItems: input.ExcludeBlocks}))
+}
+
+func FuzzExtractLaTeX(f *testing.F) {
+	f.Add(readTestdata(f, "paper.tex"))
+	f.Add([]byte(`\begin{document}\section{\emph{Open`))
+	f.Add([]byte(`\'{\item[}$\\`))
+	f.Add([]byte(`\½`))
+	f.Fuzz(func(t *testing.T, latex []byte) {
+		content := input.ExtractLaTeX(string(latex), input.BlockOptions{Headings: input.SeparateBlocks})
+		checkText(t, latex, content.Text)
+	})
+}
@@ -0,0 +1,231 @@
+package input
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ====== Types ======
+
+// Message represents a message of a localization file: its key, the context that disambiguates it, the source text, and the translation.
+// The messages of a key/value file (Apple ".strings", Java ".properties") have their values as the source texts, and no translations.
+type Message struct {
+	Key         string `json:"key"`
+	Context     string `json:"context,omitempty"`
+	Source      string `json:"source"`
+	Translation string `json:"translation,omitempty"`
+	// Fuzzy is true if the translation is marked as needing a review.
+	Fuzzy bool `json:"fuzzy,omitempty"`
+}
+
+// Catalog represents the messages of a localization file with their source and target languages (ISO 639-1 codes or locales), if the file declares them.
+type Catalog struct {
+	SourceLanguage string    `json:"source_language,omitempty"`
+	TargetLanguage string    `json:"target_language,omitempty"`
+	Messages       []Message `json:"messages"`
+}
+
+// ====== Functions ======
+
+// ExtractCatalogFile accepts a path to a localization file and returns its Catalog. The format is chosen by the extension of the file:
+// ".po" and ".pot" (see `ExtractPO`), ".xlf" and ".xliff" (see `ExtractXLIFF`), ".strings" (see `ExtractStrings`), and ".properties" (see `ExtractProperties`).
+func ExtractCatalogFile(path string) (Catalog, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Catalog{}, err
+	}
+	defer file.Close()
+
+	switch extension := strings.ToLower(filepath.Ext(path)); extension {
+	case ".po", ".pot":
+		return ExtractPO(file)
+	case ".xlf", ".xliff":
+		return ExtractXLIFF(file)
+	case ".strings":
+		return ExtractStrings(file)
+	case ".properties":
+		return ExtractProperties(file)
+	default:
+		return Catalog{}, fmt.Errorf("Unknown localization file extension %q. Cannot extract messages.", extension)
+	}
+}
+
+// PairCatalogs accepts the catalogs of the source and the translated key/value files ("en.strings" and "de.strings") and returns the Catalog of the source messages
+// with the translations of the same keys. The languages of the result are the source languages of the catalogs.
+func PairCatalogs(source, translation Catalog) Catalog {
+	translations := make(map[string]string, len(translation.Messages))
+	for _, message := range translation.Messages {
+		translations[message.Context+"\x04"+message.Key] = message.Source
+	}
+	paired := Catalog{SourceLanguage: source.SourceLanguage, TargetLanguage: translation.SourceLanguage}
+	for _, message := range source.Messages {
+		message.Translation = translations[message.Context+"\x04"+message.Key]
+		paired.Messages = append(paired.Messages, message)
+	}
+	return paired
+}
+
+// ExtractStrings accepts an Apple ".strings" file in UTF-8 and returns its messages (`"key" = "value";`) in the order of the file, without the comments.
+func ExtractStrings(r io.Reader) (Catalog, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Catalog{}, err
+	}
+	s := strings.TrimPrefix(string(data), "\ufeff")
+
+	var catalog Catalog
+	var pair []string
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], "/*"):
+			i = skipPast(s, i+2, "*/")
+		case strings.HasPrefix(s[i:], "//"):
+			i = skipPast(s, i+2, "\n")
+		case s[i] == '"':
+			value, end, ok := stringsLiteral(s, i)
+			if !ok {
+				return Catalog{}, fmt.Errorf("Unclosed string at byte %d. Cannot read strings file.", i)
+			}
+			pair = append(pair, value)
+			i = end
+		case s[i] == ';':
+			if len(pair) == 2 {
+				catalog.Messages = append(catalog.Messages, Message{Key: pair[0], Source: pair[1]})
+			}
+			pair = nil
+			i++
+		default:
+			i++
+		}
+	}
+	return catalog, nil
+}
+
+// stringsLiteral parses the quoted string of a ".strings" file at the index and returns its value, the index after it, and true, or false if it is not closed.
+func stringsLiteral(s string, i int) (string, int, bool) {
+	var b strings.Builder
+	for j := i + 1; j < len(s); j++ {
+		switch s[j] {
+		case '"':
+			return b.String(), j + 1, true
+		case '\\':
+			j++
+			if j == len(s) {
+				return "", 0, false
+			}
+			switch s[j] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case 'U', 'u':
+				if j+4 < len(s) {
+					if code, err := strconv.ParseUint(s[j+1:j+5], 16, 32); err == nil {
+						b.WriteRune(rune(code))
+						j += 4
+						continue
+					}
+				}
+				b.WriteByte(s[j])
+			default:
+				b.WriteByte(s[j])
+			}
+		default:
+			b.WriteByte(s[j])
+		}
+	}
+	return "", 0, false
+}
+
+// ExtractProperties accepts a Java ".properties" file and returns its messages ("key=value", "key: value", "key value") in the order of the file.
+// The comments ("#", "!") are skipped, the lines ending with a backslash are continued, and the escapes ("\n", "\u00e9") are decoded.
+func ExtractProperties(r io.Reader) (Catalog, error) {
+	var catalog Catalog
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var logical string
+	for scanner.Scan() {
+		line := strings.TrimLeft(scanner.Text(), " \t\f")
+		if logical == "" && (line == "" || line[0] == '#' || line[0] == '!') {
+			continue
+		}
+		if trailing := len(line) - len(strings.TrimRight(line, "\\")); trailing%2 == 1 {
+			logical += line[:len(line)-1]
+			continue
+		}
+		logical += line
+
+		key, value := propertiesEntry(logical)
+		logical = ""
+		catalog.Messages = append(catalog.Messages, Message{Key: key, Source: value})
+	}
+	if logical != "" {
+		key, value := propertiesEntry(logical)
+		catalog.Messages = append(catalog.Messages, Message{Key: key, Source: value})
+	}
+	return catalog, scanner.Err()
+}
+
+// propertiesEntry splits the logical line of a ".properties" file into its unescaped key and value.
+func propertiesEntry(line string) (string, string) {
+	end := len(line)
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.IndexByte("=: \t\f", line[i]) >= 0 {
+			end = i
+			break
+		}
+	}
+	value := strings.TrimLeft(line[end:], " \t\f")
+	if value != "" && (value[0] == '=' || value[0] == ':') {
+		value = strings.TrimLeft(value[1:], " \t\f")
+	}
+	return propertiesUnescape(line[:end]), propertiesUnescape(value)
+}
+
+// propertiesUnescape decodes the escapes of a ".properties" file ("\n", "\t", "\u00e9", "\=").
+func propertiesUnescape(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+4 < len(s) {
+				if code, err := strconv.ParseUint(s[i+1:i+5], 16, 32); err == nil {
+					b.WriteRune(rune(code))
+					i += 4
+					continue
+				}
+			}
+			b.WriteByte('u')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,144 @@
+package input_test
+
+import (
+	"bytes"
+	"goreadability/input"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractCatalog(t *testing.T) {
+	for name, file := range map[string]string{
+		"catalog_po":         "messages.po",
+		"catalog_xliff":      "messages.xlf",
+		"catalog_xliff2":     "messages2.xliff",
+		"catalog_strings":    "en.strings",
+		"catalog_properties": "messages.properties",
+	} {
+		catalog, err := input.ExtractCatalogFile("testdata/locale/" + file)
+		if err != nil {
+			t.Fatalf("ExtractCatalogFile(%q) returned error: %v", file, err)
+		}
+		checkGolden(t, name, catalog)
+	}
+}
+
+func TestPairCatalogs(t *testing.T) {
+	source, err := input.ExtractCatalogFile("testdata/locale/en.strings")
+	if err != nil {
+		t.Fatalf("ExtractCatalogFile() returned error: %v", err)
+	}
+	translation, err := input.ExtractCatalogFile("testdata/locale/de.strings")
+	if err != nil {
+		t.Fatalf("ExtractCatalogFile() returned error: %v", err)
+	}
+	checkGolden(t, "catalog_paired", input.PairCatalogs(source, translation))
+}
+
+func TestExtractCatalogFormats(t *testing.T) {
+	tests := []struct {
+		name    string
+		extract func(io.Reader) (input.Catalog, error)
+		data    string
+		want    input.Catalog
+	}{
+		{
+			"po", input.ExtractPO,
+			"msgid \"\"\nmsgstr \"\"\n\"Language: fr\\n\"\n\n#, fuzzy\nmsgctxt \"menu\"\nmsgid \"Open\"\nmsgstr \"Ouvrir\"\n\n" +
+				"msgid \"Say \\\"hi\\\"\"\nmsgstr \"\"\n\"Dis \"\n\"bonjour\"\n\n#~ msgid \"Old\"\n#~ msgstr \"Vieux\"\n",
+			input.Catalog{TargetLanguage: "fr", Messages: []input.Message{
+				{Key: "Open", Context: "menu", Source: "Open", Translation: "Ouvrir", Fuzzy: true},
+				{Key: `Say "hi"`, Source: `Say "hi"`, Translation: "Dis bonjour"},
+			}},
+		},
+		{
+			"xliff 1.2", input.ExtractXLIFF,
+			`<xliff version="1.2"><file source-language="en" target-language="de"><body>` +
+				`<trans-unit id="1" resname="greeting"><source>Hello <x id="1"/> world.</source><target state="needs-review-translation">Hallo Welt.</target></trans-unit>` +
+				`<trans-unit id="2"><source>Bye.</source><note>A note.</note></trans-unit></body></file></xliff>`,
+			input.Catalog{SourceLanguage: "en", TargetLanguage: "de", Messages: []input.Message{
+				{Key: "greeting", Source: "Hello {1} world.", Translation: "Hallo Welt.", Fuzzy: true},
+				{Key: "2", Source: "Bye."},
+			}},
+		},
+		{
+			"xliff 2", input.ExtractXLIFF,
+			`<xliff version="2.0" srcLang="en" trgLang="es"><file id="f"><unit id="u1" name="title">` +
+				`<segment><source>One.</source><target>Uno.</target></segment><segment><source>Two.</source><target>Dos.</target></segment></unit></file></xliff>`,
+			input.Catalog{SourceLanguage: "en", TargetLanguage: "es", Messages: []input.Message{
+				{Key: "title", Source: "One. Two.", Translation: "Uno. Dos."},
+			}},
+		},
+		{
+			"strings", input.ExtractStrings,
+			"/* A comment. */\n\"title\" = \"Hello \\\"world\\\"\";\n// Another one.\n\"bye\" = \"Bye.\\nSee you.\";\n",
+			input.Catalog{Messages: []input.Message{
+				{Key: "title", Source: `Hello "world"`},
+				{Key: "bye", Source: "Bye.\nSee you."},
+			}},
+		},
+		{
+			"properties", input.ExtractProperties,
+			"# A comment.\n! Another one.\ntitle=Hello\ngreeting: Caf\\u00e9 \\\n    open.\nbye Bye.\n",
+			input.Catalog{Messages: []input.Message{
+				{Key: "title", Source: "Hello"},
+				{Key: "greeting", Source: "Café open."},
+				{Key: "bye", Source: "Bye."},
+			}},
+		},
+	}
+	for _, test := range tests {
+		got, err := test.extract(strings.NewReader(test.data))
+		if err != nil {
+			t.Errorf("Extract() %s returned error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Extract() %s = %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestPairCatalogsMessages(t *testing.T) {
+	source := input.Catalog{SourceLanguage: "en", Messages: []input.Message{{Key: "a", Source: "Hello."}, {Key: "b", Source: "Bye."}}}
+	translation := input.Catalog{SourceLanguage: "de", Messages: []input.Message{{Key: "b", Source: "Tschüss."}, {Key: "c", Source: "Extra."}}}
+	want := input.Catalog{SourceLanguage: "en", TargetLanguage: "de", Messages: []input.Message{{Key: "a", Source: "Hello."}, {Key: "b", Source: "Bye.", Translation: "Tschüss."}}}
+	if got := input.PairCatalogs(source, translation); !reflect.DeepEqual(got, want) {
+		t.Errorf("PairCatalogs() = %+v, want %+v", got, want)
+	}
+}
+
+// fuzzCatalog fuzzes the extraction of a localization file format with the seed files.
+func fuzzCatalog(f *testing.F, extract func(io.Reader) (input.Catalog, error), files ...string) {
+	for _, file := range files {
+		f.Add(readTestdata(f, "locale/"+file))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		catalog, err := extract(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+		for _, message := range catalog.Messages {
+			checkText(t, data, message.Source)
+			checkText(t, data, message.Translation)
+		}
+	})
+}
+
+func FuzzExtractPO(f *testing.F) {
+	fuzzCatalog(f, input.ExtractPO, "messages.po")
+}
+
+func FuzzExtractXLIFF(f *testing.F) {
+	fuzzCatalog(f, input.ExtractXLIFF, "messages.xlf", "messages2.xliff")
+}
+
+func FuzzExtractStrings(f *testing.F) {
+	fuzzCatalog(f, input.ExtractStrings, "en.strings", "de.strings")
+}
+
+func FuzzExtractProperties(f *testing.F) {
+	fuzzCatalog(f, input.ExtractProperties, "messages.properties")
+}
@@ -0,0 +1,427 @@
+package input
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Functions ======
+
+// FromMarkdown accepts a Markdown document and the options for its headings, list items, and table cells and returns its text. See `ExtractMarkdown`.
+func FromMarkdown(s string, bo BlockOptions) string {
+	return ExtractMarkdown(s, bo).Text
+}
+
+// ExtractMarkdown accepts a Markdown document and the options for its headings, list items, and table cells and returns its Content.
+// The front matter (YAML between "---" lines, TOML between "+++" lines), code blocks (fenced and indented), inline code, images, HTML tags and comments,
+// link reference definitions, and footnote references are removed. Links are replaced with their texts, so their URLs are removed.
+// The markers of headings, lists, block quotes, emphasis, and tables are removed too, and the table cells are separated as blocks.
+// Paragraphs, headings, list items, and table cells are separated by blank lines, and the lines of a paragraph are joined.
+func ExtractMarkdown(s string, bo BlockOptions) Content {
+	c := lineConverter{w: blockWriter{bo: bo}, inline: markdownInline}
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	lines = skipFrontMatter(lines)
+
+	var fence string
+	inComment, inCode, prevBlank, inList := false, false, true, false
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if fence != "" {
+			if closesFence(line, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if inComment {
+			if end := strings.Index(line, "-->"); end >= 0 {
+				inComment = false
+				line = line[end+3:]
+			} else {
+				continue
+			}
+		}
+
+		indent, content := markdownIndent(line)
+		if content == "" {
+			c.flush()
+			prevBlank = true
+			continue
+		}
+		blank := prevBlank
+		prevBlank = false
+		inCode = inCode && indent >= 4
+
+		switch {
+		case indent < 4 && (strings.HasPrefix(content, "```") || strings.HasPrefix(content, "~~~")):
+			c.flush()
+			fence = content[:len(content)-len(strings.TrimLeft(content, content[:1]))]
+			continue
+		case indent >= 4 && c.kind == "" && (blank || inCode) && !inList:
+			// An indented code block.
+			inCode = true
+			continue
+		case strings.HasPrefix(content, "<!--") && !strings.Contains(content, "-->"):
+			c.flush()
+			inComment = true
+			continue
+		}
+
+		content = trimBlockQuote(content)
+		if content == "" {
+			c.flush()
+			continue
+		}
+		if c.kind == ParagraphBlock && isSetextUnderline(content) {
+			// The paragraph is a heading: "Title\n=====".
+			c.w.kind = HeadingBlock
+			c.flush()
+			continue
+		}
+		if isThematicBreak(content) {
+			c.flush()
+			inList = false
+			continue
+		}
+		if level := atxHeadingLevel(content); level > 0 {
+			heading := strings.TrimSpace(content[level:])
+			heading = strings.TrimSpace(strings.TrimRight(heading, "#"))
+			c.start(HeadingBlock, heading)
+			c.flush()
+			inList = false
+			continue
+		}
+		if item, ok := listItem(content); ok {
+			c.start(ListItemBlock, item)
+			inList = true
+			continue
+		}
+		if isLinkDefinition(content) {
+			continue
+		}
+		if strings.Contains(content, "|") && i+1 < len(lines) && isTableDelimiter(lines[i+1]) {
+			c.flush()
+			for _, cell := range tableCells(content) {
+				c.start(TableCellBlock, cell)
+			}
+			for i += 2; i < len(lines) && strings.Contains(lines[i], "|") && strings.TrimSpace(lines[i]) != ""; i++ {
+				for _, cell := range tableCells(lines[i]) {
+					c.start(TableCellBlock, cell)
+				}
+			}
+			c.flush()
+			i--
+			inList = false
+			continue
+		}
+
+		if c.kind == "" || c.kind == TableCellBlock || (c.kind == ListItemBlock && blank) {
+			if blank && indent < 2 {
+				inList = false
+			}
+			c.start(ParagraphBlock, content)
+			continue
+		}
+		// A continuation of the paragraph or the list item.
+		c.continueBlock(content)
+	}
+	return c.w.content()
+}
+
+// skipFrontMatter returns the lines after the front matter, or all lines if the document has no front matter.
+func skipFrontMatter(lines []string) []string {
+	if len(lines) == 0 {
+		return lines
+	}
+	delimiter := strings.TrimSpace(lines[0])
+	if delimiter != "---" && delimiter != "+++" {
+		return lines
+	}
+	for i := 1; i < len(lines); i++ {
+		end := strings.TrimSpace(lines[i])
+		if end == delimiter || (delimiter == "---" && end == "...") {
+			return lines[i+1:]
+		}
+	}
+	return lines
+}
+
+// closesFence reports whether the line closes the code block of the fence: it has the fence characters only, at least as many as the fence.
+func closesFence(line, fence string) bool {
+	indent, content := markdownIndent(line)
+	content = strings.TrimRightFunc(content, unicode.IsSpace)
+	return indent < 4 && len(content) >= len(fence) && strings.Trim(content, fence[:1]) == ""
+}
+
+// markdownIndent returns the indentation of the line, with tabs counting as four spaces, and the line without the indentation and the trailing whitespaces.
+func markdownIndent(line string) (int, string) {
+	indent := 0
+	for i, char := range line {
+		switch char {
+		case ' ':
+			indent++
+		case '\t':
+			indent += 4 - indent%4
+		default:
+			return indent, strings.TrimRightFunc(line[i:], unicode.IsSpace)
+		}
+	}
+	return indent, ""
+}
+
+// trimBlockQuote returns the line without the block quote markers (">").
+func trimBlockQuote(line string) string {
+	for strings.HasPrefix(line, ">") {
+		line = strings.TrimLeft(line[1:], " \t")
+	}
+	return line
+}
+
+// isSetextUnderline reports whether the line underlines a setext heading ("===", "---").
+func isSetextUnderline(line string) bool {
+	return len(line) > 0 && (strings.Trim(line, "=") == "" || strings.Trim(line, "-") == "")
+}
+
+// isThematicBreak reports whether the line is a thematic break: three or more "-", "*", or "_", optionally separated by spaces.
+func isThematicBreak(line string) bool {
+	compact := strings.ReplaceAll(line, " ", "")
+	return len(compact) >= 3 && strings.Trim(compact, compact[:1]) == "" && strings.Contains("-*_", compact[:1])
+}
+
+// atxHeadingLevel returns the number of "#" that start the ATX heading ("## Title"), or 0 if the line is not a heading.
+func atxHeadingLevel(line string) int {
+	level := len(line) - len(strings.TrimLeft(line, "#"))
+	if level == 0 || level > 6 || (level < len(line) && line[level] != ' ' && line[level] != '\t') {
+		return 0
+	}
+	return level
+}
+
+// listItem returns the text of the list item without its marker ("- ", "* ", "+ ", "1. ", "1) ") and task box ("[ ] ", "[x] "), and false if the line is not a list item.
+func listItem(line string) (string, bool) {
+	marker := 0
+	switch {
+	case len(line) > 0 && strings.ContainsRune("-*+", rune(line[0])):
+		marker = 1
+	default:
+		for marker < len(line) && marker < 9 && line[marker] >= '0' && line[marker] <= '9' {
+			marker++
+		}
+		if marker == 0 || marker == len(line) || (line[marker] != '.' && line[marker] != ')') {
+			return "", false
+		}
+		marker++
+	}
+	if marker < len(line) && line[marker] != ' ' && line[marker] != '\t' {
+		return "", false
+	}
+	item := strings.TrimSpace(line[marker:])
+	for _, box := range []string{"[ ]", "[x]", "[X]"} {
+		if strings.HasPrefix(item, box) {
+			item = strings.TrimSpace(item[len(box):])
+		}
+	}
+	return item, true
+}
+
+// isLinkDefinition reports whether the line is a link reference definition ("[id]: https://example.com").
+func isLinkDefinition(line string) bool {
+	if !strings.HasPrefix(line, "[") {
+		return false
+	}
+	end := strings.Index(line, "]:")
+	return end > 1 && !strings.ContainsRune(line[1:end], ']')
+}
+
+// isTableDelimiter reports whether the line is the delimiter row of a table ("|---|:---:|").
+func isTableDelimiter(line string) bool {
+	line = strings.TrimSpace(line)
+	return strings.Contains(line, "-") && strings.Trim(line, "|-: \t") == "" && (strings.Contains(line, "|") || strings.Contains(line, "-:"))
+}
+
+// tableCells returns the cells of the table row, without the escaped pipes ("\|") splitting them.
+func tableCells(row string) []string {
+	row = strings.TrimSpace(row)
+	row = strings.TrimPrefix(row, "|")
+	if !strings.HasSuffix(row, "\\|") {
+		row = strings.TrimSuffix(row, "|")
+	}
+	var cells []string
+	start := 0
+	for i := 0; i < len(row); i++ {
+		switch row[i] {
+		case '\\':
+			i++
+		case '|':
+			cells = append(cells, strings.TrimSpace(row[start:i]))
+			start = i + 1
+		}
+	}
+	return append(cells, strings.TrimSpace(row[start:]))
+}
+
+// markdownInline returns the text of the line with the inline markup removed: inline code, images, HTML tags, autolinks, footnote references, and emphasis.
+// Links are replaced with their texts.
+func markdownInline(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && isASCIIPunctuation(s[i+1]):
+			b.WriteByte(s[i+1])
+			i += 2
+			continue
+		case c == '`':
+			run := len(s[i:]) - len(strings.TrimLeft(s[i:], "`"))
+			if end := strings.Index(s[i+run:], s[i:i+run]); end >= 0 {
+				i += run + end + run
+				trimRemovedSpace(&b, s[i:])
+				continue
+			}
+			b.WriteString(s[i : i+run])
+			i += run
+			continue
+		case c == '!' && strings.HasPrefix(s[i+1:], "["):
+			if _, end, ok := markdownLink(s, i+1); ok {
+				i = end
+				trimRemovedSpace(&b, s[i:])
+				continue
+			}
+		case c == '[':
+			if text, end, ok := markdownLink(s, i); ok {
+				b.WriteString(markdownInline(text))
+				i = end
+				continue
+			}
+			if strings.HasPrefix(s[i:], "[^") {
+				if end := strings.IndexByte(s[i:], ']'); end > 0 {
+					i += end + 1
+					continue
+				}
+			}
+		case c == '<':
+			if strings.HasPrefix(s[i:], "<!--") {
+				i = skipPast(s, i+4, "-->")
+				continue
+			}
+			if end := strings.IndexByte(s[i:], '>'); end > 0 && isAutolink(s[i+1:i+end]) {
+				i += end + 1
+				continue
+			}
+			if name, _, _, end := parseTag(s, i); name != "" {
+				i = end
+				continue
+			}
+		case c == '*' || c == '_' || c == '~':
+			run := len(s[i:]) - len(strings.TrimLeft(s[i:], string(c)))
+			if isEmphasisRun(s, i, run, c) {
+				i += run
+				continue
+			}
+			b.WriteString(s[i : i+run])
+			i += run
+			continue
+		}
+		b.WriteByte(c)
+		i++
+	}
+	return b.String()
+}
+
+// markdownLink parses the link that starts with "[" at the index ("[text](url)", "[text][id]") and returns its text, the index after the link, and true.
+// Returns false if there is no link at the index.
+func markdownLink(s string, i int) (string, int, bool) {
+	depth := 0
+	for j := i; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth > 0 {
+				continue
+			}
+			text := s[i+1 : j]
+			rest := s[j+1:]
+			if strings.HasPrefix(text, "^") {
+				return "", 0, false
+			}
+			if strings.HasPrefix(rest, "(") {
+				if end := matchingParen(rest); end > 0 {
+					return text, j + 1 + end + 1, true
+				}
+			}
+			if strings.HasPrefix(rest, "[") {
+				if end := strings.IndexByte(rest, ']'); end > 0 {
+					return text, j + 1 + end + 1, true
+				}
+			}
+			return "", 0, false
+		}
+	}
+	return "", 0, false
+}
+
+// matchingParen returns the index of the ")" that closes the "(" at the start of the string, or -1 if there is none.
+func matchingParen(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// isAutolink reports whether the content of the angle brackets is an autolink: a URL ("https://example.com") or an email address.
+func isAutolink(s string) bool {
+	if strings.ContainsAny(s, " \t<") {
+		return false
+	}
+	scheme, _, ok := strings.Cut(s, ":")
+	return (ok && len(scheme) >= 2 && strings.IndexFunc(scheme, func(c rune) bool { return !unicode.IsLetter(c) && c != '+' && c != '.' && c != '-' }) < 0) ||
+		strings.Contains(s, "@")
+}
+
+// isEmphasisRun reports whether the run of "*", "_", or "~" at the index is an emphasis delimiter rather than a literal character:
+// it is next to a word ("*word*", "word**"), "_" is not inside a word ("snake_case"), and "~" is doubled ("~~struck~~").
+// As in CommonMark, a run between a word and a punctuation mark flanks the word only ("_emphasis_.", "(*word*)").
+func isEmphasisRun(s string, i, run int, c byte) bool {
+	before, after := ' ', ' '
+	if i > 0 {
+		before, _ = utf8.DecodeLastRuneInString(s[:i])
+	}
+	if i+run < len(s) {
+		after, _ = utf8.DecodeRuneInString(s[i+run:])
+	}
+	isPunct := func(char rune) bool { return unicode.IsPunct(char) || unicode.IsSymbol(char) }
+	leftFlanking := !unicode.IsSpace(after) && (!isPunct(after) || unicode.IsSpace(before) || isPunct(before))
+	rightFlanking := !unicode.IsSpace(before) && (!isPunct(before) || unicode.IsSpace(after) || isPunct(after))
+	switch c {
+	case '_':
+		return leftFlanking && (!rightFlanking || isPunct(before)) || rightFlanking && (!leftFlanking || isPunct(after))
+	case '~':
+		return run >= 2 && (leftFlanking || rightFlanking)
+	}
+	return leftFlanking || rightFlanking
+}
+
+// isASCIIPunctuation reports whether the byte is an ASCII punctuation character, which can be escaped in Markdown.
+func isASCIIPunctuation(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || strings.IndexByte("$+<=>^`|~", c) >= 0
+}
+
+// isLetterOrDigit reports whether the rune is a letter or a digit.
+func isLetterOrDigit(char rune) bool {
+	return unicode.IsLetter(char) || unicode.IsDigit(char)
+}
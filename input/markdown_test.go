@@ -0,0 +1,65 @@
+package input_test
+
+import (
+	"goreadability/input"
+	"testing"
+)
+
+func TestExtractMarkdown(t *testing.T) {
+	doc := string(readTestdata(t, "doc.md"))
+	checkGolden(t, "doc_md", input.ExtractMarkdown(doc, input.BlockOptions{}))
+}
+
+func TestFromMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		bo   input.BlockOptions
+		want string
+	}{
+		{"paragraph lines", "One two\nthree.\n\nFour.", input.BlockOptions{}, "One two three.\n\nFour."},
+		{"emphasis and code", "A **bold**, _italic_ and `code` word.", input.BlockOptions{}, "A bold, italic and word."},
+		{"links and images", "See [the docs](https://example.com) and ![logo](logo.png) here.", input.BlockOptions{}, "See the docs and here."},
+		{"reference links", "See [the docs][1].\n\n[1]: https://example.com", input.BlockOptions{}, "See the docs."},
+		{"front matter", "---\ntitle: Doc\n---\nText.", input.BlockOptions{}, "Text."},
+		{"fenced code", "Text.\n\n```go\nfmt.Println()\n```\n\nMore.", input.BlockOptions{}, "Text.\n\nMore."},
+		{"block quote", "> Quoted\n> text.", input.BlockOptions{}, "Quoted text."},
+		{"headings", "# Title\n\nText.\n\nSub\n---\n\nMore.", input.BlockOptions{Headings: input.SentenceBlocks}, "Title.\n\nText.\n\nSub.\n\nMore."},
+		{"list items", "- One\n- Two\n1. Three", input.BlockOptions{ListItems: input.SentenceBlocks}, "One.\n\nTwo.\n\nThree."},
+		{"excluded list items", "Text.\n\n* One\n* Two", input.BlockOptions{ListItems: input.ExcludeBlocks}, "Text."},
+		{"table", "| A | B |\n|---|---|\n| c | d |", input.BlockOptions{}, "A\n\nB\n\nc\n\nd"},
+		{"code before a point", "Call `f()`, then `g()`.", input.BlockOptions{}, "Call, then."},
+		{"html comment", "Text <!-- hidden --> here.", input.BlockOptions{}, "Text here."},
+	}
+	for _, test := range tests {
+		if got := input.FromMarkdown(test.s, test.bo); got != test.want {
+			t.Errorf("FromMarkdown() %s = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func FuzzExtractMarkdown(f *testing.F) {
+	f.Add(string(readTestdata(f, "doc.md")))
+	f.Add("```\nunclosed fence")
+	f.Add("---\nunclosed: front matter")
+	f.Add("[a](b [c](d) ![e](f) <!-- g")
+	f.Add("| a |\n|---|\n| b | c | d |")
+	f.Fuzz(func(t *testing.T, s string) {
+		content := input.ExtractMarkdown(s, input.BlockOptions{ListItems: input.SeparateBlocks, TableCells: input.SentenceBlocks})
+		checkText(t, []byte(s), content.Text)
+	})
+}
+
+func TestBlockPolicies(t *testing.T) {
+	doc := string(readTestdata(t, "doc.md"))
+	// KeepBlocks is the zero value, tested by TestExtractMarkdown.
+	policies := map[string]input.BlockPolicy{
+		"sentence": input.SentenceBlocks,
+		"exclude":  input.ExcludeBlocks,
+		"separate": input.SeparateBlocks,
+	}
+	for name, policy := range policies {
+		bo := input.BlockOptions{Headings: policy, ListItems: policy, TableCells: policy}
+		checkGolden(t, "doc_md_"+name, input.ExtractMarkdown(doc, bo))
+	}
+}
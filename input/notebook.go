@@ -0,0 +1,112 @@
+package input
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// ====== Types ======
+
+// Cell represents a Markdown cell of a Jupyter notebook: its 0-based index among all the cells of the notebook and its extracted content.
+type Cell struct {
+	Index int `json:"index"`
+	Content
+}
+
+// notebookCell represents a cell of a Jupyter notebook. The source is a string or an array of lines.
+type notebookCell struct {
+	CellType string          `json:"cell_type"`
+	Source   json.RawMessage `json:"source"`
+}
+
+// notebook represents a Jupyter notebook: the cells of nbformat 4, or the cells of the worksheets of nbformat 3.
+type notebook struct {
+	Cells      []notebookCell `json:"cells"`
+	Worksheets []struct {
+		Cells []notebookCell `json:"cells"`
+	} `json:"worksheets"`
+}
+
+// ====== Functions ======
+
+// ExtractNotebookFile accepts a path to a Jupyter notebook (".ipynb") and the options for its headings, list items, and table cells and returns its Markdown cells.
+// See `ExtractNotebook`.
+func ExtractNotebookFile(path string, bo BlockOptions) ([]Cell, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ExtractNotebook(file, bo)
+}
+
+// ExtractNotebook accepts a Jupyter notebook (nbformat 3 or 4) and the options for its headings, list items, and table cells and returns its Markdown cells
+// in the order of the notebook, extracted as in `ExtractMarkdown`. The code and raw cells and the outputs are skipped, and so are the Markdown cells without text.
+// The LaTeX math of the cells ("$x^2$", "$$\sum_i x_i$$") is dropped, while a dollar sign before a number ("$5") is kept.
+func ExtractNotebook(r io.Reader, bo BlockOptions) ([]Cell, error) {
+	var nb notebook
+	if err := json.NewDecoder(r).Decode(&nb); err != nil {
+		return nil, err
+	}
+	cells := nb.Cells
+	for _, worksheet := range nb.Worksheets {
+		cells = append(cells, worksheet.Cells...)
+	}
+	if cells == nil {
+		return nil, errors.New("No cells in the notebook. Cannot read the notebook.")
+	}
+
+	var markdownCells []Cell
+	for i, cell := range cells {
+		if cell.CellType != "markdown" {
+			continue
+		}
+		var source string
+		if err := json.Unmarshal(cell.Source, &source); err != nil {
+			var lines []string
+			if err := json.Unmarshal(cell.Source, &lines); err != nil {
+				return nil, err
+			}
+			// The lines of a cell keep their line breaks.
+			source = strings.Join(lines, "")
+		}
+		content := ExtractMarkdown(dropNotebookMath(source), bo)
+		if strings.TrimSpace(content.Text) == "" && len(content.Separated) == 0 {
+			continue
+		}
+		markdownCells = append(markdownCells, Cell{i, content})
+	}
+	return markdownCells, nil
+}
+
+// dropNotebookMath returns the Markdown source of a notebook cell without its inline ("$...$") and display ("$$...$$") math.
+// As in Pandoc, the inline math doesn't start with a space or a digit and doesn't end with a space, so the prices ("$5 or $10") are not math.
+func dropNotebookMath(s string) string {
+	if !strings.Contains(s, "$") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || (i > 0 && s[i-1] == '\\') {
+			b.WriteByte(s[i])
+			continue
+		}
+		if strings.HasPrefix(s[i:], "$$") {
+			if end := strings.Index(s[i+2:], "$$"); end >= 0 {
+				i += 2 + end + 1
+				continue
+			}
+		}
+		next := byteAt(s, i+1)
+		end := strings.IndexAny(s[i+1:], "$\n")
+		if next != ' ' && next != '\t' && (next < '0' || next > '9') && end > 0 && s[i+1+end] == '$' && s[i+end] != ' ' {
+			i += end + 1
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
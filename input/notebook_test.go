@@ -0,0 +1,92 @@
+package input_test
+
+import (
+	"bytes"
+	"goreadability/input"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractNotebook(t *testing.T) {
+	for name, bo := range map[string]input.BlockOptions{
+		"notebook":           {},
+		"notebook_separated": {Headings: input.SeparateBlocks, ListItems: input.SeparateBlocks},
+	} {
+		cells, err := input.ExtractNotebookFile("testdata/notebook.ipynb", bo)
+		if err != nil {
+			t.Fatalf("ExtractNotebookFile() returned error: %v", err)
+		}
+		checkGolden(t, name, cells)
+	}
+
+	v3 := `{"worksheets": [{"cells": [{"cell_type": "code", "input": "x"}, {"cell_type": "markdown", "source": ["Old ", "format."]}]}], "nbformat": 3}`
+	cells, err := input.ExtractNotebook(strings.NewReader(v3), input.BlockOptions{})
+	if err != nil || len(cells) != 1 || cells[0].Index != 1 || cells[0].Text != "Old format." {
+		t.Errorf("ExtractNotebook(%q) = %+v, %v, want the cell 1 with the text \"Old format.\"", v3, cells, err)
+	}
+	if _, err := input.ExtractNotebook(strings.NewReader(`{"metadata": {}}`), input.BlockOptions{}); err == nil {
+		t.Errorf("ExtractNotebook() of a notebook without cells returned no error")
+	}
+}
+
+func FuzzExtractNotebook(f *testing.F) {
+	f.Add(readTestdata(f, "notebook.ipynb"))
+	f.Add([]byte(`{"cells": [{"cell_type": "markdown", "source": "$a $$b\\$ c$ $$"}]}`))
+	f.Fuzz(func(t *testing.T, notebook []byte) {
+		cells, err := input.ExtractNotebook(bytes.NewReader(notebook), input.BlockOptions{})
+		if err != nil {
+			return
+		}
+		for _, cell := range cells {
+			checkText(t, notebook, cell.Text)
+		}
+	})
+}
+
+func TestExtractNotebookCells(t *testing.T) {
+	tests := []struct {
+		name  string
+		cells string
+		want  []string
+	}{
+		{"string source", `{"cell_type": "markdown", "source": "# Title\n\nSome *text*."}`, []string{"Title\n\nSome text."}},
+		{"lines source", `{"cell_type": "markdown", "source": ["First line\n", "second line."]}`, []string{"First line second line."}},
+		{"inline math", `{"cell_type": "markdown", "source": "The mean $\\mu$ of $x_i$ is small."}`, []string{"The mean of is small."}},
+		{"display math", `{"cell_type": "markdown", "source": "We sum $$\\sum_i x_i$$ the values."}`, []string{"We sum the values."}},
+		{"prices", `{"cell_type": "markdown", "source": "It costs $5 or $10."}`, []string{"It costs $5 or $10."}},
+		{"escaped dollar", `{"cell_type": "markdown", "source": "A \\$ sign and $ alone."}`, []string{"A $ sign and $ alone."}},
+		{
+			"code, raw, and empty cells",
+			`{"cell_type": "code", "source": "x = 1", "outputs": [{"text": "1"}]}, {"cell_type": "raw", "source": "Raw."}, {"cell_type": "markdown", "source": "$x$"}, {"cell_type": "markdown", "source": "Last."}`,
+			[]string{"Last."},
+		},
+	}
+	for _, test := range tests {
+		cells, err := input.ExtractNotebook(strings.NewReader(`{"cells": [`+test.cells+`]}`), input.BlockOptions{})
+		if err != nil {
+			t.Errorf("ExtractNotebook() %s returned error: %v", test.name, err)
+			continue
+		}
+		var got []string
+		for _, cell := range cells {
+			got = append(got, cell.Text)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ExtractNotebook() %s = %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	// The indices count all the cells of the notebook.
+	nb := `{"cells": [{"cell_type": "code", "source": "x"}, {"cell_type": "markdown", "source": "One."}, {"cell_type": "code", "source": "y"}, {"cell_type": "markdown", "source": "Two."}]}`
+	cells, err := input.ExtractNotebook(strings.NewReader(nb), input.BlockOptions{})
+	if err != nil || len(cells) != 2 || cells[0].Index != 1 || cells[1].Index != 3 {
+		t.Errorf("ExtractNotebook(%q) = %+v, %v, want the cells 1 and 3", nb, cells, err)
+	}
+
+	for _, nb := range []string{"", "[]", `{"cells": [{"cell_type": "markdown", "source": 3}]}`} {
+		if _, err := input.ExtractNotebook(strings.NewReader(nb), input.BlockOptions{}); err == nil {
+			t.Errorf("ExtractNotebook(%q) returned no error", nb)
+		}
+	}
+}
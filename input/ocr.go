@@ -0,0 +1,188 @@
+package input
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// ocrReplacer normalizes the quotes and the ligatures of OCR-derived text: the typographic and the TeX-style quotes become straight ones.
+var ocrReplacer = strings.NewReplacer(
+	"``", "\"", "''", "\"", "“", "\"", "”", "\"", "„", "\"", "‟", "\"", "″", "\"",
+	"‘", "'", "’", "'", "‚", "'", "‛", "'", "′", "'",
+	"ﬀ", "ff", "ﬁ", "fi", "ﬂ", "fl", "ﬃ", "ffi", "ﬄ", "ffl",
+)
+
+// ====== Functions ======
+
+// FromOCR accepts a text recognized from scanned pages (OCR) and returns it cleaned up, so it can be scored as prose:
+//   - the page numbers ("12", "- 12 -", "Page 12 of 30", "xiv") are dropped, and they and the form feeds separate the pages;
+//   - the running headers and footers, the first or last lines of the pages that repeat on three or more pages (ignoring the numbers in them), are dropped;
+//   - the stray lines of a single character (a speck recognized as "." or "|") are dropped;
+//   - the lines of a paragraph are joined, and the words hyphenated at the line breaks ("inter-" and "national") are rejoined,
+//     if the next line starts with a lower case letter ("anti-" and "American" keep the hyphen);
+//   - the quotes are made straight, including the TeX-style ones of two backticks or apostrophes, and the ligatures ("ﬁ") are expanded;
+//   - the digits confused with letters are corrected: "0" and "1" between two lower case letters ("c0de", "wor1d") are "o" and "l", and a "|" standing alone is "I".
+//
+// The paragraphs are separated by blank lines, and a paragraph broken by a page is continued on the next page.
+func FromOCR(s string) string {
+	s = strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\r", "\n")
+	// A soft hyphen at a line break is a hyphenation, and the others are invisible.
+	s = strings.ReplaceAll(strings.ReplaceAll(s, "\u00ad\n", "-\n"), "\u00ad", "")
+	pages := ocrPages(s)
+	headers := ocrRunningHeaders(pages)
+
+	var paragraphs []string
+	var paragraph strings.Builder
+	flush := func() {
+		if paragraph.Len() > 0 {
+			paragraphs = append(paragraphs, paragraph.String())
+			paragraph.Reset()
+		}
+	}
+	for _, page := range pages {
+		for _, line := range page {
+			trimmed := strings.TrimSpace(line)
+			switch {
+			case trimmed == "":
+				flush()
+				continue
+			case headers[ocrLineKey(trimmed)], utf8.RuneCountInString(trimmed) == 1:
+				continue
+			}
+
+			text := paragraph.String()
+			first, _ := utf8.DecodeRuneInString(trimmed)
+			if beforeHyphen, ok := strings.CutSuffix(text, "-"); ok && unicode.IsLetter(first) {
+				if last, _ := utf8.DecodeLastRuneInString(beforeHyphen); unicode.IsLetter(last) {
+					// A compound with a capitalized part ("anti-" and "American") keeps its hyphen.
+					if unicode.IsLower(first) {
+						paragraph.Reset()
+						paragraph.WriteString(beforeHyphen)
+					}
+					paragraph.WriteString(strings.Join(strings.Fields(trimmed), " "))
+					continue
+				}
+			}
+			if paragraph.Len() > 0 {
+				paragraph.WriteByte(' ')
+			}
+			paragraph.WriteString(strings.Join(strings.Fields(trimmed), " "))
+		}
+	}
+	flush()
+	return ocrReplacer.Replace(fixConfusables(strings.Join(paragraphs, "\n\n")))
+}
+
+// fixConfusables returns the text with the digits recognized instead of letters corrected (see `FromOCR`).
+func fixConfusables(s string) string {
+	runes := []rune(s)
+	for i, c := range runes {
+		prev, next := ' ', ' '
+		if i > 0 {
+			prev = runes[i-1]
+		}
+		if i < len(runes)-1 {
+			next = runes[i+1]
+		}
+		switch {
+		case c == '0' && unicode.IsLower(prev) && unicode.IsLower(next):
+			runes[i] = 'o'
+		case c == '1' && unicode.IsLower(prev) && unicode.IsLower(next):
+			runes[i] = 'l'
+		case c == '|' && unicode.IsSpace(prev) && unicode.IsSpace(next):
+			runes[i] = 'I'
+		}
+	}
+	return string(runes)
+}
+
+// ocrPages splits the text into pages of lines at the form feeds and at the page numbers, which are dropped.
+func ocrPages(s string) [][]string {
+	var pages [][]string
+	for _, page := range strings.Split(s, "\f") {
+		var lines []string
+		for _, line := range strings.Split(page, "\n") {
+			if isPageNumber(strings.TrimSpace(line)) {
+				pages = append(pages, lines)
+				lines = nil
+				continue
+			}
+			lines = append(lines, line)
+		}
+		pages = append(pages, lines)
+	}
+	return pages
+}
+
+// ocrRunningHeaders returns the keys (see `ocrLineKey`) of the running headers and footers of the pages: the first and the last lines of the pages
+// that repeat on three or more pages.
+func ocrRunningHeaders(pages [][]string) map[string]bool {
+	counts := map[string]int{}
+	for _, page := range pages {
+		var first, last string
+		for _, line := range page {
+			if trimmed := strings.TrimSpace(line); trimmed != "" {
+				if first == "" {
+					first = trimmed
+				}
+				last = trimmed
+			}
+		}
+		if first == "" {
+			continue
+		}
+		counts[ocrLineKey(first)]++
+		if ocrLineKey(last) != ocrLineKey(first) {
+			counts[ocrLineKey(last)]++
+		}
+	}
+	headers := map[string]bool{}
+	for key, count := range counts {
+		if count >= 3 && len(strings.Fields(key)) <= 12 {
+			headers[key] = true
+		}
+	}
+	return headers
+}
+
+// ocrLineKey returns the line as it is compared to the running headers: in lower case, with the whitespaces collapsed and the digits replaced with "#",
+// so "Chapter 2 · 17" and "Chapter 2 · 18" match.
+func ocrLineKey(line string) string {
+	return strings.Map(func(c rune) rune {
+		if unicode.IsDigit(c) {
+			return '#'
+		}
+		return unicode.ToLower(c)
+	}, strings.Join(strings.Fields(line), " "))
+}
+
+// isPageNumber reports whether the trimmed line is a page number: Arabic ("12", "- 12 -", "[12]", "Page 12", "p. 12", "12 of 30") or lower case Roman ("xiv").
+func isPageNumber(line string) bool {
+	line = strings.Trim(line, "-–—|[]() ")
+	lower := strings.ToLower(line)
+	for _, prefix := range []string{"page ", "p. ", "pg. ", "pg "} {
+		if strings.HasPrefix(lower, prefix) {
+			line = strings.TrimSpace(line[len(prefix):])
+			break
+		}
+	}
+	if number, total, ok := strings.Cut(line, " of "); ok && isDigits(strings.TrimSpace(total)) {
+		line = strings.TrimSpace(number)
+	}
+	if line == "" {
+		return false
+	}
+	if isDigits(line) {
+		return len(line) <= 4
+	}
+	// The front matter is numbered with the common lower case Roman numerals ("ii", "xiv").
+	return len(line) <= 6 && strings.Trim(line, "ivx") == ""
+}
+
+// isDigits reports whether the string is a non-empty sequence of ASCII digits.
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
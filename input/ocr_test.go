@@ -0,0 +1,58 @@
+package input_test
+
+import (
+	"goreadability/input"
+	"testing"
+)
+
+func TestFromOCR(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"joined lines", "The quick brown\nfox   jumps over\nthe lazy dog.", "The quick brown fox jumps over the lazy dog."},
+		{"paragraphs", "First paragraph\nends here.\n\n\nSecond one.", "First paragraph ends here.\n\nSecond one."},
+		{"hyphenated word", "an inter-\nnational treaty", "an international treaty"},
+		{"soft hyphen", "an inter­\nnational treaty with­out", "an international treaty without"},
+		{"capitalized compound", "the anti-\nAmerican view", "the anti-American view"},
+		{"dash at a line break", "It was cold -\nvery cold.", "It was cold - very cold."},
+		{"number after a hyphen", "pages 10-\n12 of the book", "pages 10- 12 of the book"},
+		{"stray symbols", "The first line.\n|\n.\n'\nThe second line.", "The first line. The second line."},
+		{"page numbers", "The end of a\n- 12 -\npage and\nPage 13 of 30\nanother.", "The end of a page and another."},
+		{"roman page number", "Preface text\nxiv\ncontinues.", "Preface text continues."},
+		{"form feed", "Broken by a\fpage break.", "Broken by a page break."},
+		{"ligatures", "The ﬁrst ﬂoor was ﬀ and ﬃ and ﬄ.", "The first floor was ff and ffi and ffl."},
+		{"quotes", "“Hello,” she said. ``Yes,'' he said. ‘Fine’ it’s", `"Hello," she said. "Yes," he said. 'Fine' it's`},
+		{"confusables", "The c0de is in the wor1d. | think so.", "The code is in the world. I think so."},
+		{"digits kept", "In 1901 the 10 men and 1st A1 rank of a|b.", "In 1901 the 10 men and 1st A1 rank of a|b."},
+		{"windows line breaks", "One\r\ntwo\rthree.", "One two three."},
+		{"empty", "", ""},
+	}
+	for _, test := range tests {
+		if got := input.FromOCR(test.s); got != test.want {
+			t.Errorf("FromOCR() %s = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFromOCRRunningHeaders(t *testing.T) {
+	s := "THE TIME MACHINE\nThe Time Traveller was expounding\na recondite matter to us.\n1\n" +
+		"THE TIME MACHINE\nHis grey eyes shone and\ntwinkled.\n2\n" +
+		"THE TIME MACHINE\nThe fire burned brightly.\n3\f" +
+		"And the soft radiance.\nChapter 1 · 4\f" +
+		"Of the lights.\nChapter 1 · 5\f" +
+		"Caught the bubbles.\nChapter 1 · 6"
+	// The page numbers are dropped first, and the headers and footers match with the different numbers in them.
+	want := "The Time Traveller was expounding a recondite matter to us. His grey eyes shone and twinkled. The fire burned brightly. And the soft radiance. Of the lights. Caught the bubbles."
+	if got := input.FromOCR(s); got != want {
+		t.Errorf("FromOCR() = %q, want %q", got, want)
+	}
+
+	// A line that repeats on two pages only is not a running header.
+	s = "Intro line.\nText one.\n1\nIntro line.\nText two."
+	want = "Intro line. Text one. Intro line. Text two."
+	if got := input.FromOCR(s); got != want {
+		t.Errorf("FromOCR() = %q, want %q", got, want)
+	}
+}
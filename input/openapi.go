@@ -0,0 +1,88 @@
+package input
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ====== Types ======
+
+// openAPIValues are the keys of the values that are data, not documentation, so their "summary" and "description" keys are not walked into.
+var openAPIValues = toSet("example", "default", "enum", "const", "value")
+
+// openAPINames are the keys of the objects whose keys are names (of the properties of a schema), so a property named "example" is walked into.
+var openAPINames = toSet("properties", "patternProperties")
+
+// ====== Functions ======
+
+// ExtractOpenAPIFile accepts a path to an OpenAPI or Swagger document (JSON or YAML) and the options for its headings, list items, and table cells
+// and returns its documentation fields. See `ExtractOpenAPI`.
+func ExtractOpenAPIFile(path string, bo BlockOptions) ([]Field, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ExtractOpenAPI(file, bo)
+}
+
+// ExtractOpenAPI accepts an OpenAPI 3 or Swagger 2 document (JSON or YAML) and the options for its headings, list items, and table cells
+// and returns its "summary" and "description" fields. The paths of the fields are JSON pointers ("/paths/~1users/get/summary"),
+// in the order of the keys of the objects (alphabetical) and of the elements of the arrays. The texts are CommonMark, extracted as in `ExtractMarkdown`.
+// The examples, defaults, enums, and constants are data, so their fields are skipped.
+func ExtractOpenAPI(r io.Reader, bo BlockOptions) ([]Field, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var document any
+	if text := strings.TrimSpace(string(data)); strings.HasPrefix(text, "{") {
+		err = json.Unmarshal(data, &document)
+	} else {
+		document, err = parseYAML(string(data))
+	}
+	if err != nil {
+		return nil, err
+	}
+	root, ok := document.(map[string]any)
+	if !ok || (root["openapi"] == nil && root["swagger"] == nil) {
+		return nil, errors.New("No openapi or swagger version in the document. Cannot read OpenAPI.")
+	}
+	return appendOpenAPIFields(nil, root, "", "", bo), nil
+}
+
+// appendOpenAPIFields appends the documentation fields of the value of the key at the JSON pointer to the fields.
+func appendOpenAPIFields(fields []Field, value any, key, pointer string, bo BlockOptions) []Field {
+	switch value := value.(type) {
+	case map[string]any:
+		names := openAPINames[key]
+		keys := make([]string, 0, len(value))
+		for childKey := range value {
+			keys = append(keys, childKey)
+		}
+		sort.Strings(keys)
+		for _, childKey := range keys {
+			child := value[childKey]
+			childPointer := pointer + "/" + strings.ReplaceAll(strings.ReplaceAll(childKey, "~", "~0"), "/", "~1")
+			if text, ok := child.(string); ok && !names && (childKey == "summary" || childKey == "description") {
+				if text = strings.TrimSpace(ExtractMarkdown(text, bo).Text); text != "" {
+					fields = append(fields, Field{childPointer, text})
+				}
+				continue
+			}
+			if names || !openAPIValues[childKey] {
+				fields = appendOpenAPIFields(fields, child, childKey, childPointer, bo)
+			}
+		}
+	case []any:
+		for i, element := range value {
+			fields = appendOpenAPIFields(fields, element, key, pointer+"/"+strconv.Itoa(i), bo)
+		}
+	}
+	return fields
+}
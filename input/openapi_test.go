@@ -0,0 +1,101 @@
+package input_test
+
+import (
+	"bytes"
+	"goreadability/input"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractOpenAPI(t *testing.T) {
+	fields, err := input.ExtractOpenAPIFile("testdata/openapi.yaml", input.BlockOptions{})
+	if err != nil {
+		t.Fatalf("ExtractOpenAPIFile() returned error: %v", err)
+	}
+	checkGolden(t, "openapi", fields)
+
+	separated, err := input.ExtractOpenAPI(bytes.NewReader(readTestdata(t, "openapi.yaml")), input.BlockOptions{Headings: input.ExcludeBlocks, ListItems: input.ExcludeBlocks})
+	if err != nil {
+		t.Fatalf("ExtractOpenAPI() returned error: %v", err)
+	}
+	checkGolden(t, "openapi_excluded", separated)
+
+	json := `{"swagger": "2.0", "info": {"description": "Swagger *text*."}, "x": [{"summary": "One."}]}`
+	want := []input.Field{{"/info/description", "Swagger text."}, {"/x/0/summary", "One."}}
+	if got, err := input.ExtractOpenAPI(strings.NewReader(json), input.BlockOptions{}); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractOpenAPI(%q) = %v, %v, want %v", json, got, err, want)
+	}
+	if _, err := input.ExtractOpenAPI(strings.NewReader(`{"info": {}}`), input.BlockOptions{}); err == nil {
+		t.Errorf("ExtractOpenAPI() of a document without a version returned no error")
+	}
+}
+
+func FuzzExtractOpenAPI(f *testing.F) {
+	f.Add(readTestdata(f, "openapi.yaml"))
+	f.Add([]byte(`{"openapi": "3.1.0", "paths": {"/a~b": {"description": "[x](y"}}}`))
+	f.Fuzz(func(t *testing.T, document []byte) {
+		fields, err := input.ExtractOpenAPI(bytes.NewReader(document), input.BlockOptions{})
+		if err != nil {
+			return
+		}
+		for _, field := range fields {
+			checkText(t, document, field.Text)
+		}
+	})
+}
+
+func TestExtractOpenAPIFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		want     []input.Field
+	}{
+		{
+			"yaml paths",
+			"openapi: 3.0.0\ninfo:\n  title: Users\n  description: |\n    Manage the **users**.\n\n    See [the guide](https://example.com).\npaths:\n  /users/{id}:\n    get:\n      summary: Get a user.\n",
+			[]input.Field{{"/info/description", "Manage the users.\n\nSee the guide."}, {"/paths/~1users~1{id}/get/summary", "Get a user."}},
+		},
+		{
+			"keys in alphabetical order",
+			`{"openapi": "3.1.0", "tags": [{"name": "b", "description": "Second."}], "info": {"summary": "First."}}`,
+			[]input.Field{{"/info/summary", "First."}, {"/tags/0/description", "Second."}},
+		},
+		{
+			"tilde in a key",
+			`{"openapi": "3.1.0", "paths": {"/a~b": {"description": "Tilde."}}}`,
+			[]input.Field{{"/paths/~1a~0b/description", "Tilde."}},
+		},
+		{
+			"examples skipped",
+			`{"openapi": "3.1.0", "components": {"schemas": {"User": {"description": "A user.", "example": {"description": "Sample."}, "default": {"summary": "Default."}}}}}`,
+			[]input.Field{{"/components/schemas/User/description", "A user."}},
+		},
+		{
+			"properties named as the keys",
+			`{"openapi": "3.1.0", "properties": {"example": {"description": "The example."}, "description": {"type": "string"}}}`,
+			[]input.Field{{"/properties/example/description", "The example."}},
+		},
+		{
+			"empty and non-string fields",
+			`{"swagger": "2.0", "info": {"description": "  ", "summary": 3}, "x": {"description": "<!-- comment -->"}}`,
+			nil,
+		},
+	}
+	for _, test := range tests {
+		got, err := input.ExtractOpenAPI(strings.NewReader(test.document), input.BlockOptions{})
+		if err != nil {
+			t.Errorf("ExtractOpenAPI() %s returned error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ExtractOpenAPI() %s = %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	for _, document := range []string{"", "[1, 2]", `{"openapi": "3.0.0"`, "title: No version\n"} {
+		if _, err := input.ExtractOpenAPI(strings.NewReader(document), input.BlockOptions{}); err == nil {
+			t.Errorf("ExtractOpenAPI(%q) returned no error", document)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package input
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ====== Types ======
+
+// poEntry represents an entry of a gettext PO file while it is read.
+type poEntry struct {
+	context, id, plural string
+	translations        []string
+	fuzzy               bool
+	// field is the field the continuation strings are added to: "msgctxt", "msgid", "msgid_plural", or "msgstr[n]".
+	field string
+}
+
+// ====== Methods ======
+
+// add appends the string to the field of the entry.
+func (e *poEntry) add(field, value string) {
+	switch {
+	case field == "msgctxt":
+		e.context += value
+	case field == "msgid":
+		e.id += value
+	case field == "msgid_plural":
+		e.plural += value
+	case strings.HasPrefix(field, "msgstr"):
+		index := 0
+		if open := strings.IndexByte(field, '['); open >= 0 {
+			index, _ = strconv.Atoi(strings.TrimSuffix(field[open+1:], "]"))
+		}
+		for len(e.translations) <= index {
+			e.translations = append(e.translations, "")
+		}
+		e.translations[index] += value
+	}
+}
+
+// messages returns the messages of the entry: one for a singular entry, and one per translation of a plural entry,
+// with the key of the singular "msgid[n]" and the plural source text for n > 0.
+func (e *poEntry) messages() []Message {
+	if len(e.translations) == 0 {
+		e.translations = []string{""}
+	}
+	var messages []Message
+	for i, translation := range e.translations {
+		message := Message{Key: e.id, Context: e.context, Source: e.id, Translation: translation, Fuzzy: e.fuzzy}
+		if i > 0 {
+			message.Key = fmt.Sprintf("%s[%d]", e.id, i)
+			message.Source = e.plural
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// ====== Functions ======
+
+// ExtractPO accepts a gettext PO (or POT) file and returns its messages in the order of the file. The keys are the source texts ("msgid"), and the contexts are "msgctxt".
+// The target language is read from the "Language" header, and the source language is not declared by PO files.
+// The obsolete entries ("#~") are skipped, the fuzzy ones ("#, fuzzy") are marked, and the plural entries have a message per plural form.
+func ExtractPO(r io.Reader) (Catalog, error) {
+	var catalog Catalog
+	var entry poEntry
+	flush := func() {
+		if entry.field == "" {
+			entry = poEntry{}
+			return
+		}
+		if entry.id == "" && entry.context == "" {
+			// The header.
+			for _, line := range strings.Split(strings.Join(entry.translations, ""), "\n") {
+				if name, value, ok := strings.Cut(line, ":"); ok && strings.TrimSpace(name) == "Language" {
+					catalog.TargetLanguage = strings.TrimSpace(value)
+				}
+			}
+		} else {
+			catalog.Messages = append(catalog.Messages, entry.messages()...)
+		}
+		entry = poEntry{}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#~"):
+			// An obsolete entry.
+			continue
+		case strings.HasPrefix(line, "#"):
+			if entry.field != "" {
+				// A comment starts the next entry.
+				flush()
+			}
+			if strings.HasPrefix(line, "#,") && strings.Contains(line, "fuzzy") {
+				entry.fuzzy = true
+			}
+			continue
+		case strings.HasPrefix(line, "\""):
+			value, err := strconv.Unquote(line)
+			if err != nil || entry.field == "" {
+				return Catalog{}, fmt.Errorf("Invalid string at line %d. Cannot read PO.", number)
+			}
+			entry.add(entry.field, value)
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, " ")
+		if (field == "msgctxt" || field == "msgid") && entry.field != "" && entry.field != "msgctxt" {
+			flush()
+		}
+		unquoted, err := strconv.Unquote(strings.TrimSpace(value))
+		if err != nil {
+			return Catalog{}, fmt.Errorf("Invalid string at line %d. Cannot read PO.", number)
+		}
+		entry.field = field
+		entry.add(field, unquoted)
+	}
+	flush()
+	return catalog, scanner.Err()
+}
@@ -0,0 +1,402 @@
+package input
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// rstProseDirectives are the reStructuredText and Sphinx directives whose content is running text: admonitions, topics, and notes on versions.
+// The content of other directives (code, math, images, tables of contents, includes, autodoc) is removed.
+var rstProseDirectives = toSet(
+	"attention", "caution", "danger", "error", "hint", "important", "note", "tip", "warning", "admonition", "seealso", "todo",
+	"versionadded", "versionchanged", "deprecated", "topic", "sidebar", "rubric", "epigraph", "highlights", "pull-quote", "compound", "container", "only",
+)
+
+// rstTitledDirectives are the prose directives whose argument is a title rather than the first line of the text.
+var rstTitledDirectives = toSet("admonition", "topic", "sidebar", "rubric")
+
+// rstCodeRoles are the reStructuredText and Sphinx roles whose text is code or math, removed as inline literals are. The text of other roles is kept.
+var rstCodeRoles = toSet(
+	"math", "code", "literal", "samp", "file", "command", "program", "envvar", "option", "kbd", "makevar", "regexp",
+	"func", "meth", "class", "attr", "obj", "data", "const", "exc", "mod", "var", "type", "member", "macro", "enum", "struct",
+)
+
+// ====== Functions ======
+
+// FromRST accepts a reStructuredText document and the options for its headings, list items, and table cells and returns its text. See `ExtractRST`.
+func FromRST(s string, bo BlockOptions) string {
+	return ExtractRST(s, bo).Text
+}
+
+// ExtractRST accepts a reStructuredText document (including the Sphinx extensions) and the options for its headings, list items, and table cells and returns its Content.
+// The comments, link targets, footnotes, substitution definitions, field lists, literal blocks (after "::"), and the directives other than the admonitions and topics
+// ("code-block", "math", "image", "toctree") are removed with their indented content. The content of the admonitions (".. note::", ".. warning::") is kept as paragraphs.
+// Inline literals, math and code roles (":math:", ":func:"), substitutions, and footnote and citation references are removed, and the other roles (":ref:", ":term:")
+// and links are replaced with their texts. The section titles are headings, and the cells of grid and simple tables are table cells.
+func ExtractRST(s string, bo BlockOptions) Content {
+	c := lineConverter{w: blockWriter{bo: bo}, inline: rstInline}
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+
+	// skip is the indentation of the construct whose more indented lines are removed, or -1.
+	skip := -1
+	// literal is the indentation of the paragraph that ends with "::", which introduces a literal block, or -1.
+	literal := -1
+	blockIndent := 0
+	for i := 0; i < len(lines); i++ {
+		indent, content := markdownIndent(lines[i])
+		if content == "" {
+			c.flush()
+			continue
+		}
+		if skip >= 0 {
+			if indent > skip {
+				continue
+			}
+			skip = -1
+		}
+		if literal >= 0 {
+			if c.kind == "" && indent > literal {
+				skip = literal
+				literal = -1
+				continue
+			}
+			if c.kind == "" {
+				literal = -1
+			}
+		}
+
+		next := ""
+		if i+1 < len(lines) {
+			next = strings.TrimSpace(lines[i+1])
+		}
+		switch {
+		case c.kind == "" && isRSTAdornment(content) && i+2 < len(lines) && strings.TrimSpace(lines[i+2]) == content && next != "":
+			// A title between an overline and an underline.
+			c.start(HeadingBlock, next)
+			c.flush()
+			i += 2
+			continue
+		case isRSTAdornment(content) && len(content) >= 4 && c.kind == "":
+			// A transition.
+			continue
+		case c.kind == "" && !isRSTAdornment(content) && isRSTAdornment(next) && utf8.RuneCountInString(next) >= min(utf8.RuneCountInString(content), 3):
+			c.start(HeadingBlock, content)
+			c.flush()
+			i++
+			continue
+		case content == ".." || strings.HasPrefix(content, ".. "):
+			c.flush()
+			name, argument, ok := rstDirective(strings.TrimSpace(content[2:]))
+			if !ok || !rstProseDirectives[name] {
+				// A comment, a link target, a footnote, a substitution definition, or a directive without text.
+				skip = indent
+				continue
+			}
+			if argument != "" {
+				if rstTitledDirectives[name] {
+					c.start(HeadingBlock, argument)
+					c.flush()
+				} else {
+					c.start(ParagraphBlock, argument)
+					blockIndent = indent
+				}
+			}
+			continue
+		case isRSTFieldList(content):
+			// Directive options and document fields (":class: tip", ":author: Name").
+			c.flush()
+			skip = indent
+			continue
+		case strings.HasPrefix(content, "+-") || strings.HasPrefix(content, "+="):
+			c.flush()
+			i = rstGridTable(&c, lines, i) - 1
+			continue
+		case c.kind == "" && isRSTTableBorder(content):
+			c.flush()
+			i = rstSimpleTable(&c, lines, i) - 1
+			continue
+		}
+
+		if strings.HasSuffix(content, "::") {
+			// "Example::" is "Example:", and "Example ::" and "::" are removed.
+			content = strings.TrimSuffix(content, "::")
+			if content != "" && !strings.HasSuffix(content, " ") {
+				content += ":"
+			}
+			content = strings.TrimSpace(content)
+			if c.kind == "" {
+				literal = indent
+			} else {
+				literal = blockIndent
+			}
+			if content == "" {
+				c.flush()
+				continue
+			}
+		}
+		if item, ok := rstListItem(content); ok && c.kind != ParagraphBlock {
+			c.start(ListItemBlock, item)
+			blockIndent = indent
+			continue
+		}
+		if c.kind == "" {
+			c.start(ParagraphBlock, content)
+			blockIndent = indent
+			continue
+		}
+		// A continuation of the paragraph, or the definition of a term.
+		c.continueBlock(content)
+	}
+	return c.w.content()
+}
+
+// isRSTAdornment reports whether the line is a section adornment or a transition: one punctuation character repeated at least twice ("=====", "-----").
+func isRSTAdornment(line string) bool {
+	return len(line) >= 2 && isASCIIPunctuation(line[0]) && strings.Trim(line, line[:1]) == ""
+}
+
+// rstDirective parses the explicit markup after ".." and returns the name of the directive ("note", "code-block"), its argument, and true.
+// Returns false if the markup is not a directive (a comment, a link target, a footnote, or a substitution definition).
+func rstDirective(s string) (string, string, bool) {
+	name, argument, ok := strings.Cut(s, "::")
+	if !ok || name == "" || strings.ContainsAny(name, " \t|[]_") {
+		return "", "", false
+	}
+	if domain := strings.LastIndexByte(name, ':'); domain >= 0 {
+		// A Sphinx domain directive (".. py:function::").
+		name = name[domain+1:]
+	}
+	return strings.ToLower(name), strings.TrimSpace(argument), true
+}
+
+// isRSTFieldList reports whether the line starts a field list item (":name: value"), rather than a role (":ref:`target`").
+func isRSTFieldList(line string) bool {
+	if !strings.HasPrefix(line, ":") {
+		return false
+	}
+	end := strings.IndexByte(line[1:], ':')
+	if end <= 0 {
+		return false
+	}
+	rest := line[end+2:]
+	return !strings.HasPrefix(rest, "`") && (rest == "" || rest[0] == ' ' || rest[0] == '\t')
+}
+
+// rstListItem returns the text of the list item without its marker ("- ", "* ", "• ", "1. ", "#. ", "(a) ", "a) "), and false if the line is not a list item.
+func rstListItem(line string) (string, bool) {
+	if item, ok := listItem(line); ok {
+		return item, true
+	}
+	if strings.HasPrefix(line, "• ") {
+		return strings.TrimSpace(line[len("• "):]), true
+	}
+	marker, rest, ok := strings.Cut(line, " ")
+	if !ok {
+		return "", false
+	}
+	enumerator := strings.TrimPrefix(marker, "(")
+	switch {
+	case strings.HasPrefix(marker, "(") && strings.HasSuffix(enumerator, ")"),
+		!strings.HasPrefix(marker, "(") && (strings.HasSuffix(marker, ".") || strings.HasSuffix(marker, ")")):
+		enumerator = enumerator[:len(enumerator)-1]
+	default:
+		return "", false
+	}
+	// An auto-enumerator ("#"), a letter ("a", "B"), or a Roman numeral ("iv").
+	if enumerator == "#" || (len(enumerator) == 1 && isASCIILetter(enumerator[0])) ||
+		(enumerator != "" && (strings.Trim(enumerator, "ivxlcdm") == "" || strings.Trim(enumerator, "IVXLCDM") == "")) {
+		return strings.TrimSpace(rest), true
+	}
+	return "", false
+}
+
+// isRSTTableBorder reports whether the line is a border of a simple table: runs of "=" separated by spaces, with at least two columns.
+func isRSTTableBorder(line string) bool {
+	return strings.Trim(line, "= ") == "" && len(strings.Fields(line)) >= 2
+}
+
+// rstGridTable writes the cells of the grid table that starts at the line and returns the index of the line after the table.
+// The lines of a cell are joined, so a cell is one block.
+func rstGridTable(c *lineConverter, lines []string, i int) int {
+	var cells []string
+	flushRow := func() {
+		for _, cell := range cells {
+			c.start(TableCellBlock, cell)
+		}
+		cells = nil
+	}
+	for ; i < len(lines); i++ {
+		row := strings.TrimSpace(lines[i])
+		switch {
+		case strings.HasPrefix(row, "+"):
+			flushRow()
+			continue
+		case !strings.HasPrefix(row, "|"):
+			flushRow()
+			c.flush()
+			return i
+		}
+		for j, cell := range strings.Split(strings.Trim(row, "|"), "|") {
+			if j == len(cells) {
+				cells = append(cells, "")
+			}
+			cells[j] = strings.TrimSpace(cells[j] + " " + strings.TrimSpace(cell))
+		}
+	}
+	flushRow()
+	c.flush()
+	return i
+}
+
+// rstSimpleTable writes the cells of the simple table whose top border is the line and returns the index of the line after the table.
+// The columns are the runs of "=" of the border, and the text of the last column may overflow it.
+func rstSimpleTable(c *lineConverter, lines []string, i int) int {
+	border := lines[i]
+	var starts []int
+	for j := 0; j < len(border); j++ {
+		if border[j] == '=' && (j == 0 || border[j-1] == ' ') {
+			starts = append(starts, j)
+		}
+	}
+	for i++; i < len(lines); i++ {
+		line := lines[i]
+		content := strings.TrimSpace(line)
+		if isRSTTableBorder(content) {
+			if i+1 == len(lines) || strings.TrimSpace(lines[i+1]) == "" {
+				c.flush()
+				return i + 1
+			}
+			continue
+		}
+		if content == "" || isRSTAdornment(strings.ReplaceAll(content, " ", "")) {
+			continue
+		}
+		for k, start := range starts {
+			end := len(line)
+			if k+1 < len(starts) {
+				end = min(starts[k+1], len(line))
+			}
+			if start < end {
+				c.start(TableCellBlock, strings.TrimSpace(line[start:end]))
+			}
+		}
+	}
+	c.flush()
+	return i
+}
+
+// rstInline returns the text of the line with the inline markup removed: inline literals, code and math roles, substitutions, footnote and citation references, and emphasis.
+// Links, references, and other roles are replaced with their texts.
+func rstInline(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s):
+			b.WriteByte(s[i+1])
+			i += 2
+			continue
+		case strings.HasPrefix(s[i:], "``"):
+			if end := strings.Index(s[i+2:], "``"); end >= 0 {
+				i += 2 + end + 2
+				trimRemovedSpace(&b, s[i:])
+				continue
+			}
+		case c == ':':
+			if role, text, end, ok := rstRole(s, i); ok {
+				i = end
+				if rstCodeRoles[role] {
+					trimRemovedSpace(&b, s[i:])
+				} else {
+					b.WriteString(rstReferenceText(text))
+				}
+				continue
+			}
+		case c == '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end >= 0 {
+				b.WriteString(rstReferenceText(s[i+1 : i+1+end]))
+				i += 1 + end + 1
+				i += len(s[i:]) - len(strings.TrimLeft(s[i:], "_"))
+				if strings.HasPrefix(s[i:], ":") {
+					// A role after the text ("`text`:role:").
+					if end := strings.IndexByte(s[i+1:], ':'); end > 0 {
+						i += 1 + end + 1
+					}
+				}
+				continue
+			}
+		case c == '|':
+			if end := strings.IndexByte(s[i+1:], '|'); end > 0 && s[i+1] != ' ' && s[i+end] != ' ' {
+				i += 1 + end + 1
+				i += len(s[i:]) - len(strings.TrimLeft(s[i:], "_"))
+				trimRemovedSpace(&b, s[i:])
+				continue
+			}
+		case c == '[':
+			if end := strings.Index(s[i:], "]_"); end > 1 && !strings.ContainsAny(s[i+1:i+end], " \t[") {
+				// A footnote or citation reference ("[1]_", "[#]_", "[CIT2002]_").
+				i += end + 2
+				trimmed := strings.TrimRight(b.String(), " ")
+				b.Reset()
+				b.WriteString(trimmed)
+				continue
+			}
+		case c == '_':
+			before, _ := utf8.DecodeLastRuneInString(s[:i])
+			run := len(s[i:]) - len(strings.TrimLeft(s[i:], "_"))
+			after, _ := utf8.DecodeRuneInString(s[i+run:])
+			if run <= 2 && isLetterOrDigit(before) && !isLetterOrDigit(after) {
+				// A reference ("Python_", "anonymous__").
+				i += run
+				continue
+			}
+		case c == '*':
+			run := len(s[i:]) - len(strings.TrimLeft(s[i:], "*"))
+			if isEmphasisRun(s, i, run, c) {
+				i += run
+				continue
+			}
+			b.WriteString(s[i : i+run])
+			i += run
+			continue
+		}
+		b.WriteByte(c)
+		i++
+	}
+	return b.String()
+}
+
+// rstRole parses the role that starts with ":" at the index (":ref:`text`", ":py:func:`name`") and returns its name without the domain, its text,
+// the index after the role, and true. Returns false if there is no role at the index.
+func rstRole(s string, i int) (string, string, int, bool) {
+	j := i + 1
+	for j < len(s) && (isASCIILetter(s[j]) || (s[j] >= '0' && s[j] <= '9') || strings.IndexByte(":-_.+", s[j]) >= 0) {
+		if s[j] == ':' && j+1 < len(s) && s[j+1] == '`' {
+			break
+		}
+		j++
+	}
+	if j == i+1 || j+1 >= len(s) || s[j] != ':' || s[j+1] != '`' {
+		return "", "", 0, false
+	}
+	end := strings.IndexByte(s[j+2:], '`')
+	if end < 0 {
+		return "", "", 0, false
+	}
+	name := s[i+1 : j]
+	if domain := strings.LastIndexByte(name, ':'); domain >= 0 {
+		name = name[domain+1:]
+	}
+	return strings.ToLower(name), s[j+2 : j+2+end], j + 2 + end + 1, true
+}
+
+// rstReferenceText returns the text of a reference or a role: the title of "Title <target>", without the "~" and "!" prefixes of Sphinx.
+func rstReferenceText(s string) string {
+	if end := strings.LastIndexByte(s, '<'); end > 0 && strings.HasSuffix(s, ">") {
+		s = strings.TrimRightFunc(s[:end], unicode.IsSpace)
+	}
+	return strings.TrimLeft(s, "~!")
+}
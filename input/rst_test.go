@@ -0,0 +1,46 @@
+package input_test
+
+import (
+	"goreadability/input"
+	"testing"
+)
+
+func TestExtractRST(t *testing.T) {
+	rst := string(readTestdata(t, "guide.rst"))
+	checkGolden(t, "guide_rst", input.ExtractRST(rst, input.BlockOptions{}))
+	checkGolden(t, "guide_rst_separated", input.ExtractRST(rst, input.BlockOptions{Headings: input.SeparateBlocks, ListItems: input.SeparateBlocks, TableCells: input.ExcludeBlocks}))
+}
+
+func TestFromRST(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"paragraph lines", "One two\nthree.\n\nFour.", "One two three.\n\nFour."},
+		{"section title", "Title\n=====\n\nText.", "Title\n\nText."},
+		{"inline markup", "An *emphasized* and **strong** word with ``code``.", "An emphasized and strong word with."},
+		{"roles and links", "See :ref:`the guide <guide>` and `Python <https://python.org>`_, :math:`x^2`.", "See the guide and Python,."},
+		{"comment", ".. A comment\n   on two lines.\n\nText.", "Text."},
+		{"literal block", "Example::\n\n    code here\n\nText.", "Example:\n\nText."},
+		{"directive", ".. code-block:: go\n\n   fmt.Println()\n\nText.", "Text."},
+		{"admonition", ".. note::\n\n   Be careful.\n\nText.", "Be careful.\n\nText."},
+		{"list items", "- One\n- Two", "One\n\nTwo"},
+		{"footnote reference", "Text [#]_ here.\n\n.. [#] The note.", "Text here."},
+	}
+	for _, test := range tests {
+		if got := input.ExtractRST(test.s, input.BlockOptions{}).Text; got != test.want {
+			t.Errorf("ExtractRST() %s = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func FuzzExtractRST(f *testing.F) {
+	f.Add(readTestdata(f, "guide.rst"))
+	f.Add([]byte("Title\n=\n\n.. note::\n+--+\n| a\n"))
+	f.Add([]byte(":ref:`open <\n`link <``"))
+	f.Fuzz(func(t *testing.T, rst []byte) {
+		content := input.ExtractRST(string(rst), input.BlockOptions{TableCells: input.SeparateBlocks})
+		checkText(t, rst, content.Text)
+	})
+}
@@ -0,0 +1,50 @@
+// Package shapes computes the areas of shapes.
+//
+// # Overview
+//
+// Every shape implements [Shape]. See the [Go spec] for the details:
+//   - squares,
+//   - circles.
+//
+// For example:
+//
+//	area := shapes.Square{Side: 2}.Area()
+//
+// [Go spec]: https://go.dev/ref/spec
+package shapes
+
+import "math"
+
+// Shape is a plane figure.
+type Shape interface {
+	Area() float64
+}
+
+// Square is a square.
+type Square struct {
+	Side float64
+}
+
+// Area returns the area of the square.
+func (s Square) Area() float64 {
+	return s.Side * s.Side
+}
+
+// circle is a circle.
+type circle[T ~float64] struct {
+	radius T
+}
+
+// Area returns the area of the circle.
+func (c *circle[T]) Area() float64 {
+	return math.Pi * float64(c.radius*c.radius)
+}
+
+// The units of the shapes.
+const (
+	// Meter is a meter.
+	Meter = 1.0
+	Inch  = 0.0254
+)
+
+func undocumented() {}
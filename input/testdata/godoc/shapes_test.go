@@ -0,0 +1,4 @@
+package shapes
+
+// TestDoc is skipped.
+func TestDoc() {}
@@ -0,0 +1,140 @@
+package input
+
+import (
+	"encoding/xml"
+	"errors"
+	"html"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// xliffCodes are the XLIFF 1.2 inline elements of paired codes of the original format. Their content is native code, which is removed.
+var xliffCodes = toSet("bpt", "ept")
+
+// xliffPlaceholders are the XLIFF inline elements that stand for a code in the text ("x", "ph", "it"), which are replaced with placeholders ("{1}").
+var xliffPlaceholders = toSet("x", "ph", "it")
+
+// ====== Functions ======
+
+// ExtractXLIFF accepts an XLIFF file (1.2 or 2) and returns its messages in the order of the file. The keys are the names ("resname", "name") or the IDs of the units,
+// and the languages are read from the "xliff" element (XLIFF 2) or the "file" element (XLIFF 1.2).
+// The segments of a unit are joined (by a space if they are not separated by whitespace), the inline codes are replaced with placeholders ("{1}"), and the alternative translations and notes are skipped.
+// The translations marked for a review (`state="needs-review-translation"`) are fuzzy.
+func ExtractXLIFF(r io.Reader) (Catalog, error) {
+	var catalog Catalog
+	var message *Message
+	var text *strings.Builder
+	var source, target strings.Builder
+	// skipped is the depth of the skipped elements (alternative translations, notes, native codes) the decoder is in.
+	skipped := 0
+	// separate is true at the start of a segment after the first one, which is separated from the previous one by a space unless either has it.
+	separate := false
+	write := func(s string) {
+		if separate && s != "" {
+			first, _ := utf8.DecodeRuneInString(s)
+			last, _ := utf8.DecodeLastRuneInString(text.String())
+			if !unicode.IsSpace(first) && !unicode.IsSpace(last) {
+				text.WriteByte(' ')
+			}
+			separate = false
+		}
+		text.WriteString(s)
+	}
+
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Catalog{}, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			name := token.Name.Local
+			if skipped > 0 {
+				skipped++
+				continue
+			}
+			switch {
+			case name == "xliff":
+				catalog.SourceLanguage = xmlAttribute(token, "srcLang")
+				catalog.TargetLanguage = xmlAttribute(token, "trgLang")
+			case name == "file" && catalog.SourceLanguage == "":
+				catalog.SourceLanguage = xmlAttribute(token, "source-language")
+				catalog.TargetLanguage = xmlAttribute(token, "target-language")
+			case name == "trans-unit" || name == "unit":
+				key := xmlAttribute(token, "resname")
+				if key == "" {
+					key = xmlAttribute(token, "name")
+				}
+				if key == "" {
+					key = xmlAttribute(token, "id")
+				}
+				message = &Message{Key: key}
+				source.Reset()
+				target.Reset()
+			case message == nil:
+			case name == "alt-trans" || name == "note" || name == "notes" || name == "originalData":
+				skipped = 1
+			case name == "source":
+				text = &source
+				separate = source.Len() > 0
+			case name == "target":
+				text = &target
+				separate = target.Len() > 0
+				if state := xmlAttribute(token, "state"); strings.HasPrefix(state, "needs-review") {
+					message.Fuzzy = true
+				}
+			case text != nil && xliffPlaceholders[name]:
+				var code struct {
+					Inner string `xml:",innerxml"`
+				}
+				if err := decoder.DecodeElement(&code, &token); err != nil {
+					return Catalog{}, err
+				}
+				// The codes of formatting tags ("<b>") are not words, unlike the variables they stand for otherwise.
+				if !strings.HasPrefix(strings.TrimSpace(html.UnescapeString(code.Inner)), "<") {
+					id := xmlAttribute(token, "id")
+					if id == "" {
+						id = name
+					}
+					write("{" + id + "}")
+				}
+			case text != nil && xliffCodes[name]:
+				skipped = 1
+			}
+		case xml.EndElement:
+			if skipped > 0 {
+				skipped--
+				continue
+			}
+			switch token.Name.Local {
+			case "source", "target":
+				text = nil
+			case "trans-unit", "unit":
+				if message != nil {
+					message.Source = source.String()
+					message.Translation = target.String()
+					catalog.Messages = append(catalog.Messages, *message)
+				}
+				message = nil
+			}
+		case xml.CharData:
+			if text != nil && skipped == 0 {
+				write(string(token))
+			}
+		}
+	}
+	if catalog.Messages == nil && catalog.SourceLanguage == "" {
+		return Catalog{}, errors.New("No XLIFF units or files were found. Cannot read XLIFF.")
+	}
+	return catalog, nil
+}
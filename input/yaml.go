@@ -0,0 +1,455 @@
+package input
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// yamlParser parses a YAML document line by line into the values `encoding/json` decodes to: map[string]any, []any, string, float64, bool, and nil.
+type yamlParser struct {
+	lines []string
+	i     int
+	// anchors maps the anchors ("&name") to their values, for the aliases ("*name").
+	anchors map[string]any
+}
+
+// ====== Methods ======
+
+// skipBlank moves to the next line that is not blank or a comment and returns its indentation and content without the comment, or false at the end of the document.
+func (p *yamlParser) skipBlank() (int, string, bool) {
+	for ; p.i < len(p.lines); p.i++ {
+		line := p.lines[p.i]
+		content := strings.TrimSpace(stripYAMLComment(line))
+		if content == "---" && p.i == 0 {
+			continue
+		}
+		if content == "..." || (content == "---" && p.i > 0) {
+			// The end of the first document.
+			p.i = len(p.lines)
+			break
+		}
+		if content != "" {
+			return len(line) - len(strings.TrimLeft(line, " ")), content, true
+		}
+	}
+	return 0, "", false
+}
+
+// node parses the node that starts at the next line if it is indented more than the parent, or returns nil.
+func (p *yamlParser) node(parent int) (any, error) {
+	indent, content, ok := p.skipBlank()
+	if !ok || indent <= parent {
+		return nil, nil
+	}
+	switch {
+	case content == "-" || strings.HasPrefix(content, "- "):
+		return p.sequence(indent)
+	case yamlKeyEnd(content) >= 0:
+		return p.mapping(indent)
+	}
+	p.i++
+	return p.value(content, parent)
+}
+
+// sequence parses the block sequence whose items ("- item") are at the indentation.
+func (p *yamlParser) sequence(indent int) ([]any, error) {
+	items := []any{}
+	for {
+		lineIndent, content, ok := p.skipBlank()
+		if !ok || lineIndent != indent || (content != "-" && !strings.HasPrefix(content, "- ")) {
+			return items, nil
+		}
+		rest := strings.TrimLeft(content[1:], " ")
+		var item any
+		var err error
+		if rest == "" {
+			p.i++
+			item, err = p.node(indent)
+		} else {
+			// The item is parsed as if it started on its own line at the indentation of its content: "- key: value" is a mapping.
+			p.lines[p.i] = strings.Repeat(" ", indent+len(content)-len(rest)) + rest
+			item, err = p.node(indent)
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+}
+
+// mapping parses the block mapping whose keys ("key: value") are at the indentation.
+func (p *yamlParser) mapping(indent int) (map[string]any, error) {
+	result := map[string]any{}
+	for {
+		lineIndent, content, ok := p.skipBlank()
+		if !ok || lineIndent != indent {
+			return result, nil
+		}
+		end := yamlKeyEnd(content)
+		if end < 0 {
+			return nil, fmt.Errorf("Expected a key at line %d. Cannot parse YAML.", p.i+1)
+		}
+		key := yamlKey(content[:end])
+		rest := strings.TrimSpace(content[end+1:])
+		p.i++
+
+		var value any
+		var err error
+		switch {
+		case rest == "":
+			if nextIndent, next, ok := p.skipBlank(); ok && nextIndent == indent && (next == "-" || strings.HasPrefix(next, "- ")) {
+				// A sequence may have the indentation of its key.
+				value, err = p.sequence(indent)
+			} else {
+				value, err = p.node(indent)
+			}
+		default:
+			value, err = p.value(rest, indent)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if key != "<<" {
+			result[key] = value
+		}
+	}
+}
+
+// value parses the value that starts with the text after a key or a sequence marker, with its continuation lines indented more than the parent.
+func (p *yamlParser) value(text string, parent int) (any, error) {
+	anchor := ""
+	for strings.HasPrefix(text, "&") || strings.HasPrefix(text, "!") {
+		// An anchor ("&name") or a tag ("!!str").
+		name, rest, _ := strings.Cut(text, " ")
+		if strings.HasPrefix(name, "&") {
+			anchor = name[1:]
+		}
+		text = strings.TrimSpace(rest)
+	}
+
+	var value any
+	var err error
+	switch {
+	case text == "":
+		value, err = p.node(parent)
+	case strings.HasPrefix(text, "*"):
+		value = p.anchors[text[1:]]
+	case text[0] == '|' || text[0] == '>':
+		value = p.blockScalar(text, parent)
+	case text[0] == '"' || text[0] == '\'':
+		value, err = p.quoted(text)
+	case text[0] == '[' || text[0] == '{':
+		for strings.Count(text, text[:1]) > strings.Count(text, map[byte]string{'[': "]", '{': "}"}[text[0]]) && p.i < len(p.lines) {
+			text += " " + strings.TrimSpace(stripYAMLComment(p.lines[p.i]))
+			p.i++
+		}
+		value, _, err = parseYAMLFlow(text, 0)
+	default:
+		// A plain scalar, folded with its continuation lines.
+		for {
+			indent, next, ok := p.skipBlank()
+			if !ok || indent <= parent || yamlKeyEnd(next) >= 0 || strings.HasPrefix(next, "- ") {
+				break
+			}
+			text += " " + next
+			p.i++
+		}
+		value = yamlPlainScalar(text)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if anchor != "" {
+		p.anchors[anchor] = value
+	}
+	return value, nil
+}
+
+// blockScalar parses the literal ("|") or folded (">") block scalar with the header, whose lines are indented more than the parent.
+func (p *yamlParser) blockScalar(header string, parent int) string {
+	folded := header[0] == '>'
+	chomping := byte(0)
+	indent := -1
+	for _, c := range []byte(header[1:]) {
+		switch {
+		case c == '-' || c == '+':
+			chomping = c
+		case c >= '1' && c <= '9':
+			indent = parent + 1 + int(c-'1')
+		}
+	}
+
+	var lines []string
+	for ; p.i < len(p.lines); p.i++ {
+		line := p.lines[p.i]
+		lineIndent := len(line) - len(strings.TrimLeft(line, " "))
+		if strings.TrimSpace(line) == "" {
+			lines = append(lines, "")
+			continue
+		}
+		if indent < 0 {
+			indent = lineIndent
+		}
+		if lineIndent <= parent || lineIndent < indent {
+			break
+		}
+		lines = append(lines, line[indent:])
+	}
+
+	content := len(lines)
+	for content > 0 && lines[content-1] == "" {
+		content--
+	}
+	var b strings.Builder
+	for i, line := range lines[:content] {
+		switch {
+		case i == 0, folded && line != "" && lines[i-1] == "":
+			// In a folded scalar, a blank line is a line break.
+		case !folded || line == "" || strings.HasPrefix(line, " ") || strings.HasPrefix(lines[i-1], " "):
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(' ')
+		}
+		b.WriteString(line)
+	}
+	switch chomping {
+	case '-':
+	case '+':
+		b.WriteString(strings.Repeat("\n", len(lines)-content+1))
+	default:
+		if content > 0 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// quoted parses the single- or double-quoted scalar that starts the text, with its continuation lines, which are folded.
+func (p *yamlParser) quoted(text string) (string, error) {
+	start := p.i
+	for {
+		if value, _, ok := yamlQuoted(text); ok {
+			return value, nil
+		}
+		if p.i >= len(p.lines) {
+			return "", fmt.Errorf("Unclosed quote at line %d. Cannot parse YAML.", start)
+		}
+		next := strings.TrimSpace(p.lines[p.i])
+		p.i++
+		if next == "" {
+			text += "\n"
+		} else if strings.HasSuffix(text, "\n") {
+			text += next
+		} else {
+			text += " " + next
+		}
+	}
+}
+
+// ====== Functions ======
+
+// parseYAML parses the first document of the YAML string into the values `encoding/json` decodes to.
+// The block mappings and sequences, the flow collections ("[a, b]", "{a: b}"), the plain, quoted, literal ("|"), and folded (">") scalars,
+// the comments, the anchors and aliases, and the tags are supported. The plain scalars are resolved by the core schema ("true", "3.14", "null").
+func parseYAML(s string) (any, error) {
+	p := yamlParser{lines: strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n"), anchors: map[string]any{}}
+	return p.node(-1)
+}
+
+// stripYAMLComment returns the line without its comment ("# comment" at the start or after a whitespace, outside quotes).
+func stripYAMLComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case (c == '"' || c == '\'') && (i == 0 || strings.IndexByte(" \t:[{,-", line[i-1]) >= 0):
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// yamlKeyEnd returns the index of the ":" that ends the key of a mapping entry ("key: value", "key:"), or -1 if the line is not a mapping entry.
+func yamlKeyEnd(line string) int {
+	if strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "[") || strings.HasPrefix(line, "{") {
+		return -1
+	}
+	if line[0] == '"' || line[0] == '\'' {
+		_, end, ok := yamlQuoted(line)
+		if !ok || !strings.HasPrefix(line[end:], ":") || (end+1 < len(line) && line[end+1] != ' ') {
+			return -1
+		}
+		return end
+	}
+	for i := 0; i < len(line); i++ {
+		if line[i] == ':' && (i+1 == len(line) || line[i+1] == ' ' || line[i+1] == '\t') {
+			return i
+		}
+	}
+	return -1
+}
+
+// yamlKey returns the key of a mapping entry, unquoted.
+func yamlKey(key string) string {
+	key = strings.TrimSpace(key)
+	if value, end, ok := yamlQuoted(key); ok && end == len(key) {
+		return value
+	}
+	return key
+}
+
+// yamlQuoted parses the quoted scalar that starts the text and returns its value, the index after it, and true, or false if it is not quoted or not closed.
+func yamlQuoted(text string) (string, int, bool) {
+	if text == "" || (text[0] != '"' && text[0] != '\'') {
+		return "", 0, false
+	}
+	quote := text[0]
+	var b strings.Builder
+	for i := 1; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote == '\'' && c == '\'':
+			if i+1 < len(text) && text[i+1] == '\'' {
+				b.WriteByte('\'')
+				i++
+				continue
+			}
+			return b.String(), i + 1, true
+		case quote == '"' && c == '"':
+			return b.String(), i + 1, true
+		case quote == '"' && c == '\\' && i+1 < len(text):
+			i++
+			switch text[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case '0':
+				b.WriteByte(0)
+			case 'u', 'U', 'x':
+				size := map[byte]int{'x': 2, 'u': 4, 'U': 8}[text[i]]
+				if i+size < len(text) {
+					if code, err := strconv.ParseUint(text[i+1:i+1+size], 16, 32); err == nil {
+						b.WriteRune(rune(code))
+						i += size
+					}
+				}
+			default:
+				b.WriteByte(text[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", 0, false
+}
+
+// yamlPlainScalar resolves the plain scalar by the core schema: null, a boolean, a number, or a string.
+func yamlPlainScalar(text string) any {
+	switch text {
+	case "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	}
+	if first, _ := utf8.DecodeRuneInString(text); strings.ContainsRune("0123456789+-.", first) {
+		if number, err := strconv.ParseFloat(text, 64); err == nil {
+			return number
+		}
+		if number, err := strconv.ParseInt(text, 0, 64); err == nil {
+			return float64(number)
+		}
+	}
+	return text
+}
+
+// parseYAMLFlow parses the flow node ("[a, b]", "{a: b}", a scalar) at the index and returns its value and the index after it.
+func parseYAMLFlow(s string, i int) (any, int, error) {
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+	if i == len(s) {
+		return nil, i, nil
+	}
+	switch s[i] {
+	case '[', '{':
+		closing := map[byte]byte{'[': ']', '{': '}'}[s[i]]
+		var items []any
+		entries := map[string]any{}
+		for i++; ; {
+			for i < len(s) && (s[i] == ' ' || s[i] == ',') {
+				i++
+			}
+			if i == len(s) {
+				return nil, i, errors.New("Unclosed flow collection. Cannot parse YAML.")
+			}
+			if s[i] == closing {
+				if closing == ']' {
+					if items == nil {
+						items = []any{}
+					}
+					return items, i + 1, nil
+				}
+				return entries, i + 1, nil
+			}
+			value, end, err := parseYAMLFlow(s, i)
+			if err != nil {
+				return nil, end, err
+			}
+			if end == i && (s[i] == ']' || s[i] == '}') {
+				// The closer of the other kind of collection: "[}", "{]".
+				return nil, i, errors.New("Mismatched flow collection closer. Cannot parse YAML.")
+			}
+			i = end
+			for i < len(s) && s[i] == ' ' {
+				i++
+			}
+			if i < len(s) && s[i] == ':' {
+				// A key of a mapping entry.
+				var entry any
+				entry, i, err = parseYAMLFlow(s, i+1)
+				if err != nil {
+					return nil, i, err
+				}
+				key := fmt.Sprint(value)
+				if closing == ']' {
+					items = append(items, map[string]any{key: entry})
+				} else {
+					entries[key] = entry
+				}
+				continue
+			}
+			if closing == ']' {
+				items = append(items, value)
+			} else {
+				entries[fmt.Sprint(value)] = nil
+			}
+		}
+	case '"', '\'':
+		value, end, ok := yamlQuoted(s[i:])
+		if !ok {
+			return nil, len(s), errors.New("Unclosed quote in a flow collection. Cannot parse YAML.")
+		}
+		return value, i + end, nil
+	}
+	end := i
+	for end < len(s) && strings.IndexByte(",]}", s[end]) < 0 && !(s[end] == ':' && (end+1 == len(s) || strings.IndexByte(" ,]}", s[end+1]) >= 0)) {
+		end++
+	}
+	return yamlPlainScalar(strings.TrimSpace(s[i:end])), end, nil
+}
@@ -0,0 +1,61 @@
+package input_test
+
+import (
+	"goreadability/input"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractYAMLFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		yaml  string
+		paths []string
+		want  []input.Field
+	}{
+		{"block mapping", "title: Hello world\ninfo:\n  summary: A short text.\n", []string{"title", "info.summary"},
+			[]input.Field{{"title", "Hello world"}, {"info.summary", "A short text."}}},
+		{"block sequence", "items:\n  - help: First.\n  - help: Second.\n", []string{"items[].help"},
+			[]input.Field{{"items[0].help", "First."}, {"items[1].help", "Second."}}},
+		{"flow sequence", "tags: [one, \"two, three\", 'four']\n", []string{"tags"},
+			[]input.Field{{"tags[0]", "one"}, {"tags[1]", "two, three"}, {"tags[2]", "four"}}},
+		{"flow mapping", "info: {title: Hello, summary: [A text.]}\n", []string{"info.title", "info.summary"},
+			[]input.Field{{"info.title", "Hello"}, {"info.summary[0]", "A text."}}},
+		{"empty flow entry", "info: {title: }\n", []string{"info.title"}, nil},
+		{"literal scalar", "text: |\n  Line one.\n  Line two.\n", []string{"text"},
+			[]input.Field{{"text", "Line one.\nLine two.\n"}}},
+		{"folded scalar", "text: >\n  Line one.\n  Line two.\n", []string{"text"},
+			[]input.Field{{"text", "Line one. Line two.\n"}}},
+		{"anchor and alias", "a: &name Hello\nb: *name\n", []string{"b"}, []input.Field{{"b", "Hello"}}},
+		{"comment", "title: Hello # not the title\n", []string{"title"}, []input.Field{{"title", "Hello"}}},
+	}
+	for _, tt := range tests {
+		got, err := input.ExtractYAMLFields(strings.NewReader(tt.yaml), tt.paths)
+		if err != nil {
+			t.Errorf("%s: ExtractYAMLFields(%q) returned error: %v", tt.name, tt.yaml, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ExtractYAMLFields(%q) = %v, want %v", tt.name, tt.yaml, got, tt.want)
+		}
+	}
+}
+
+func TestExtractYAMLFieldsErrors(t *testing.T) {
+	tests := []string{
+		"[}",
+		"{]",
+		"a: [}",
+		"a: {]",
+		"a: [x}",
+		"a: {x: [y}}",
+		"a: [x, y",
+		"a: [\"x]",
+	}
+	for _, yaml := range tests {
+		if _, err := input.ExtractYAMLFields(strings.NewReader(yaml), []string{"a"}); err == nil {
+			t.Errorf("ExtractYAMLFields(%q) returned no error", yaml)
+		}
+	}
+}
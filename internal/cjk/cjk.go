@@ -0,0 +1,39 @@
+// Package `cjk` provides the sentence splitting shared by the packages for languages written without spaces between words (`ja` and `zh`).
+package cjk
+
+import "strings"
+
+// closingBrackets are the closing brackets and quotes that belong to the sentence they close when they follow a terminator (`。」`, `！”`).
+const closingBrackets = "」』）)】〉》\"”’"
+
+// ====== Functions ======
+
+// SplitSentences accepts a string and the runes that end its sentences and splits it into sentences.
+// Closing brackets and quotes right after a terminator belong to the sentence they close.
+// A trailing text without a terminator is returned as the last sentence. Empty sentences are skipped.
+func SplitSentences(s string, terminators string) []string {
+	var sentences []string
+	runes := []rune(s)
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		if !strings.ContainsRune(terminators, runes[i]) {
+			continue
+		}
+		for i+1 < len(runes) && (strings.ContainsRune(terminators, runes[i+1]) || isClosingBracket(runes[i+1])) {
+			i++
+		}
+		if sentence := strings.TrimSpace(string(runes[start : i+1])); sentence != "" {
+			sentences = append(sentences, sentence)
+		}
+		start = i + 1
+	}
+	if sentence := strings.TrimSpace(string(runes[start:])); sentence != "" {
+		sentences = append(sentences, sentence)
+	}
+	return sentences
+}
+
+// isClosingBracket reports whether the rune is a closing bracket or quote (see `closingBrackets`).
+func isClosingBracket(char rune) bool {
+	return strings.ContainsRune(closingBrackets, char)
+}
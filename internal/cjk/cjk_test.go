@@ -0,0 +1,55 @@
+package cjk_test
+
+import (
+	"goreadability/internal/cjk"
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestSplitSentences(t *testing.T) {
+	tests := []struct {
+		text        string
+		terminators string
+		want        []string
+	}{
+		{"今日は晴れです。「本当？」と聞いた。", "。！？!?", []string{"今日は晴れです。", "「本当？」", "と聞いた。"}},
+		{"我们走吧！！” 他说；然后…", "。！？；…!?;", []string{"我们走吧！！”", "他说；", "然后…"}},
+		{"はい。いいえ！本当？", "。！？!?", []string{"はい。", "いいえ！", "本当？"}},
+		{"最後の文", "。", []string{"最後の文"}},
+		{" 。 。", "。", []string{"。", "。"}},
+		{"", "。", nil},
+	}
+	for _, tt := range tests {
+		if got := cjk.SplitSentences(tt.text, tt.terminators); !slices.Equal(got, tt.want) {
+			t.Errorf("SplitSentences(%q, %q) = %q, want %q", tt.text, tt.terminators, got, tt.want)
+		}
+	}
+}
+
+func FuzzSplitSentences(f *testing.F) {
+	f.Add("今日は晴れです。「本当？」と聞いた。", "。！？!?")
+	f.Add("我们走吧！！” 他说；然后…", "。！？；…!?;")
+	f.Add("\xe8\xb4", "0")
+	f.Fuzz(func(t *testing.T, text, terminators string) {
+		withoutSpaces := func(s string) string {
+			return strings.Map(func(char rune) rune {
+				if unicode.IsSpace(char) {
+					return -1
+				}
+				return char
+			}, string([]rune(s)))
+		}
+		// The sentences are the whole text without the whitespaces around them. The invalid UTF-8 bytes are replaced with U+FFFD one by one.
+		sentences := cjk.SplitSentences(text, terminators)
+		for _, sentence := range sentences {
+			if sentence == "" || sentence != strings.TrimSpace(sentence) {
+				t.Errorf("SplitSentences(%q, %q) has the sentence %q", text, terminators, sentence)
+			}
+		}
+		if got, want := withoutSpaces(strings.Join(sentences, "")), withoutSpaces(text); got != want {
+			t.Errorf("SplitSentences(%q, %q) = %q, which is not the whole text", text, terminators, sentences)
+		}
+	})
+}
@@ -8,22 +8,93 @@ import (
 	"math"
 )
 
+// Gulpease index thresholds below which a text is difficult for readers with the education level.
+const (
+	GULPEASE_ELEMENTARY_THRESHOLD    = 80
+	GULPEASE_MIDDLE_SCHOOL_THRESHOLD = 60
+	GULPEASE_HIGH_SCHOOL_THRESHOLD   = 40
+)
+
+// ====== Types ======
+
+// GulpeaseResult represents whether the text is easy for readers with elementary, middle school, and high school education according to the Gulpease index.
+type GulpeaseResult struct {
+	Score            uint `json:"score"`
+	EasyElementary   bool `json:"easy_elementary"`
+	EasyMiddleSchool bool `json:"easy_middle_school"`
+	EasyHighSchool   bool `json:"easy_high_school"`
+}
+
+// Description returns the least educated readers the text is easy for.
+func (r GulpeaseResult) Description() string {
+	switch {
+	case r.EasyElementary:
+		return "Easy for readers with elementary education"
+	case r.EasyMiddleSchool:
+		return "Easy for readers with middle school education"
+	case r.EasyHighSchool:
+		return "Easy for readers with high school education"
+	}
+	return "Difficult for readers with high school education"
+}
+
+// ====== Functions ======
+
 // CalcGulpease accepts a non-empty string and returns the Gulpease index formula for it. The string must contain at least one word (a number is considered a word, for example `18.` is valid string) and at least one sentence.
-// The calculated result is rounded to the nearest whole number.
-func CalcGulpease(s string) (uint, error) {
+// The calculated result is rounded to the nearest whole number, and a negative result (of a text with very long words) is 0.
+func CalcGulpease(s string, opts ...stats.Option) (uint, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcGulpeaseFromStats(stats.CountAllStats(s, opts...))
+}
+
+// CalcGulpeaseRaw accepts a non-empty string and options and returns the Gulpease index for it without rounding. See `CalcGulpease`.
+func CalcGulpeaseRaw(s string, opts ...stats.Option) (float64, error) {
 	if len(s) == 0 {
 		return 0, errors.New("Empty string.")
 	}
+	return gulpeaseRaw(stats.CountAllStats(s, opts...))
+}
+
+// CalcGulpeaseDoc accepts a Document and returns the Gulpease index for it. See `CalcGulpease`.
+func CalcGulpeaseDoc(doc *stats.Document) (uint, error) {
+	return CalcGulpeaseFromStats(doc.Stats())
+}
+
+// CalcGulpeaseFromStats accepts the statistics of a text and returns the Gulpease index for it. See `CalcGulpease`.
+func CalcGulpeaseFromStats(st stats.TotalStats) (uint, error) {
+	raw_index_gulpease, err := gulpeaseRaw(st)
+	if err != nil {
+		return 0, err
+	}
+	if raw_index_gulpease < 0 {
+		return 0, nil
+	}
+	return uint(math.Round(raw_index_gulpease)), nil
+}
 
-	words := float64(stats.CountWords(s))
+// gulpeaseRaw returns the Gulpease index without rounding.
+func gulpeaseRaw(st stats.TotalStats) (float64, error) {
+	words := float64(st.Words)
 	if words == 0 {
 		return 0, errors.New("No words were parsed. Cannot calculate Gulpease readability index.")
 	}
 
-	characters := float64(stats.CountCharacters(s))
-	sentences := float64(stats.CountSentences(s))
+	characters := float64(st.Characters)
+	sentences := float64(st.Sentences)
 
 	raw_index_gulpease := 89 + ((300*sentences - 10*characters) / words)
-	gulpease_index := uint(math.Round(raw_index_gulpease))
-	return gulpease_index, nil
+	return raw_index_gulpease, nil
+}
+
+// CalcGulpeaseResult accepts a Gulpease index and returns the GulpeaseResult structure for it.
+// A text is difficult for readers with elementary education below 80, with middle school education below 60, and with high school education below 40.
+func CalcGulpeaseResult(score uint) GulpeaseResult {
+	return GulpeaseResult{
+		Score:            score,
+		EasyElementary:   score >= GULPEASE_ELEMENTARY_THRESHOLD,
+		EasyMiddleSchool: score >= GULPEASE_MIDDLE_SCHOOL_THRESHOLD,
+		EasyHighSchool:   score >= GULPEASE_HIGH_SCHOOL_THRESHOLD,
+	}
 }
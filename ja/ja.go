@@ -0,0 +1,173 @@
+// Package `ja` provides functions and types to calculate the readability for texts in Japanese language.
+// 1. Tateishi readability score, based on the lengths of character-type runs
+//
+// Japanese is written without spaces between words, so the space-based counters of `stats` package cannot be used.
+// Instead, the text is split into sentences by `。`, `！`, `？` and into runs (tokens) of the same script: hiragana, katakana, kanji, and alphabet.
+package ja
+
+import (
+	"errors"
+	"goreadability/internal/cjk"
+	"goreadability/stats"
+	"math"
+	"unicode"
+)
+
+// terminators are the runes that end a Japanese sentence.
+const terminators = "。！？!?"
+
+// ====== Types ======
+
+// scriptType represents the script of a Japanese character.
+type scriptType int
+
+const (
+	otherScript scriptType = iota
+	hiraganaScript
+	katakanaScript
+	kanjiScript
+	alphabetScript
+)
+
+// RunStats represents the number and the total length (in characters) of the runs of one script.
+type RunStats struct {
+	Runs       uint `json:"runs"`
+	Characters uint `json:"characters"`
+}
+
+// AverageLength returns the average length of a run, or 0 if there are no runs.
+func (r RunStats) AverageLength() float64 {
+	if r.Runs == 0 {
+		return 0
+	}
+	return float64(r.Characters) / float64(r.Runs)
+}
+
+// TextStats represents the statistics of a Japanese text required by the Tateishi formula.
+type TextStats struct {
+	Sentences  uint     `json:"sentences"`
+	Characters uint     `json:"characters"`
+	Touten     uint     `json:"touten"`
+	Kuten      uint     `json:"kuten"`
+	Hiragana   RunStats `json:"hiragana"`
+	Katakana   RunStats `json:"katakana"`
+	Kanji      RunStats `json:"kanji"`
+	Alphabet   RunStats `json:"alphabet"`
+}
+
+// ====== Functions ======
+
+// CalcTateishi accepts a non-empty string and options and returns the Tateishi readability score for it. The string must contain at least one sentence.
+// The formula is -0.12 * ls - 1.37 * la + 7.4 * lh - 23.18 * lc - 5.4 * lk - 4.67 * cp + 115.79, where
+// ls is the average sentence length in characters, la, lh, lc, lk are the average lengths of alphabet, hiragana, kanji, and katakana runs,
+// and cp is the ratio of touten (`、`) to kuten (`。`).
+// Higher score means easier text: long hiragana runs are typical for simple texts, and long kanji and katakana compounds for difficult ones.
+// The calculated score is rounded to the first decimal point.
+func CalcTateishi(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcTateishiDoc(stats.NewDocument(s, opts...))
+}
+
+// CalcTateishiRaw accepts a non-empty string and options and returns the Tateishi readability score for it without rounding. See `CalcTateishi`.
+func CalcTateishiRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return tateishiRaw(stats.NewDocument(s, opts...))
+}
+
+// CalcTateishiDoc accepts a Document and returns the Tateishi readability score for it. See `CalcTateishi`.
+// Only the text of the document is used, as its space-based statistics do not apply to Japanese.
+func CalcTateishiDoc(doc *stats.Document) (float64, error) {
+	score, err := tateishiRaw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(score*10) / 10, nil
+}
+
+// tateishiRaw returns the Tateishi readability score without rounding.
+func tateishiRaw(doc *stats.Document) (float64, error) {
+	textStats := CountStats(doc.Text())
+	if textStats.Sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate Tateishi readability score.")
+	}
+
+	ls := float64(textStats.Characters) / float64(textStats.Sentences)
+	var cp float64
+	if textStats.Kuten > 0 {
+		cp = float64(textStats.Touten) / float64(textStats.Kuten)
+	}
+
+	score := -0.12*ls - 1.37*textStats.Alphabet.AverageLength() + 7.4*textStats.Hiragana.AverageLength() -
+		23.18*textStats.Kanji.AverageLength() - 5.4*textStats.Katakana.AverageLength() - 4.67*cp + 115.79
+	return score, nil
+}
+
+// CountStats accepts a string and returns its sentence, punctuation, and character-type run statistics.
+// Whitespaces are not counted as characters.
+func CountStats(s string) TextStats {
+	var result TextStats
+	result.Sentences = uint(len(SplitSentences(s)))
+
+	prevScript := otherScript
+	for _, char := range s {
+		if unicode.IsSpace(char) {
+			prevScript = otherScript
+			continue
+		}
+		result.Characters++
+
+		switch char {
+		case '、', '，':
+			result.Touten++
+		case '。':
+			result.Kuten++
+		}
+
+		script := classify(char)
+		var run *RunStats
+		switch script {
+		case hiraganaScript:
+			run = &result.Hiragana
+		case katakanaScript:
+			run = &result.Katakana
+		case kanjiScript:
+			run = &result.Kanji
+		case alphabetScript:
+			run = &result.Alphabet
+		}
+		if run != nil {
+			if script != prevScript {
+				run.Runs++
+			}
+			run.Characters++
+		}
+		prevScript = script
+	}
+	return result
+}
+
+// SplitSentences accepts a string and splits it into sentences ended with `。`, `！`, `？` (or their half-width forms `!`, `?`).
+// Closing brackets and quotes right after a terminator (`。」`) belong to the sentence they close.
+// A trailing text without a terminator is returned as the last sentence. Empty sentences are skipped.
+func SplitSentences(s string) []string {
+	return cjk.SplitSentences(s, terminators)
+}
+
+// classify returns the script of a character.
+func classify(char rune) scriptType {
+	switch {
+	case unicode.Is(unicode.Hiragana, char):
+		return hiraganaScript
+	case unicode.Is(unicode.Katakana, char) || char == 'ー':
+		return katakanaScript
+	case unicode.Is(unicode.Han, char) || char == '々':
+		return kanjiScript
+	case unicode.IsLetter(char) || unicode.IsDigit(char):
+		return alphabetScript
+	}
+	return otherScript
+}
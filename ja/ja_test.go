@@ -0,0 +1,75 @@
+package ja_test
+
+import (
+	"goreadability/ja"
+	"goreadability/stats"
+	"slices"
+	"testing"
+)
+
+func TestCountStats(t *testing.T) {
+	tests := []struct {
+		text string
+		want ja.TextStats
+	}{
+		{"ひらがなです。", ja.TextStats{Sentences: 1, Characters: 7, Kuten: 1, Hiragana: ja.RunStats{Runs: 1, Characters: 6}}},
+		// A run ends where the script changes, at a punctuation mark, and at a whitespace.
+		{"カタカナとひらがな、漢字とromaji。", ja.TextStats{
+			Sentences: 1, Characters: 20, Touten: 1, Kuten: 1,
+			Hiragana: ja.RunStats{Runs: 2, Characters: 6}, Katakana: ja.RunStats{Runs: 1, Characters: 4},
+			Kanji: ja.RunStats{Runs: 1, Characters: 2}, Alphabet: ja.RunStats{Runs: 1, Characters: 6},
+		}},
+		{"abc def。", ja.TextStats{Sentences: 1, Characters: 7, Kuten: 1, Alphabet: ja.RunStats{Runs: 2, Characters: 6}}},
+		// The prolonged sound mark belongs to katakana, and the iteration mark to kanji.
+		{"コーヒー", ja.TextStats{Sentences: 1, Characters: 4, Katakana: ja.RunStats{Runs: 1, Characters: 4}}},
+		{"人々！", ja.TextStats{Sentences: 1, Characters: 3, Kanji: ja.RunStats{Runs: 1, Characters: 2}}},
+		{"", ja.TextStats{}},
+	}
+	for _, tt := range tests {
+		if got := ja.CountStats(tt.text); got != tt.want {
+			t.Errorf("CountStats(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestSplitSentences(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"はい。いいえ！本当？", []string{"はい。", "いいえ！", "本当？"}},
+		{"「行こう。」と言った。", []string{"「行こう。」", "と言った。"}},
+		{"Yes! 終わり", []string{"Yes!", "終わり"}},
+	}
+	for _, tt := range tests {
+		if got := ja.SplitSentences(tt.text); !slices.Equal(got, tt.want) {
+			t.Errorf("SplitSentences(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCalcTateishi(t *testing.T) {
+	tests := []struct {
+		text string
+		opts []stats.Option
+		want float64
+	}{
+		// ls = 7 and lh = 6: -0.12 * 7 + 7.4 * 6 + 115.79 = 159.4.
+		{"ひらがなです。", nil, 159.4},
+		// ls = 20, la = 6, lh = 3, lc = 2, lk = 4, and cp = 1: -2.4 - 8.22 + 22.2 - 46.36 - 21.6 - 4.67 + 115.79 = 54.7.
+		{"カタカナとひらがな、漢字とromaji。", nil, 54.7},
+		// The options are applied to the text: without the footnote marker, ls = 7, lh = 1.5, and lc = 1.5.
+		{"東京は大きい[1]。", nil, 89.6},
+		{"東京は大きい[1]。", []stats.Option{stats.WithoutCitations()}, 91.3},
+	}
+	for _, tt := range tests {
+		if got, err := ja.CalcTateishi(tt.text, tt.opts...); err != nil || got != tt.want {
+			t.Errorf("CalcTateishi(%q) = %v, %v, want %v", tt.text, got, err, tt.want)
+		}
+	}
+	for _, text := range []string{"", " "} {
+		if _, err := ja.CalcTateishi(text); err == nil {
+			t.Errorf("CalcTateishi(%q) returned no error", text)
+		}
+	}
+}
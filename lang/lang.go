@@ -0,0 +1,145 @@
+// Package `lang` provides functions to detect the language of texts, so the readability formulas of the language can be chosen automatically.
+//
+// The script of the letters decides between Latin-script languages, Russian, Arabic, Japanese, and Chinese.
+// Latin-script languages (English, Italian, Spanish, French, German, and Dutch) are told apart by their most frequent function words and distinctive letters.
+package lang
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// ISO 639-1 codes of the supported languages.
+const (
+	English  = "en"
+	Italian  = "it"
+	Spanish  = "es"
+	French   = "fr"
+	German   = "de"
+	Dutch    = "nl"
+	Russian  = "ru"
+	Arabic   = "ar"
+	Japanese = "ja"
+	Chinese  = "zh"
+)
+
+// ====== Types ======
+
+// Scripts counts the letters of a text by script.
+type scripts struct {
+	latin, cyrillic, arabic, kana, han uint
+}
+
+// stopWords maps the Latin-script languages to their most frequent function words.
+var stopWords = map[string]map[string]bool{
+	English: toSet("the", "and", "of", "to", "is", "in", "that", "it", "was", "for", "with", "as", "on", "are", "this", "be", "by", "have", "not", "you", "they", "he", "she", "from", "or", "which", "at"),
+	Italian: toSet("il", "di", "che", "e", "la", "per", "un", "non", "sono", "una", "del", "della", "gli", "le", "nel", "con", "si", "lo", "anche", "più", "ma", "come", "questo", "dei", "alla", "è"),
+	Spanish: toSet("el", "de", "que", "y", "la", "los", "las", "en", "un", "una", "por", "con", "para", "es", "del", "se", "no", "al", "lo", "como", "más", "pero", "su", "está", "muy", "también"),
+	French:  toSet("le", "la", "les", "de", "des", "et", "est", "un", "une", "du", "que", "qui", "dans", "pour", "pas", "sur", "au", "avec", "ce", "il", "elle", "nous", "vous", "sont", "mais", "ou"),
+	German:  toSet("der", "die", "das", "und", "ist", "nicht", "ein", "eine", "zu", "den", "von", "mit", "sich", "des", "auf", "für", "im", "dem", "auch", "es", "sie", "ich", "wir", "aber", "wird", "nach"),
+	Dutch:   toSet("de", "het", "een", "en", "van", "is", "dat", "niet", "op", "te", "zijn", "met", "voor", "er", "ook", "maar", "als", "bij", "wordt", "ze", "hij", "naar", "dit", "uit", "nog", "wij"),
+}
+
+// distinctiveLetters maps the Latin-script languages to the letters that are frequent in them and rare in the others.
+var distinctiveLetters = map[string]string{
+	Italian: "ìòù",
+	Spanish: "ñ¿¡á",
+	French:  "çêèâœë",
+	German:  "ßäöü",
+}
+
+// latinLanguages lists the Latin-script languages in the order of preference for ties.
+var latinLanguages = []string{English, Italian, Spanish, French, German, Dutch}
+
+// ====== Functions ======
+
+// Detect accepts a non-empty string and returns the ISO 639-1 code of its language ("en", "it", "es", "fr", "de", "nl", "ru", "ar", "ja", or "zh").
+// Returns an error if the string has no letters or its language cannot be told.
+func Detect(s string) (string, error) {
+	if len(s) == 0 {
+		return "", errors.New("Empty string.")
+	}
+
+	counts := countScripts(s)
+	switch {
+	case counts.latin+counts.cyrillic+counts.arabic+counts.kana+counts.han == 0:
+		return "", errors.New("No letters were found. Cannot detect language.")
+	case counts.kana > 0 && counts.kana+counts.han >= counts.latin:
+		return Japanese, nil
+	case counts.han > counts.latin && counts.han >= counts.cyrillic && counts.han >= counts.arabic:
+		return Chinese, nil
+	case counts.cyrillic > counts.latin && counts.cyrillic >= counts.arabic:
+		return Russian, nil
+	case counts.arabic > counts.latin:
+		return Arabic, nil
+	}
+	return detectLatin(s)
+}
+
+// Supported returns the ISO 639-1 codes of the languages `Detect` can return.
+func Supported() []string {
+	return []string{English, Italian, Spanish, French, German, Dutch, Russian, Arabic, Japanese, Chinese}
+}
+
+// detectLatin returns the Latin-script language with the most function words and distinctive letters in the string.
+func detectLatin(s string) (string, error) {
+	lower := strings.ToLower(s)
+	scores := map[string]float64{}
+	for _, word := range strings.FieldsFunc(lower, func(c rune) bool {
+		return !unicode.IsLetter(c) && c != '\''
+	}) {
+		for language, words := range stopWords {
+			if words[word] {
+				scores[language]++
+			}
+		}
+	}
+	for language, letters := range distinctiveLetters {
+		for _, char := range lower {
+			if strings.ContainsRune(letters, char) {
+				scores[language] += 0.5
+			}
+		}
+	}
+
+	best, bestScore := "", 0.0
+	for _, language := range latinLanguages {
+		if scores[language] > bestScore {
+			best, bestScore = language, scores[language]
+		}
+	}
+	if best == "" {
+		return "", errors.New("No function words were found. Cannot detect language.")
+	}
+	return best, nil
+}
+
+// countScripts returns the number of letters of each script in the string.
+func countScripts(s string) scripts {
+	var counts scripts
+	for _, char := range s {
+		switch {
+		case unicode.Is(unicode.Hiragana, char), unicode.Is(unicode.Katakana, char):
+			counts.kana++
+		case unicode.Is(unicode.Han, char):
+			counts.han++
+		case unicode.Is(unicode.Cyrillic, char):
+			counts.cyrillic++
+		case unicode.Is(unicode.Arabic, char) && unicode.IsLetter(char):
+			counts.arabic++
+		case unicode.Is(unicode.Latin, char):
+			counts.latin++
+		}
+	}
+	return counts
+}
+
+// toSet returns the words as a set.
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
@@ -0,0 +1,110 @@
+// Package `nl` provides functions and types to calculate the readability for texts in Dutch language.
+// 1. Douma adaptation of Flesch reading ease score
+package nl
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+	"strings"
+)
+
+// CalcFleschDouma accepts a non-empty string and returns the Douma adaptation of the Flesch reading ease score for Dutch texts. The string must contain at least one word (a number is considered a word, for example `18.` is a valid string) and at least one sentence.
+// The formula is 206.84 - 0.77 * (syllables per 100 words) - 0.93 * (words / sentences).
+// The calculated score is rounded to the first decimal point.
+func CalcFleschDouma(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcFleschDoumaDoc(newDocument(s, opts))
+}
+
+// CalcFleschDoumaRaw accepts a non-empty string and options and returns the Flesch–Douma readability score for it without rounding. See `CalcFleschDouma`.
+func CalcFleschDoumaRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return fleschDoumaRaw(newDocument(s, opts))
+}
+
+// CalcFleschDoumaDoc accepts a Document and returns the Flesch–Douma readability score for it. See `CalcFleschDouma`.
+func CalcFleschDoumaDoc(doc *stats.Document) (float64, error) {
+	score, err := fleschDoumaRaw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(score*10) / 10, nil
+}
+
+// fleschDoumaRaw returns the Flesch–Douma readability score without rounding.
+func fleschDoumaRaw(doc *stats.Document) (float64, error) {
+	words := float64(doc.Words())
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate Flesch–Douma readability score.")
+	}
+	sentences := float64(doc.Sentences())
+	if sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate Flesch–Douma readability score.")
+	}
+	syllables := float64(doc.Syllables())
+
+	syllablesPer100 := syllables / words * 100
+	score := 206.84 - 0.77*syllablesPer100 - 0.93*(words/sentences)
+	return score, nil
+}
+
+// CountSyllables accepts a string that represents a Dutch word and returns the number of syllables in it.
+// Every group of adjacent vowels ("aa", "oe", "eeu", "ui") is counted as one syllable. The digraph "ij" is treated as a vowel, and a vowel with a diaeresis ("ë", "ï") always starts a new syllable ("België", "ideeën").
+// A hyphen ends a group of vowels, so the parts of a hyphenated compound ("zee-eend") are syllabified separately.
+// A word without vowels (for example, a number) counts as one syllable.
+func CountSyllables(s string) uint {
+	word := []rune(strings.ToLower(s))
+
+	var syllables uint
+	prevIsVowel := false
+	for i := 0; i < len(word); i++ {
+		char := word[i]
+		isDigraph := char == 'i' && i+1 < len(word) && word[i+1] == 'j'
+		if isDigraph {
+			i++
+		}
+
+		switch {
+		case isDiaeresis(char):
+			syllables++
+			prevIsVowel = true
+		case isDigraph || isVowel(char):
+			if !prevIsVowel {
+				syllables++
+			}
+			prevIsVowel = true
+		default:
+			prevIsVowel = false
+		}
+	}
+
+	if syllables == 0 {
+		syllables++
+	}
+	return syllables
+}
+
+// isVowel reports whether the rune is a Dutch vowel.
+func isVowel(char rune) bool {
+	return strings.ContainsRune("aeiouyáéíóúàèâêîôû", char)
+}
+
+// isDiaeresis reports whether the rune is a vowel with a diaeresis (trema), which marks a syllable break.
+func isDiaeresis(char rune) bool {
+	return strings.ContainsRune("äëïöü", char)
+}
+
+// init registers `CountSyllables` as the syllable counter of Dutch texts (see `stats.RegisterSyllableCounter`).
+func init() {
+	stats.RegisterSyllableCounter("nl", stats.SyllableCounterFunc(CountSyllables))
+}
+
+// newDocument returns a Document for a Dutch text, with the given options applied over the language defaults.
+func newDocument(s string, opts []stats.Option) *stats.Document {
+	return stats.NewDocument(s, append([]stats.Option{stats.WithLanguage("nl")}, opts...)...)
+}
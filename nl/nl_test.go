@@ -0,0 +1,56 @@
+package nl_test
+
+import (
+	"goreadability/nl"
+	"testing"
+)
+
+func TestCountSyllables(t *testing.T) {
+	tests := []struct {
+		word string
+		want uint
+	}{
+		{"kat", 1},
+		{"ijs", 1}, // "ij" is a vowel
+		{"rijden", 2},
+		{"boek", 1}, // "oe", "ui", and "eeuw" are one vowel each
+		{"moeder", 2},
+		{"huis", 1},
+		{"buiten", 2},
+		{"eeuw", 1},
+		{"leeuwen", 2},
+		{"ziekenhuis", 3},
+		{"België", 3}, // a diaeresis starts a new syllable
+		{"ideeën", 3},
+		{"zee-eend", 2}, // the parts of a hyphenated compound are syllabified separately
+		{"Noord-Holland", 3},
+		{"2024", 1},
+	}
+	for _, tt := range tests {
+		if got := nl.CountSyllables(tt.word); got != tt.want {
+			t.Errorf("CountSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestCalcFleschDouma(t *testing.T) {
+	tests := []struct {
+		text string
+		want float64
+	}{
+		// 10 words, 2 sentences, and 10 syllables: 206.84 - 0.77 * 100 - 0.93 * 5 = 125.2.
+		{"De kat zit op de mat. Het huis is groot.", 125.2},
+		// 6 words, 1 sentence, and 9 syllables: 206.84 - 0.77 * 150 - 0.93 * 6 = 85.8.
+		{"Mijn moeder werkt in het ziekenhuis.", 85.8},
+	}
+	for _, tt := range tests {
+		if got, err := nl.CalcFleschDouma(tt.text); err != nil || got != tt.want {
+			t.Errorf("CalcFleschDouma(%q) = %v, %v, want %v", tt.text, got, err, tt.want)
+		}
+	}
+	for _, text := range []string{"", "Hallo"} {
+		if _, err := nl.CalcFleschDouma(text); err == nil {
+			t.Errorf("CalcFleschDouma(%q) returned no error", text)
+		}
+	}
+}
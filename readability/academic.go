@@ -0,0 +1,160 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// PaperReport represents the reports of the abstract and the body of a scholarly text. Abstract is nil if the text has no abstract.
+// The body is the text after the abstract, up to the list of references.
+type PaperReport struct {
+	Abstract *PaperSection `json:"abstract,omitempty"`
+	Body     PaperSection  `json:"body"`
+}
+
+// PaperSection represents the Report of a section of a scholarly text with the densities of its hedging words (see `HedgeWords`) and its nominalizations
+// (see `DetectNominalizations`), the two habits that make academic prose vague and abstract. The positions of the words are in the text of the section.
+type PaperSection struct {
+	Report
+	Hedges          WordDensity `json:"hedges"`
+	Nominalizations WordDensity `json:"nominalizations"`
+}
+
+// abstractEnds are the starts of the lines that end an abstract, in lower case.
+var abstractEnds = []string{"keywords", "key words", "index terms", "jel classification"}
+
+// referenceHeadings are the headings of the lists of references, in lower case.
+var referenceHeadings = map[string]bool{"references": true, "bibliography": true, "works cited": true, "literature cited": true, "reference list": true}
+
+// ====== Methods ======
+
+// AnalyzePaper accepts a non-empty scholarly text and options and returns the reports of its abstract and of its body, counted with `stats.WithAcademicProfile`.
+// The abstract starts after an "Abstract" heading (on a line of its own or separated from the text, "Abstract: We study"), and it ends with the keywords ("Keywords: ...")
+// or with the next heading, a short line without a terminator after a blank line ("1 Introduction"). The text before the abstract (the title and the authors)
+// is not analyzed, and the body ends with the heading of the references ("References", "Bibliography"). Without an abstract, the whole text is the body.
+// Each section is reported with its hedging words and nominalizations (see `PaperSection`).
+func (a *Analyzer) AnalyzePaper(s string, opts ...stats.Option) (PaperReport, error) {
+	if len(s) == 0 {
+		return PaperReport{}, errors.New("Empty string.")
+	}
+	opts = append([]stats.Option{stats.WithAcademicProfile()}, opts...)
+
+	abstract, body, found := splitAbstract(s)
+	var paper PaperReport
+	if found && strings.TrimSpace(abstract) != "" {
+		section, err := a.analyzePaperSection(abstract, opts)
+		if err != nil {
+			return PaperReport{}, err
+		}
+		paper.Abstract = &section
+	}
+	if strings.TrimSpace(body) == "" {
+		return PaperReport{}, errors.New("No body after the abstract. Cannot analyze the paper.")
+	}
+	var err error
+	if paper.Body, err = a.analyzePaperSection(body, opts); err != nil {
+		return PaperReport{}, err
+	}
+	return paper, nil
+}
+
+// analyzePaperSection returns the PaperSection of a section of a scholarly text.
+func (a *Analyzer) analyzePaperSection(s string, opts []stats.Option) (PaperSection, error) {
+	report, err := a.Analyze(s, opts...)
+	if err != nil {
+		return PaperSection{}, err
+	}
+	nominalizations, err := DetectNominalizations(s, opts...)
+	if err != nil {
+		return PaperSection{}, err
+	}
+	words := stats.Words(s, opts...)
+	nouns := make([]stats.Token, len(nominalizations))
+	for i, nominalization := range nominalizations {
+		nouns[i] = nominalization.Token
+	}
+	return PaperSection{
+		Report:          report,
+		Hedges:          newWordDensity(matchPhrases(s, words, HedgeWords), uint(len(words))),
+		Nominalizations: newWordDensity(nouns, uint(len(words))),
+	}, nil
+}
+
+// ====== Functions ======
+
+// splitAbstract returns the abstract and the body of the scholarly text, and whether the text has an abstract. See `Analyzer.AnalyzePaper`.
+func splitAbstract(s string) (abstract, body string, found bool) {
+	lines := strings.SplitAfter(s, "\n")
+	start := -1
+	for i, line := range lines {
+		heading := strings.ToLower(strings.Trim(strings.TrimSpace(line), "#*_ "))
+		rest, ok := strings.CutPrefix(heading, "abstract")
+		separator, _ := utf8.DecodeRuneInString(strings.TrimSpace(rest))
+		if ok && (rest == "" || strings.ContainsRune(":.—–-", separator)) {
+			text := strings.TrimLeft(strings.TrimSpace(line), "#*_ ")
+			lines[i] = strings.TrimLeft(text[len("abstract"):], ":.—–-*_ ") + "\n"
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return "", cutReferences(s), false
+	}
+
+	end := len(lines)
+	for i := start + 1; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		lower := strings.ToLower(line)
+		if startsWithAny(lower, abstractEnds) || (isHeadingLine(line) && strings.TrimSpace(lines[i-1]) == "" && strings.TrimSpace(strings.Join(lines[start:i], "")) != "") {
+			end = i
+			break
+		}
+	}
+	body = strings.Join(lines[end:], "")
+	if end < len(lines) && startsWithAny(strings.ToLower(strings.TrimSpace(lines[end])), abstractEnds) {
+		// The keywords are neither the abstract nor the body.
+		body = strings.Join(lines[end+1:], "")
+	}
+	return strings.Join(lines[start:end], ""), cutReferences(body), true
+}
+
+// cutReferences returns the text up to the heading of its list of references.
+func cutReferences(s string) string {
+	offset := 0
+	for _, line := range strings.SplitAfter(s, "\n") {
+		heading := strings.ToLower(strings.Trim(strings.TrimSpace(line), "#*_:. "))
+		heading = strings.TrimLeftFunc(heading, func(c rune) bool { return unicode.IsDigit(c) || c == '.' || c == ' ' })
+		if referenceHeadings[heading] {
+			return s[:offset]
+		}
+		offset += len(line)
+	}
+	return s
+}
+
+// isHeadingLine reports whether the trimmed line looks like a heading: a non-empty line of up to eight words that starts with a capital letter or a number
+// and doesn't end with a punctuation mark.
+func isHeadingLine(line string) bool {
+	line = strings.Trim(line, "#*_ ")
+	if line == "" || len(strings.Fields(line)) > 8 {
+		return false
+	}
+	first, _ := utf8.DecodeRuneInString(line)
+	last, _ := utf8.DecodeLastRuneInString(line)
+	return (unicode.IsUpper(first) || unicode.IsDigit(first)) && !strings.ContainsRune(".!?:;,", last)
+}
+
+// startsWithAny reports whether the string starts with any of the prefixes.
+func startsWithAny(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"goreadability/stats"
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzePaper(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		abstract string
+		body     string
+	}{
+		{
+			"keywords and references",
+			"On Cats\nJ. Smith\n\nAbstract\nWe study cats. The results suggest a correlation.\nKeywords: cats, dogs\n\n1 Introduction\nThe implementation of the evaluation is simple. Cats sleep.\n\nReferences\nSmith, J. Cats. 2020.",
+			"We study cats. The results suggest a correlation.\n",
+			"\n1 Introduction\nThe implementation of the evaluation is simple. Cats sleep.\n\n",
+		},
+		{
+			"inline abstract and heading",
+			"**Abstract:** Cats might sleep. It seems so.\n\nMethods\nWe watched the cats.\n",
+			"Cats might sleep. It seems so.\n\n",
+			"Methods\nWe watched the cats.\n",
+		},
+		{
+			"no abstract",
+			"Cats sleep a lot. Dogs do too.\n\n## Bibliography\nJones, A. Dogs.",
+			"",
+			"Cats sleep a lot. Dogs do too.\n\n",
+		},
+	}
+	opts := []stats.Option{stats.WithAcademicProfile()}
+	for _, test := range tests {
+		got, err := readability.NewAnalyzer().AnalyzePaper(test.text)
+		if err != nil {
+			t.Errorf("AnalyzePaper() %s returned error: %v", test.name, err)
+			continue
+		}
+		if test.abstract == "" {
+			if got.Abstract != nil {
+				t.Errorf("AnalyzePaper() %s abstract = %+v, want nil", test.name, got.Abstract.Report)
+			}
+		} else if want, _ := readability.NewAnalyzer().Analyze(test.abstract, opts...); got.Abstract == nil || !reflect.DeepEqual(got.Abstract.Report, want) {
+			t.Errorf("AnalyzePaper() %s abstract = %+v, want the Report of %q", test.name, got.Abstract, test.abstract)
+		}
+		if want, _ := readability.NewAnalyzer().Analyze(test.body, opts...); !reflect.DeepEqual(got.Body.Report, want) {
+			t.Errorf("AnalyzePaper() %s body = %+v, want the Report of %q", test.name, got.Body.Report, test.body)
+		}
+	}
+
+	for _, text := range []string{"", "Abstract\nOnly an abstract.\n", "Abstract\nOnly an abstract.\n\nReferences\nSmith, J."} {
+		if _, err := readability.NewAnalyzer().AnalyzePaper(text); err == nil {
+			t.Errorf("AnalyzePaper(%q) returned no error", text)
+		}
+	}
+}
+
+func TestAnalyzePaperDensities(t *testing.T) {
+	text := "Abstract\nWe study cats. The results suggest a correlation.\nKeywords: cats\n\n1 Introduction\nThe implementation of the evaluation is simple. Perhaps cats might sleep.\n"
+	got, err := readability.NewAnalyzer().AnalyzePaper(text)
+	if err != nil {
+		t.Fatalf("AnalyzePaper() returned error: %v", err)
+	}
+	tests := []struct {
+		name    string
+		density readability.WordDensity
+		want    []string
+		per100  float64
+	}{
+		// The abstract has 8 words and the body has 13, with the heading "1 Introduction".
+		{"abstract hedges", got.Abstract.Hedges, []string{"suggest"}, 12.5},
+		{"abstract nominalizations", got.Abstract.Nominalizations, []string{"correlation"}, 12.5},
+		{"body hedges", got.Body.Hedges, []string{"Perhaps", "might"}, 15.4},
+		{"body nominalizations", got.Body.Nominalizations, []string{"Introduction", "implementation", "evaluation"}, 23.1},
+	}
+	for _, test := range tests {
+		var words []string
+		for _, word := range test.density.Words {
+			words = append(words, word.Text)
+		}
+		if !reflect.DeepEqual(words, test.want) || test.density.Count != uint(len(test.want)) || test.density.Per100Words != test.per100 {
+			t.Errorf("AnalyzePaper() %s = %v (%d, %v per 100 words), want %v (%v per 100 words)", test.name, words, test.density.Count, test.density.Per100Words, test.want, test.per100)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"strings"
+	"unicode"
+)
+
+// ====== Types ======
+
+// Acronym represents an acronym of a text with its uses and expansion.
+// Unexpanded is the uses before the acronym is expanded, or all uses if it is never expanded. Plain-language guidelines require an acronym to be expanded on its first use.
+type Acronym struct {
+	Acronym    string        `json:"acronym"`
+	Count      uint          `json:"count"`
+	Expansion  string        `json:"expansion"`
+	Uses       []stats.Token `json:"uses"`
+	Unexpanded []stats.Token `json:"unexpanded"`
+}
+
+// minorWords are the English words that acronyms usually skip ("DoD", "Department of Defense").
+var minorWords = map[string]bool{
+	"a": true, "an": true, "the": true, "of": true, "and": true, "for": true, "to": true, "in": true, "on": true, "at": true, "by": true, "with": true, "or": true,
+}
+
+// acronymSuffixes are the possessive and plural endings of acronyms ("NASA's", "APIs"), which are not part of them.
+var acronymSuffixes = []string{"'s", "’s", "s"}
+
+// ====== Methods ======
+
+// Flagged reports whether the acronym is used before it is expanded or is never expanded.
+func (a Acronym) Flagged() bool {
+	return len(a.Unexpanded) > 0
+}
+
+// ====== Functions ======
+
+// DetectAcronyms accepts a non-empty string and options and returns the acronyms in it in the order of their first uses.
+// An acronym is a word with at least two capital letters and fewer lower case ones ("NASA", "R&D", "MP3", "DoD"), and a plural or possessive "s" is ignored ("APIs", "NASA's").
+// Roman numerals ("II", "XIV") are not acronyms.
+// An acronym is expanded where it is followed by its expansion in parentheses ("TLA (three letter acronym)") or put in parentheses after it ("three letter acronym (TLA)").
+// The initials of the expansion must spell the acronym, with the minor words ("of", "and", "the") and the parts of hyphenated words counted or skipped.
+func DetectAcronyms(s string, opts ...stats.Option) ([]Acronym, error) {
+	if len(s) == 0 {
+		return nil, errors.New("Empty string.")
+	}
+	words := stats.Words(s, opts...)
+	if len(words) == 0 {
+		return nil, errors.New("No words were parsed. Cannot detect acronyms.")
+	}
+
+	var acronyms []Acronym
+	indices := map[string]int{}
+	for _, word := range words {
+		use, ok := acronymUse(s, word)
+		if !ok {
+			continue
+		}
+		i, ok := indices[use.Text]
+		if !ok {
+			i = len(acronyms)
+			indices[use.Text] = i
+			acronyms = append(acronyms, Acronym{Acronym: use.Text})
+		}
+		a := &acronyms[i]
+		a.Count++
+		a.Uses = append(a.Uses, use)
+		if a.Expansion != "" {
+			continue
+		}
+		if a.Expansion = acronymExpansion(s, use); a.Expansion == "" {
+			a.Unexpanded = append(a.Unexpanded, use)
+		}
+	}
+	return acronyms, nil
+}
+
+// acronymUse returns the acronym in the word, without the punctuation around it and the plural or possessive "s", and false if the word is not an acronym.
+func acronymUse(s string, word stats.Token) (stats.Token, bool) {
+	start := word.Start + strings.IndexFunc(word.Text, isLetterOrDigit)
+	end := word.Start + strings.LastIndexFunc(word.Text, isLetterOrDigit) + 1
+	if start < word.Start || end <= start {
+		return stats.Token{}, false
+	}
+	text := s[start:end]
+	for _, suffix := range acronymSuffixes {
+		if strings.HasSuffix(text, suffix) {
+			text = strings.TrimSuffix(text, suffix)
+			break
+		}
+	}
+	upper, lower := 0, 0
+	for _, char := range text {
+		if unicode.IsUpper(char) {
+			upper++
+		} else if unicode.IsLower(char) {
+			lower++
+		}
+	}
+	if upper < 2 || lower >= upper || isRomanNumeral(text) {
+		return stats.Token{}, false
+	}
+	return stats.Token{Text: text, Start: start, End: start + len(text)}, true
+}
+
+// acronymExpansion returns the expansion of the acronym at its use, or an empty string if the acronym is not expanded there.
+func acronymExpansion(s string, use stats.Token) string {
+	after := s[use.End:]
+	for _, suffix := range acronymSuffixes {
+		if strings.HasPrefix(after, suffix) {
+			after = after[len(suffix):]
+			break
+		}
+	}
+	if rest := strings.TrimLeftFunc(after, unicode.IsSpace); strings.HasPrefix(rest, "(") {
+		if end := strings.IndexByte(rest, ')'); end > 0 {
+			expansion := strings.TrimSpace(rest[1:end])
+			if spellsAcronym(strings.Fields(expansion), use.Text) {
+				return expansion
+			}
+		}
+	}
+
+	before := strings.TrimRightFunc(s[:use.Start], unicode.IsSpace)
+	if !strings.HasSuffix(before, "(") || !strings.HasPrefix(after, ")") {
+		return ""
+	}
+	fields := strings.Fields(before[:len(before)-1])
+	// An expansion has at most a word per letter, plus the minor words between them.
+	for n := 1; n <= len(fields) && n <= 2*len(use.Text); n++ {
+		if spellsAcronym(fields[len(fields)-n:], use.Text) {
+			return strings.TrimFunc(strings.Join(fields[len(fields)-n:], " "), isNotLetterOrDigit)
+		}
+	}
+	return ""
+}
+
+// spellsAcronym reports whether the initials of the words spell the acronym. The minor words and the parts of hyphenated words can be skipped.
+func spellsAcronym(words []string, acronym string) bool {
+	var parts []string
+	for _, word := range words {
+		for i, part := range strings.Split(strings.TrimFunc(word, isNotLetterOrDigit), "-") {
+			if part == "" {
+				continue
+			}
+			if i > 0 {
+				// The parts of a hyphenated word after the first one may be skipped: "E-mail", "three-letter".
+				part = "-" + part
+			}
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return false
+	}
+	return spellsLetters(parts, acronymLetters(acronym))
+}
+
+// spellsLetters reports whether the initials of the parts spell the lower case letters, skipping the minor words and the parts starting with "-".
+// The choices of skipping are tabled rather than tried one by one, as an expansion of many minor words has exponentially many of them.
+func spellsLetters(parts []string, letters []rune) bool {
+	// spells[i][j] reports whether parts[i:] spell letters[j:].
+	spells := make([][]bool, len(parts)+1)
+	for i := range spells {
+		spells[i] = make([]bool, len(letters)+1)
+	}
+	spells[len(parts)][len(letters)] = true
+	for i := len(parts) - 1; i >= 0; i-- {
+		part := strings.TrimPrefix(parts[i], "-")
+		initial := []rune(strings.ToLower(part))[0]
+		skippable := minorWords[strings.ToLower(part)] || strings.HasPrefix(parts[i], "-")
+		for j := range letters {
+			spells[i][j] = initial == letters[j] && spells[i+1][j+1] || skippable && spells[i+1][j]
+		}
+		spells[i][len(letters)] = skippable && spells[i+1][len(letters)]
+	}
+	return spells[0][0]
+}
+
+// acronymLetters returns the letters of the acronym in lower case.
+func acronymLetters(acronym string) []rune {
+	var letters []rune
+	for _, char := range acronym {
+		if unicode.IsLetter(char) {
+			letters = append(letters, unicode.ToLower(char))
+		}
+	}
+	return letters
+}
+
+// isLetterOrDigit reports whether the rune is a letter or a digit.
+func isLetterOrDigit(char rune) bool {
+	return unicode.IsLetter(char) || unicode.IsDigit(char)
+}
+
+// isNotLetterOrDigit reports whether the rune is neither a letter nor a digit.
+func isNotLetterOrDigit(char rune) bool {
+	return !isLetterOrDigit(char)
+}
+
+// isRomanNumeral reports whether the word is a Roman numeral of the common ones ("II", "IV", "XIV").
+func isRomanNumeral(word string) bool {
+	return strings.Trim(word, "IVX") == ""
+}
@@ -0,0 +1,96 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDetectAcronyms(t *testing.T) {
+	text := string(readTestdata(t, "sample.txt")) + "\nThe Department of Defense (DoD) and the DoD's R&D use APIs. See part II of the TLA (three-letter acronym) FAQ."
+	acronyms, err := readability.DetectAcronyms(text)
+	if err != nil {
+		t.Fatalf("DetectAcronyms() returned error: %v", err)
+	}
+	checkGolden(t, "acronyms", acronyms)
+
+	// The minor words of a long expansion can be skipped in many ways, and all of them are tried at once.
+	text = "AAAAAAAAAAAAAAAAAAAA (" + strings.Repeat("a ", 60) + "b)"
+	if acronyms, err := readability.DetectAcronyms(text); err != nil || len(acronyms) != 1 || !acronyms[0].Flagged() {
+		t.Errorf("DetectAcronyms(%q) = %+v, %v, want an unexpanded acronym", text, acronyms, err)
+	}
+
+	for _, text := range []string{"", "   "} {
+		if _, err := readability.DetectAcronyms(text); err == nil {
+			t.Errorf("DetectAcronyms(%q) returned no error", text)
+		}
+	}
+}
+
+func TestDetectAcronymsExpansions(t *testing.T) {
+	type acronym struct {
+		acronym    string
+		count      uint
+		expansion  string
+		unexpanded int
+	}
+	tests := []struct {
+		text string
+		want []acronym
+	}{
+		{"The Department of Defense (DoD) and the DoD's budget.", []acronym{{"DoD", 2, "Department of Defense", 0}}},
+		// The use before the expansion is flagged.
+		{"NASA launched. The National Aeronautics and Space Administration (NASA) said so.", []acronym{{"NASA", 2, "National Aeronautics and Space Administration", 1}}},
+		{"The TLA (three-letter acronym) is common.", []acronym{{"TLA", 1, "three-letter acronym", 0}}},
+		// The plurals are the uses of the acronym, and the Roman numerals are not acronyms.
+		{"Use the APIs and the API. Read part II.", []acronym{{"API", 2, "", 2}}},
+		{"R&D makes MP3 files.", []acronym{{"R&D", 1, "", 1}, {"MP3", 1, "", 1}}},
+		// The initials of the words in parentheses don't spell the acronym.
+		{"The WHO (a United Nations agency) met.", []acronym{{"WHO", 1, "", 1}}},
+		{"No acronyms here.", nil},
+	}
+	for _, test := range tests {
+		got, err := readability.DetectAcronyms(test.text)
+		if err != nil {
+			t.Errorf("DetectAcronyms(%q) returned error: %v", test.text, err)
+			continue
+		}
+		var acronyms []acronym
+		for _, a := range got {
+			acronyms = append(acronyms, acronym{a.Acronym, a.Count, a.Expansion, len(a.Unexpanded)})
+		}
+		if !slices.Equal(acronyms, test.want) {
+			t.Errorf("DetectAcronyms(%q) = %+v, want %+v", test.text, acronyms, test.want)
+		}
+	}
+}
+
+func FuzzDetectAcronyms(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")))
+	f.Add("The Department of Defense (DoD) and the DoD's R&D use APIs (application programming interfaces).")
+	f.Add("AAA (a a a a b) (AA) XIV")
+	f.Fuzz(func(t *testing.T, text string) {
+		acronyms, err := readability.DetectAcronyms(text)
+		if err != nil {
+			return
+		}
+		seen := map[string]bool{}
+		for _, a := range acronyms {
+			if seen[a.Acronym] || a.Count != uint(len(a.Uses)) || len(a.Unexpanded) > len(a.Uses) || a.Flagged() != (len(a.Unexpanded) > 0) {
+				t.Errorf("the acronym %+v of %q is repeated or miscounted", a, text)
+			}
+			seen[a.Acronym] = true
+			checkOccurrences(t, text, a.Uses)
+			// The uses before the expansion are unexpanded, and all of them if it is never expanded.
+			if a.Expansion == "" && len(a.Unexpanded) != len(a.Uses) {
+				t.Errorf("the acronym %+v of %q is never expanded, but some uses are not flagged", a, text)
+			}
+			for i, use := range a.Unexpanded {
+				if use != a.Uses[i] {
+					t.Errorf("the unexpanded use %+v of %q is not among the first uses %+v", use, text, a.Uses)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/en"
+	"goreadability/it"
+	"goreadability/stats"
+)
+
+// ====== Types ======
+
+// Analyzer calculates all supported readability indices of a text from a single count of its statistics.
+// If Glossary is set, the occurrences of its jargon are reported too (see `DetectJargon`).
+type Analyzer struct {
+	Glossary *Glossary
+}
+
+// Report represents the statistics of a text and all readability indices calculated from them.
+type Report struct {
+	Stats          stats.TotalStats `json:"stats"`
+	DifficultWords uint             `json:"difficult_words"`
+	ARI            int              `json:"ari"`
+	CLI            float64          `json:"cli"`
+	DCR            float64          `json:"dcr"`
+	FRES           float64          `json:"fres"`
+	FKG            float64          `json:"fkg"`
+	SMOG           float64          `json:"smog"`
+	GunningFog     float64          `json:"gunning_fog"`
+	Gulpease       uint             `json:"gulpease"`
+	Jargon         []stats.Token    `json:"jargon,omitempty"`
+}
+
+// ====== Functions ======
+
+// NewAnalyzer returns a new Analyzer.
+func NewAnalyzer() *Analyzer {
+	return &Analyzer{}
+}
+
+// Analyze accepts a non-empty string and options and returns the Report for it. The string must contain at least one word and at least one sentence.
+// The string is counted once, and every index is calculated from the same statistics, so the indices are always consistent with each other.
+func (a *Analyzer) Analyze(s string, opts ...stats.Option) (Report, error) {
+	if len(s) == 0 {
+		return Report{}, errors.New("Empty string.")
+	}
+
+	report, err := reportFromStats(stats.CountAllStats(s, opts...), en.CountDifficultWords(s, opts...))
+	if err != nil {
+		return Report{}, err
+	}
+	if a.Glossary != nil {
+		report.Jargon = findJargon(s, stats.Words(s, opts...), *a.Glossary, stats.NewConfig(opts...).StopWords)
+	}
+	return report, nil
+}
+
+// reportFromStats returns the Report for the statistics of a text and the number of difficult words in it. The statistics must have at least one word and at least one sentence.
+func reportFromStats(st stats.TotalStats, difficultWords uint) (Report, error) {
+	if st.Words == 0 || st.Sentences == 0 {
+		return Report{}, errors.New("No words or sentences were parsed. Cannot analyze the text.")
+	}
+	report := Report{Stats: st, DifficultWords: difficultWords}
+
+	var err error
+	if report.ARI, err = en.CalcAriFromStats(report.Stats); err != nil {
+		return Report{}, err
+	}
+	if report.CLI, err = en.CalcCliFromStats(report.Stats); err != nil {
+		return Report{}, err
+	}
+	if report.DCR, err = en.CalcDCRFromStats(report.Stats, report.DifficultWords); err != nil {
+		return Report{}, err
+	}
+	if report.FRES, err = en.CalcFRESFromStats(report.Stats); err != nil {
+		return Report{}, err
+	}
+	if report.FKG, err = en.CalcFKGFromStats(report.Stats); err != nil {
+		return Report{}, err
+	}
+	if report.SMOG, err = en.CalcSMOGFromStats(report.Stats); err != nil {
+		return Report{}, err
+	}
+	if report.GunningFog, err = en.CalcGunningFogFromStats(report.Stats); err != nil {
+		return Report{}, err
+	}
+	if report.Gulpease, err = it.CalcGulpeaseFromStats(report.Stats); err != nil {
+		return Report{}, err
+	}
+	return report, nil
+}
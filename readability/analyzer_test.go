@@ -0,0 +1,104 @@
+package readability_test
+
+import (
+	"goreadability/en"
+	"goreadability/it"
+	"goreadability/readability"
+	"goreadability/stats"
+	"reflect"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	text := string(readTestdata(t, "sample.txt"))
+	report, err := readability.NewAnalyzer().Analyze(text)
+	if err != nil {
+		t.Fatalf("Analyze() returned error: %v", err)
+	}
+	checkGolden(t, "analyze", report)
+
+	for _, text := range []string{"", "   "} {
+		if _, err := readability.NewAnalyzer().Analyze(text); err == nil {
+			t.Errorf("Analyze(%q) returned no error", text)
+		}
+	}
+}
+
+func TestAnalyzeReport(t *testing.T) {
+	tests := []struct {
+		text string
+		want readability.Report
+	}{
+		{
+			// 6 words of 17 letters and 6 syllables in 1 sentence.
+			"The cat sat on the mat.",
+			readability.Report{Stats: stats.TotalStats{Symbols: 23, Characters: 17, Letters: 17, Words: 6, Sentences: 1, Syllables: 6},
+				ARI: -5, CLI: -4.1, DCR: 0.3, FRES: 116.1, FKG: -1.4, SMOG: 3.1, GunningFog: 2.4, Gulpease: 111},
+		},
+		{
+			// 10 words of 48 letters and 14 syllables in 1 sentence, with the polysyllable "dinnertime" and the unfamiliar words "played", "swings", and "dinnertime":
+			// 30% of the words are difficult, so DCR adds 3.6365 to 0.1579 × 30 + 0.0496 × 10.
+			"The children played on the swings until it was dinnertime.",
+			readability.Report{Stats: stats.TotalStats{Symbols: 58, Characters: 48, Letters: 48, Words: 10, Sentences: 1, Syllables: 14, Polysyllables: 1},
+				DifficultWords: 3, ARI: 7, CLI: 9.5, DCR: 8.87, FRES: 78.2, FKG: 4.8, SMOG: 8.8, GunningFog: 8, Gulpease: 71},
+		},
+	}
+	for _, test := range tests {
+		got, err := readability.NewAnalyzer().Analyze(test.text)
+		if err != nil {
+			t.Errorf("Analyze(%q) returned error: %v", test.text, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Analyze(%q) = %+v, want %+v", test.text, got, test.want)
+		}
+	}
+}
+
+func TestAnalyzeFamiliarWords(t *testing.T) {
+	text := "The zyxwv quorble gleeped. It was fun."
+	report, err := readability.NewAnalyzer().Analyze(text)
+	if err != nil {
+		t.Fatalf("Analyze(%q) returned error: %v", text, err)
+	}
+	if report.DifficultWords != 3 {
+		t.Errorf("Analyze(%q).DifficultWords = %d, want 3", text, report.DifficultWords)
+	}
+
+	familiar := stats.WithFamiliarWords(func(word string) bool { return word != "fun" })
+	if report, err = readability.NewAnalyzer().Analyze(text, familiar); err != nil {
+		t.Fatalf("Analyze(%q) returned error: %v", text, err)
+	}
+	if report.DifficultWords != 1 {
+		t.Errorf("Analyze(%q, WithFamiliarWords).DifficultWords = %d, want 1", text, report.DifficultWords)
+	}
+}
+
+func FuzzAnalyze(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")))
+	f.Add("One. Two three four five six seven eight nine ten eleven twelve thirteen")
+	f.Add("e.g. i.e. Dr. Smith's well-known co-op.")
+	f.Fuzz(func(t *testing.T, text string) {
+		report, err := readability.NewAnalyzer().Analyze(text)
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, report)
+		// The report matches the indices calculated one by one.
+		ari, _ := en.CalcAri(text)
+		cli, _ := en.CalcCli(text)
+		dcr, _ := en.CalcDCR(text)
+		fres, _ := en.CalcFRES(text)
+		fkg, _ := en.CalcFKG(text)
+		smog, _ := en.CalcSMOG(text)
+		fog, _ := en.CalcGunningFog(text)
+		gulpease, _ := it.CalcGulpease(text)
+		want := readability.Report{
+			Stats: report.Stats, DifficultWords: report.DifficultWords,
+			ARI: ari, CLI: cli, DCR: dcr, FRES: fres, FKG: fkg, SMOG: smog, GunningFog: fog, Gulpease: gulpease,
+		}
+		if !reflect.DeepEqual(report, want) {
+			t.Errorf("Analyze(%q) = %+v, want the indices calculated one by one %+v", text, report, want)
+		}
+	})
+}
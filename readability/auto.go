@@ -0,0 +1,117 @@
+package readability
+
+import (
+	"errors"
+	"fmt"
+	"goreadability/ar"
+	"goreadability/de"
+	"goreadability/es"
+	"goreadability/fr"
+	"goreadability/ja"
+	"goreadability/lang"
+	"goreadability/nl"
+	"goreadability/ru"
+	"goreadability/stats"
+	"goreadability/zh"
+)
+
+// ====== Types ======
+
+// MetricResult represents the score of one metric and its interpretation.
+type MetricResult struct {
+	Metric         string         `json:"metric"`
+	Score          Score          `json:"score"`
+	Interpretation Interpretation `json:"interpretation"`
+}
+
+// AutoReport represents the detected language of a text and the scores of the metrics for the language.
+type AutoReport struct {
+	Language string         `json:"language"`
+	Results  []MetricResult `json:"results"`
+}
+
+// languageMetrics are the metrics of the languages other than English and Italian, in the order they are registered for their languages when the package is initialized
+// (see `RegisterLanguage`). The English and Italian metrics are registered first.
+var languageMetrics = []struct {
+	language string
+	metrics  []Metric
+}{
+	{lang.Spanish, []Metric{
+		NewMetric("Gutiérrez de Polini", float64Metric(es.CalcGutierrezDePoliniDoc), describeScore("Gutiérrez de Polini comprehensibility %.1f of 100")),
+		NewMetric("Crawford", float64Metric(es.CalcCrawfordDoc), describeScore("%.1f years of schooling")),
+	}},
+	{lang.French, []Metric{
+		NewMetric("Kandel-Moles", float64Metric(fr.CalcKandelMolesDoc), describeScore("Kandel-Moles reading ease %.1f of 100")),
+	}},
+	{lang.German, []Metric{
+		NewMetric("Tränkle-Bailer 1", float64Metric(de.CalcTraenkleBailer1Doc), describeScore("Tränkle-Bailer reading ease %.1f of 100")),
+		NewMetric("Tränkle-Bailer 2", float64Metric(de.CalcTraenkleBailer2Doc), describeScore("Tränkle-Bailer reading ease %.1f of 100")),
+	}},
+	{lang.Dutch, []Metric{
+		NewMetric("Flesch-Douma", float64Metric(nl.CalcFleschDoumaDoc), describeScore("Flesch-Douma reading ease %.1f of 100")),
+	}},
+	{lang.Russian, []Metric{
+		NewMetric("Oborneva", float64Metric(ru.CalcObornevaDoc), describeScore("Oborneva reading ease %.1f of 100")),
+		NewMetric("Matskovskiy", float64Metric(ru.CalcMatskovskiyDoc), describeScore("%.1f years of schooling")),
+	}},
+	{lang.Arabic, []Metric{
+		NewMetric("OSMAN", float64Metric(ar.CalcOsmanDoc), describeScore("OSMAN reading ease %.1f of 100")),
+	}},
+	{lang.Japanese, []Metric{
+		NewMetric("Tateishi", float64Metric(ja.CalcTateishiDoc), describeScore("Tateishi readability %.1f (higher is easier)")),
+	}},
+	{lang.Chinese, []Metric{
+		NewMetric("Chinese readability", float64Metric(zh.CalcReadabilityDoc), describeScore("Chinese readability %.1f (lower is easier)")),
+	}},
+}
+
+// ====== Functions ======
+
+// AnalyzeAuto accepts a non-empty string and options, detects its language (see `lang.Detect`), and returns the scores of the metrics registered for the language
+// (see `LanguageMetrics`).
+// The string is counted once with the language set, and all metrics share the same statistics. Metrics that cannot be calculated for the string are skipped.
+// Returns an error if the language cannot be detected or is not supported.
+func AnalyzeAuto(s string, opts ...stats.Option) (AutoReport, error) {
+	if len(s) == 0 {
+		return AutoReport{}, errors.New("Empty string.")
+	}
+
+	language, err := lang.Detect(s)
+	if err != nil {
+		return AutoReport{}, err
+	}
+	return analyzeLanguage(s, language, opts)
+}
+
+// analyzeLanguage returns the scores of the metrics registered for the language.
+func analyzeLanguage(s string, language string, opts []stats.Option) (AutoReport, error) {
+	names := LanguageMetrics(language)
+	if len(names) == 0 {
+		return AutoReport{}, fmt.Errorf("Language %q is not supported. Cannot analyze the text.", language)
+	}
+
+	doc := stats.NewDocument(s, append([]stats.Option{stats.WithLanguage(language)}, opts...)...)
+	report := AutoReport{Language: language}
+	for _, name := range names {
+		m, ok := Lookup(name)
+		if !ok {
+			continue
+		}
+		score, err := m.Compute(doc)
+		if err != nil {
+			continue
+		}
+		report.Results = append(report.Results, MetricResult{m.Name(), score, m.Interpret(score)})
+	}
+	if len(report.Results) == 0 {
+		return AutoReport{}, errors.New("No readability index could be calculated. Cannot analyze the text.")
+	}
+	return report, nil
+}
+
+// describeScore returns an interpret function that formats the score with the format.
+func describeScore(format string) func(Score) Interpretation {
+	return func(score Score) Interpretation {
+		return Interpretation{fmt.Sprintf(format, float64(score))}
+	}
+}
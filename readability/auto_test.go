@@ -0,0 +1,117 @@
+package readability_test
+
+import (
+	"goreadability/lang"
+	"goreadability/readability"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeAuto(t *testing.T) {
+	reports := map[string]readability.AutoReport{}
+	for language := range rawIndices {
+		report, err := readability.AnalyzeAuto(rawText(t, language))
+		if err != nil {
+			t.Errorf("AnalyzeAuto() of the %s text returned error: %v", language, err)
+			continue
+		}
+		if report.Language != language {
+			t.Errorf("AnalyzeAuto() of the %s text detected %s", language, report.Language)
+		}
+		reports[language] = report
+	}
+	checkGolden(t, "auto", reports)
+
+	for _, text := range []string{"", "   ", "12345."} {
+		if _, err := readability.AnalyzeAuto(text); err == nil {
+			t.Errorf("AnalyzeAuto(%q) returned no error", text)
+		}
+	}
+}
+
+func TestAnalyzeAutoResults(t *testing.T) {
+	tests := []struct {
+		text     string
+		language string
+		metrics  []string
+		scores   []readability.Score
+	}{
+		// 16 words of one syllable in 2 sentences: 206.84 - 0.77 × 100 - 0.93 × 8.
+		{"De kat zit op de mat. De hond ligt in het gras en de zon schijnt.", lang.Dutch, []string{"Flesch-Douma"}, []readability.Score{122.4}},
+		// 12 words of 13 syllables in 2 sentences: 207 - 1.015 × 6 - 73.6 × 13 / 12.
+		{"Le chat dort sur le lit. Le chien court dans le jardin.", lang.French, []string{"Kandel-Moles"}, []readability.Score{121.2}},
+		{"El gato duerme en la cama. El perro corre en el jardín.", lang.Spanish, []string{"Gutiérrez de Polini", "Crawford"}, nil},
+		{"Der Hund schläft im Garten. Die Katze sitzt auf dem Tisch.", lang.German, []string{"Tränkle-Bailer 1", "Tränkle-Bailer 2"}, nil},
+	}
+	for _, test := range tests {
+		got, err := readability.AnalyzeAuto(test.text)
+		if err != nil {
+			t.Errorf("AnalyzeAuto(%q) returned error: %v", test.text, err)
+			continue
+		}
+		var metrics []string
+		var scores []readability.Score
+		for _, result := range got.Results {
+			metrics = append(metrics, result.Metric)
+			scores = append(scores, result.Score)
+		}
+		if got.Language != test.language || !slices.Equal(metrics, test.metrics) || (test.scores != nil && !slices.Equal(scores, test.scores)) {
+			t.Errorf("AnalyzeAuto(%q) = %s %v %v, want %s %v %v", test.text, got.Language, metrics, scores, test.language, test.metrics, test.scores)
+		}
+	}
+}
+
+func TestLanguageMetrics(t *testing.T) {
+	tests := []struct {
+		language string
+		want     []string
+	}{
+		{lang.English, []string{"ARI", "CLI", "DCR", "FRES", "FKG", "SMOG", "Gunning fog"}},
+		{lang.Spanish, []string{"Gutiérrez de Polini", "Crawford"}},
+		{lang.French, []string{"Kandel-Moles"}},
+		{"DE", []string{"Tränkle-Bailer 1", "Tränkle-Bailer 2"}},
+		{lang.Dutch, []string{"Flesch-Douma"}},
+		{lang.Russian, []string{"Oborneva", "Matskovskiy"}},
+		{lang.Arabic, []string{"OSMAN"}},
+		{lang.Japanese, []string{"Tateishi"}},
+		{lang.Chinese, []string{"Chinese readability"}},
+		{"pt", nil},
+		{"", nil},
+	}
+	for _, test := range tests {
+		got := readability.LanguageMetrics(test.language)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("LanguageMetrics(%q) = %v, want %v", test.language, got, test.want)
+		}
+		// The metrics of the languages are in the registry.
+		for _, name := range got {
+			if _, ok := readability.Lookup(name); !ok {
+				t.Errorf("Lookup(%q) found no metric", name)
+			}
+			if language, _ := readability.MetricLanguage(name); language != strings.ToLower(test.language) {
+				t.Errorf("MetricLanguage(%q) = %q, want %q", name, language, test.language)
+			}
+		}
+	}
+}
+
+func FuzzAnalyzeAuto(f *testing.F) {
+	for language := range rawIndices {
+		f.Add(rawText(f, language))
+	}
+	f.Add("Das ist gut. C'est bon.")
+	f.Fuzz(func(t *testing.T, text string) {
+		report, err := readability.AnalyzeAuto(text)
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, report)
+		if language, err := lang.Detect(text); err != nil || language != report.Language {
+			t.Errorf("AnalyzeAuto(%q) detected %s, but Detect() = %s, %v", text, report.Language, language, err)
+		}
+		if len(report.Results) == 0 {
+			t.Errorf("AnalyzeAuto(%q) returned no results", text)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/input"
+	"goreadability/stats"
+)
+
+// ====== Types ======
+
+// ChapterReport represents the Report of a chapter of a book.
+type ChapterReport struct {
+	Title string `json:"title"`
+	Report
+}
+
+// BookReport represents the reports of the chapters of a book and the Report of the whole book, calculated from the sums of the chapter statistics.
+// The jargon is reported per chapter, as its offsets are in the text of the chapter.
+type BookReport struct {
+	Chapters []ChapterReport `json:"chapters"`
+	Book     Report          `json:"book"`
+}
+
+// ====== Methods ======
+
+// AnalyzeBook accepts the chapters of a book (see `input.ExtractEPUB`) and options and returns the Report of every chapter and of the whole book.
+// The chapters without a word or a sentence (a dedication, a table of contents without terminators) are skipped, and the book must have at least one chapter with them.
+// Only the text of the chapters is analyzed, not their separated blocks.
+func (a *Analyzer) AnalyzeBook(chapters []input.Chapter, opts ...stats.Option) (BookReport, error) {
+	var book BookReport
+	var total stats.TotalStats
+	var difficultWords uint
+	for _, chapter := range chapters {
+		if len(chapter.Text) == 0 {
+			continue
+		}
+		report, err := a.Analyze(chapter.Text, opts...)
+		if err != nil {
+			continue
+		}
+		book.Chapters = append(book.Chapters, ChapterReport{chapter.Title, report})
+		total = total.Add(report.Stats)
+		difficultWords += report.DifficultWords
+	}
+	if len(book.Chapters) == 0 {
+		return BookReport{}, errors.New("No chapter has words and sentences. Cannot analyze the book.")
+	}
+
+	var err error
+	if book.Book, err = reportFromStats(total, difficultWords); err != nil {
+		return BookReport{}, err
+	}
+	return book, nil
+}
+
+// ====== Functions ======
+
+// AnalyzeEPUBFile accepts a path to an EPUB publication and options and returns its BookReport. The headings are kept in the text of the chapters.
+// See `input.ExtractEPUB` and `Analyzer.AnalyzeBook`.
+func AnalyzeEPUBFile(path string, opts ...stats.Option) (BookReport, error) {
+	chapters, err := input.ExtractEPUBFile(path, input.BlockOptions{})
+	if err != nil {
+		return BookReport{}, err
+	}
+	return NewAnalyzer().AnalyzeBook(chapters, opts...)
+}
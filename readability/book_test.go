@@ -0,0 +1,43 @@
+package readability_test
+
+import (
+	"goreadability/input"
+	"goreadability/readability"
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeBook(t *testing.T) {
+	chapters := []input.Chapter{
+		{Title: "One", Content: input.Content{Text: "The cat sat. The dog ran."}},
+		{Title: "Empty"},
+		{Title: "Contents", Content: input.Content{Text: "Contents"}},
+		{Title: "Two", Content: input.Content{Text: "We had fun."}},
+	}
+	got, err := readability.NewAnalyzer().AnalyzeBook(chapters)
+	if err != nil {
+		t.Fatalf("AnalyzeBook() returned error: %v", err)
+	}
+
+	// The empty chapter and the chapter without a sentence are skipped.
+	titles := []string{"One", "Two"}
+	if len(got.Chapters) != len(titles) {
+		t.Fatalf("AnalyzeBook() has %d chapters, want %d", len(got.Chapters), len(titles))
+	}
+	for i, title := range titles {
+		if got.Chapters[i].Title != title {
+			t.Errorf("AnalyzeBook() chapter %d = %q, want %q", i, got.Chapters[i].Title, title)
+		}
+	}
+	// The book is counted from the sums of the chapter statistics, so it is scored as the text of the chapters.
+	want, _ := readability.NewAnalyzer().Analyze("The cat sat. The dog ran. We had fun.")
+	// The space between the chapters is not a symbol of the book.
+	want.Stats.Symbols--
+	if got.Book.Stats.Words != 9 || got.Book.Stats.Sentences != 3 || !reflect.DeepEqual(got.Book, want) {
+		t.Errorf("AnalyzeBook() book = %+v, want %+v", got.Book, want)
+	}
+
+	if _, err := readability.NewAnalyzer().AnalyzeBook(chapters[1:3]); err == nil {
+		t.Errorf("AnalyzeBook() of the chapters without sentences returned no error")
+	}
+}
@@ -0,0 +1,157 @@
+package readability
+
+import (
+	"errors"
+	"fmt"
+	"goreadability/en"
+	"goreadability/stats"
+	"math"
+)
+
+// ====== Types ======
+
+// CEFRLevel represents a level of the Common European Framework of Reference for Languages.
+type CEFRLevel int
+
+const (
+	A1 CEFRLevel = iota
+	A2
+	B1
+	B2
+	C1
+	C2
+)
+
+// String returns the name of the CEFR level.
+func (l CEFRLevel) String() string {
+	switch l {
+	case A1:
+		return "A1"
+	case A2:
+		return "A2"
+	case B1:
+		return "B1"
+	case B2:
+		return "B2"
+	case C1:
+		return "C1"
+	case C2:
+		return "C2"
+	}
+	return "Unknown"
+}
+
+// MarshalText encodes the level as its name ("B1"), so it is marshaled to JSON as a string.
+func (l CEFRLevel) MarshalText() ([]byte, error) {
+	if l < A1 || l > C2 {
+		return nil, fmt.Errorf("Unknown CEFR level %d.", int(l))
+	}
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText decodes the level from its name ("B1").
+func (l *CEFRLevel) UnmarshalText(text []byte) error {
+	for level := A1; level <= C2; level++ {
+		if level.String() == string(text) {
+			*l = level
+			return nil
+		}
+	}
+	return fmt.Errorf("Unknown CEFR level %q.", string(text))
+}
+
+// CEFRResult represents the estimated CEFR level of a text together with the values it was estimated from.
+type CEFRResult struct {
+	Level              CEFRLevel `json:"level"`
+	GradeLevel         CEFRLevel `json:"grade_level"`
+	VocabularyLevel    CEFRLevel `json:"vocabulary_level"`
+	Grade              float64   `json:"grade"`
+	DifficultWordsPerc float64   `json:"difficult_words_perc"`
+	// UncommonWordsPerc is the percentage of the words outside the top 2000 words of the configured frequency list, if there is one (see `stats.WithFrequencyList`).
+	UncommonWordsPerc float64 `json:"uncommon_words_perc,omitempty"`
+}
+
+// CEFRThreshold maps the upper bound (inclusive) of a value to a CEFR level.
+type CEFRThreshold struct {
+	Max   float64   `json:"max"`
+	Level CEFRLevel `json:"level"`
+}
+
+// CEFRGradeThresholds maps the consensus US grade level to a CEFR level. Grades above the last threshold are C2.
+var CEFRGradeThresholds = []CEFRThreshold{
+	{2, A1},
+	{4, A2},
+	{6, B1},
+	{9, B2},
+	{12, C1},
+}
+
+// CEFRVocabularyThresholds maps the percentage of words outside the Dale–Chall familiar words list to a CEFR level. Percentages above the last threshold are C2.
+var CEFRVocabularyThresholds = []CEFRThreshold{
+	{5, A1},
+	{10, A2},
+	{15, B1},
+	{20, B2},
+	{30, C1},
+}
+
+// CEFRFrequencyThresholds maps the percentage of words outside the top 2000 words of a frequency list to a CEFR level. Percentages above the last threshold are C2.
+var CEFRFrequencyThresholds = []CEFRThreshold{
+	{3, A1},
+	{6, A2},
+	{10, B1},
+	{15, B2},
+	{20, C1},
+}
+
+// cefrCommonWords is the rank of the last common word of a frequency list for `EstimateCEFR`.
+const cefrCommonWords = 2000
+
+// ====== Functions ======
+
+// EstimateCEFR accepts a non-empty English string and returns its approximate CEFR level (A1–C2).
+// The level is the average (rounded up) of two estimations: the consensus grade level of the text (see `Consensus`) mapped by `CEFRGradeThresholds`,
+// and the level of the vocabulary. If a frequency list is configured (see `stats.WithFrequencyList`), the vocabulary level is the percentage of words
+// outside its top 2000 words mapped by `CEFRFrequencyThresholds`, otherwise the percentage of words outside the Dale–Chall familiar words list
+// mapped by `CEFRVocabularyThresholds`.
+// The result is an approximation and is not a substitute for a CEFR assessment.
+func EstimateCEFR(s string, opts ...stats.Option) (CEFRResult, error) {
+	if len(s) == 0 {
+		return CEFRResult{}, errors.New("Empty string.")
+	}
+
+	consensus, err := Consensus(s, opts...)
+	if err != nil {
+		return CEFRResult{}, err
+	}
+	diffWordsPerc, err := en.CalcDifficultWords(s, opts...)
+	if err != nil {
+		return CEFRResult{}, err
+	}
+
+	var result CEFRResult
+	result.Grade = consensus.Median
+	result.DifficultWordsPerc = diffWordsPerc
+	result.GradeLevel = cefrLevel(result.Grade, CEFRGradeThresholds)
+	result.VocabularyLevel = cefrLevel(result.DifficultWordsPerc, CEFRVocabularyThresholds)
+	if list := stats.NewConfig(opts...).FrequencyList; list != nil {
+		profile, err := stats.ProfileVocabulary(s, list, []uint{cefrCommonWords}, opts...)
+		if err != nil {
+			return CEFRResult{}, err
+		}
+		result.UncommonWordsPerc = math.Round((100-profile.Bands[0].Percentage)*10) / 10
+		result.VocabularyLevel = cefrLevel(result.UncommonWordsPerc, CEFRFrequencyThresholds)
+	}
+	result.Level = CEFRLevel(math.Ceil(float64(result.GradeLevel+result.VocabularyLevel) / 2))
+	return result, nil
+}
+
+// cefrLevel returns the level of the first threshold the value doesn't exceed, or C2 if it exceeds all of them.
+func cefrLevel(value float64, thresholds []CEFRThreshold) CEFRLevel {
+	for _, threshold := range thresholds {
+		if value <= threshold.Max {
+			return threshold.Level
+		}
+	}
+	return C2
+}
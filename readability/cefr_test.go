@@ -0,0 +1,82 @@
+package readability_test
+
+import (
+	"encoding/json"
+	"goreadability/readability"
+	"goreadability/stats"
+	"testing"
+)
+
+func TestEstimateCEFR(t *testing.T) {
+	results := map[string]readability.CEFRResult{}
+	for name, text := range map[string]string{
+		"sample": string(readTestdata(t, "sample.txt")),
+		"simple": "The cat sat on the mat. It was a good day. We had fun.",
+	} {
+		result, err := readability.EstimateCEFR(text)
+		if err != nil {
+			t.Fatalf("EstimateCEFR(%q) returned error: %v", text, err)
+		}
+		results[name] = result
+	}
+	checkGolden(t, "cefr", results)
+}
+
+func TestEstimateCEFRFrequencyList(t *testing.T) {
+	text := "The cat sat on the mat. It was a good day. We had fun."
+	common := []string{"the", "it", "was", "a", "we", "had", "on", "good", "day", "cat", "sat"}
+	tests := []struct {
+		words      []string
+		uncommon   float64
+		vocabulary readability.CEFRLevel
+		level      readability.CEFRLevel
+	}{
+		{append(common, "mat", "fun"), 0, readability.A1, readability.A1},
+		// "mat" is 1 of 14 words outside the list.
+		{append(common, "fun"), 7.1, readability.B1, readability.A2},
+		// "mat" and "fun" are 2 of 14 words outside the list.
+		{common, 14.3, readability.B2, readability.B1},
+	}
+	for _, test := range tests {
+		result, err := readability.EstimateCEFR(text, stats.WithFrequencyList(stats.NewFrequencyList(test.words)))
+		if err != nil {
+			t.Fatalf("EstimateCEFR(%q) returned error: %v", text, err)
+		}
+		if result.UncommonWordsPerc != test.uncommon || result.VocabularyLevel != test.vocabulary || result.Level != test.level {
+			t.Errorf("EstimateCEFR(%q) with the words %q = %+v, want %v%% uncommon words, %v vocabulary, and %v", text, test.words, result, test.uncommon, test.vocabulary, test.level)
+		}
+	}
+}
+
+func TestCEFRLevelText(t *testing.T) {
+	for level := readability.A1; level <= readability.C2; level++ {
+		data, err := json.Marshal(level)
+		if err != nil {
+			t.Fatalf("Marshal(%v) returned error: %v", level, err)
+		}
+		var got readability.CEFRLevel
+		if err := json.Unmarshal(data, &got); err != nil || got != level {
+			t.Errorf("Unmarshal(%s) = %v, %v, want %v", data, got, err, level)
+		}
+	}
+	if _, err := json.Marshal(readability.CEFRLevel(6)); err == nil {
+		t.Errorf("Marshal(CEFRLevel(6)) returned no error")
+	}
+	var level readability.CEFRLevel
+	if err := json.Unmarshal([]byte(`"D1"`), &level); err == nil {
+		t.Errorf("Unmarshal(\"D1\") returned no error")
+	}
+}
+
+func FuzzEstimateCEFR(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")))
+	f.Add("Go. Go. Go.")
+	f.Add("123 456")
+	f.Fuzz(func(t *testing.T, text string) {
+		result, err := readability.EstimateCEFR(text)
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, result)
+	})
+}
@@ -0,0 +1,95 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/input"
+	"goreadability/stats"
+	"math"
+)
+
+// ====== Types ======
+
+// RowReport represents the Report of the text of a CSV row, with the line of the row in the file and its ID.
+type RowReport struct {
+	Line int    `json:"line"`
+	ID   string `json:"id,omitempty"`
+	Report
+}
+
+// ColumnSummary represents the aggregates of the reports of the rows of a column: the number of analyzed and skipped rows,
+// the Report of all the texts of the column, calculated from the sums of their statistics, and the means and the medians of the Flesch-Kincaid grade levels
+// and the Flesch reading ease scores of the rows, with the hardest grade level.
+type ColumnSummary struct {
+	Rows       int     `json:"rows"`
+	Skipped    int     `json:"skipped"`
+	Column     Report  `json:"column"`
+	MeanFKG    float64 `json:"mean_fkg"`
+	MedianFKG  float64 `json:"median_fkg"`
+	MaxFKG     float64 `json:"max_fkg"`
+	MeanFRES   float64 `json:"mean_fres"`
+	MedianFRES float64 `json:"median_fres"`
+}
+
+// CSVReport represents the reports of the rows of a CSV column and their ColumnSummary.
+type CSVReport struct {
+	Rows    []RowReport   `json:"rows"`
+	Summary ColumnSummary `json:"summary"`
+}
+
+// ====== Methods ======
+
+// AnalyzeRows accepts the rows of a CSV column (see `input.ExtractCSVColumn`) and options and returns the Report of every row, in the same order, and their summary.
+// A text without a sentence terminator (a product name, a short macro) is analyzed as one sentence.
+// The rows without words are skipped and counted in the summary, and at least one row must be analyzed.
+func (a *Analyzer) AnalyzeRows(rows []input.CSVRow, opts ...stats.Option) (CSVReport, error) {
+	var report CSVReport
+	var total stats.TotalStats
+	var difficultWords uint
+	var grades, eases []float64
+	for _, row := range rows {
+		text := row.Text
+		if stats.CountSentences(text, opts...) == 0 {
+			text += "."
+		}
+		rowReport, err := a.Analyze(text, opts...)
+		// The point added to a blank row would be counted as a word.
+		if err != nil || stats.CountWords(row.Text, opts...) == 0 {
+			report.Summary.Skipped++
+			continue
+		}
+		report.Rows = append(report.Rows, RowReport{row.Line, row.ID, rowReport})
+		total = total.Add(rowReport.Stats)
+		difficultWords += rowReport.DifficultWords
+		grades = append(grades, rowReport.FKG)
+		eases = append(eases, rowReport.FRES)
+	}
+	if len(report.Rows) == 0 {
+		return CSVReport{}, errors.New("No row has words. Cannot analyze the column.")
+	}
+
+	summary := &report.Summary
+	summary.Rows = len(report.Rows)
+	var err error
+	if summary.Column, err = reportFromStats(total, difficultWords); err != nil {
+		return CSVReport{}, err
+	}
+	summary.MeanFKG = math.Round(mean(grades)*100) / 100
+	summary.MeanFRES = math.Round(mean(eases)*100) / 100
+	// median sorts the values, so the maximum is the last grade after it.
+	summary.MedianFKG = math.Round(median(grades)*100) / 100
+	summary.MaxFKG = grades[len(grades)-1]
+	summary.MedianFRES = math.Round(median(eases)*100) / 100
+	return report, nil
+}
+
+// ====== Functions ======
+
+// AnalyzeCSVFile accepts a path to a CSV file, the options of its column, and options and returns the CSVReport of the column.
+// See `input.ExtractCSVColumn` and `Analyzer.AnalyzeRows`.
+func AnalyzeCSVFile(path string, co input.CSVOptions, opts ...stats.Option) (CSVReport, error) {
+	rows, err := input.ExtractCSVColumnFile(path, co)
+	if err != nil {
+		return CSVReport{}, err
+	}
+	return NewAnalyzer().AnalyzeRows(rows, opts...)
+}
@@ -0,0 +1,48 @@
+package readability_test
+
+import (
+	"goreadability/input"
+	"goreadability/readability"
+	"math"
+	"testing"
+)
+
+func TestAnalyzeRows(t *testing.T) {
+	texts := []string{
+		"The cat sat. The dog ran.",
+		"The children walked slowly to school, carrying their heavy backpacks.",
+		"Notwithstanding considerable uncertainty, the committee deliberately postponed the evaluation.",
+	}
+	rows := []input.CSVRow{{Line: 2, ID: "a", Text: texts[0]}, {Line: 3, ID: "b", Text: "   "}, {Line: 4, ID: "c", Text: texts[1]}, {Line: 5, ID: "d", Text: texts[2]}}
+	got, err := readability.NewAnalyzer().AnalyzeRows(rows)
+	if err != nil {
+		t.Fatalf("AnalyzeRows() returned error: %v", err)
+	}
+
+	var grades, eases []float64
+	for _, text := range texts {
+		report, _ := readability.NewAnalyzer().Analyze(text)
+		grades = append(grades, report.FKG)
+		eases = append(eases, report.FRES)
+	}
+	// The blank row is skipped, and the grades of the rows increase, so the medians are the ones of the second row and the maximum is the third one.
+	ids := []string{"a", "c", "d"}
+	if len(got.Rows) != len(ids) || got.Summary.Rows != 3 || got.Summary.Skipped != 1 {
+		t.Fatalf("AnalyzeRows() = %d rows (%d, %d skipped), want %d rows, 1 skipped", len(got.Rows), got.Summary.Rows, got.Summary.Skipped, len(ids))
+	}
+	for i, id := range ids {
+		if got.Rows[i].ID != id || got.Rows[i].FKG != grades[i] {
+			t.Errorf("AnalyzeRows() row %d = %q, FKG %v, want %q, %v", i, got.Rows[i].ID, got.Rows[i].FKG, id, grades[i])
+		}
+	}
+	round := func(x float64) float64 { return math.Round(x*100) / 100 }
+	summary := got.Summary
+	if summary.MeanFKG != round((grades[0]+grades[1]+grades[2])/3) || summary.MedianFKG != grades[1] || summary.MaxFKG != grades[2] ||
+		summary.MeanFRES != round((eases[0]+eases[1]+eases[2])/3) || summary.MedianFRES != eases[1] {
+		t.Errorf("AnalyzeRows() summary = %+v, want the aggregates of the grades %v and the eases %v", summary, grades, eases)
+	}
+
+	if _, err := readability.NewAnalyzer().AnalyzeRows([]input.CSVRow{{Line: 1, Text: " "}}); err == nil {
+		t.Errorf("AnalyzeRows() of blank rows returned no error")
+	}
+}
@@ -0,0 +1,86 @@
+package readability_test
+
+import (
+	"goreadability/en"
+	"goreadability/stats"
+	"reflect"
+	"testing"
+)
+
+func TestDifficultWords(t *testing.T) {
+	text := string(readTestdata(t, "sample.txt"))
+	checkGolden(t, "difficult_words", stats.DifficultWords(text, stats.WithFamiliarWords(en.IsFamiliarWord)))
+
+	for word, want := range map[string]bool{"boy": true, "Boy's": true, "boy’s": true, "nominalization": false, "nominalization's": false} {
+		if got := en.IsFamiliarWord(word); got != want {
+			t.Errorf("IsFamiliarWord(%q) = %v, want %v", word, got, want)
+		}
+	}
+}
+
+func TestDifficultWordsTable(t *testing.T) {
+	type word struct {
+		text         string
+		syllables    uint
+		polysyllabic bool
+		unfamiliar   bool
+	}
+	tests := []struct {
+		text     string
+		familiar bool
+		want     []word
+	}{
+		{"The children played on the swings until it was dinnertime.", false, []word{{"dinnertime", 3, true, false}}},
+		{"The children played on the swings until it was dinnertime.", true, []word{{"played", 1, false, true}, {"swings", 1, false, true}, {"dinnertime", 3, true, true}}},
+		// The possessive of a familiar word is familiar, and the punctuation around the words is trimmed.
+		{"She said the boy’s (beautiful) dog ran.", true, []word{{"beautiful", 3, true, false}}},
+		// "50%" is "fifty percent" and "$300" is "three hundred dollars".
+		{"It costs 50% of $300.", true, []word{{"costs", 1, false, true}, {"50", 4, true, true}, {"300", 5, true, true}}},
+		{"", true, nil},
+	}
+	for _, test := range tests {
+		var opts []stats.Option
+		if test.familiar {
+			opts = append(opts, stats.WithFamiliarWords(en.IsFamiliarWord))
+		}
+		var got []word
+		for _, w := range stats.DifficultWords(test.text, opts...) {
+			got = append(got, word{w.Text, w.Syllables, w.Polysyllabic, w.Unfamiliar})
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("DifficultWords(%q) with familiar words %v = %+v, want %+v", test.text, test.familiar, got, test.want)
+		}
+	}
+}
+
+func FuzzDifficultWords(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")), true)
+	f.Add("Don’t “underestimate” the boy's (extraordinary) co-operation.", false)
+	f.Add("0%", false)
+	f.Fuzz(func(t *testing.T, text string, familiar bool) {
+		var opts []stats.Option
+		if familiar {
+			opts = append(opts, stats.WithFamiliarWords(en.IsFamiliarWord))
+		}
+		var polysyllables uint
+		end := 0
+		for _, word := range stats.DifficultWords(text, opts...) {
+			if word.Start < end || word.End < word.Start || word.End > len(text) || text[word.Start:word.End] != word.Text {
+				t.Fatalf("the word %+v of %q is not at its offsets after %d", word.Token, text, end)
+			}
+			end = word.End
+			if !word.Polysyllabic && !word.Unfamiliar || word.Polysyllabic != (word.Syllables >= stats.PolysyllableThreshold) {
+				t.Errorf("the word %+v of %q is not difficult", word, text)
+			}
+			if word.Unfamiliar && (!familiar || en.IsFamiliarWord(word.Text)) {
+				t.Errorf("the word %+v of %q is familiar", word, text)
+			}
+			if word.Polysyllabic {
+				polysyllables++
+			}
+		}
+		if want := stats.CountAllStats(text, opts...).Polysyllables; polysyllables != want {
+			t.Errorf("%q has %d polysyllabic difficult words, want %d polysyllables", text, polysyllables, want)
+		}
+	})
+}
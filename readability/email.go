@@ -0,0 +1,32 @@
+package readability
+
+import (
+	"goreadability/input"
+	"goreadability/stats"
+)
+
+// ====== Types ======
+
+// EmailReport represents the Report of the body of an email message, with the sender, the subject, and the date of the message.
+type EmailReport struct {
+	From    string `json:"from"`
+	Subject string `json:"subject"`
+	Date    string `json:"date,omitempty"`
+	Report
+}
+
+// ====== Functions ======
+
+// AnalyzeEmailFile accepts a path to an email message (".eml") and options and returns the EmailReport of its body without the quoted replies and the signature.
+// The headings are kept in the text of the body. See `input.ExtractEmail`.
+func AnalyzeEmailFile(path string, opts ...stats.Option) (EmailReport, error) {
+	email, err := input.ExtractEmailFile(path, input.BlockOptions{})
+	if err != nil {
+		return EmailReport{}, err
+	}
+	report, err := NewAnalyzer().Analyze(email.Text, opts...)
+	if err != nil {
+		return EmailReport{}, err
+	}
+	return EmailReport{email.From, email.Subject, email.Date, report}, nil
+}
@@ -0,0 +1,28 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeEmailFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reply.eml")
+	message := "From: Ann <ann@example.com>\nSubject: Re: Lunch\nDate: Mon, 5 Jan 2026 12:00:00 +0000\n\n" +
+		"Yes, I can come.\nSee you at noon.\n\nOn Mon, 5 Jan 2026, Bob <bob@example.com> wrote:\n> Can you come to lunch on Monday with the whole team?\n\n-- \nAnn\n"
+	if err := os.WriteFile(path, []byte(message), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readability.AnalyzeEmailFile(path)
+	if err != nil {
+		t.Fatalf("AnalyzeEmailFile() returned error: %v", err)
+	}
+
+	// The quoted reply and the signature are not scored.
+	want, _ := readability.NewAnalyzer().Analyze("Yes, I can come. See you at noon.")
+	if got.From != "Ann <ann@example.com>" || got.Subject != "Re: Lunch" || got.Date != "Mon, 5 Jan 2026 12:00:00 +0000" || !reflect.DeepEqual(got.Report, want) {
+		t.Errorf("AnalyzeEmailFile() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,105 @@
+package readability
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"goreadability/input"
+	"goreadability/stats"
+	"math"
+	"strings"
+)
+
+// ====== Types ======
+
+// ItemReport represents the reports of the title and the body of a feed item. TitleReport or Body is nil if it has no words.
+// A title without a sentence terminator is scored as one sentence.
+type ItemReport struct {
+	Title       string  `json:"title"`
+	Link        string  `json:"link,omitempty"`
+	Published   string  `json:"published,omitempty"`
+	TitleReport *Report `json:"title_report,omitempty"`
+	Body        *Report `json:"body,omitempty"`
+}
+
+// FeedReport represents the reports of the items of a feed and their summary: the Report of all the bodies, calculated from the sums of their statistics,
+// and the medians of the Flesch-Kincaid grade levels and the Flesch reading ease scores of the bodies, which an item far harder than the rest doesn't shift.
+type FeedReport struct {
+	Title      string       `json:"title"`
+	Items      []ItemReport `json:"items"`
+	Feed       Report       `json:"feed"`
+	MedianFKG  float64      `json:"median_fkg"`
+	MedianFRES float64      `json:"median_fres"`
+}
+
+// ====== Methods ======
+
+// AnalyzeFeed accepts a feed (see `input.ExtractFeed`) and options and returns the reports of its items and their summary.
+// The items with neither a title nor a body with words are skipped, and the feed must have at least one item with a body that can be analyzed.
+func (a *Analyzer) AnalyzeFeed(feed input.Feed, opts ...stats.Option) (FeedReport, error) {
+	report := FeedReport{Title: feed.Title}
+	var total stats.TotalStats
+	var difficultWords uint
+	var grades, eases []float64
+	for _, item := range feed.Items {
+		itemReport := ItemReport{Title: item.Title, Link: item.Link, Published: item.Published}
+		if title := strings.TrimSpace(item.Title); title != "" {
+			if stats.CountSentences(title, opts...) == 0 {
+				title += "."
+			}
+			if titleReport, err := a.Analyze(title, opts...); err == nil {
+				itemReport.TitleReport = &titleReport
+			}
+		}
+		if len(item.Text) > 0 {
+			if body, err := a.Analyze(item.Text, opts...); err == nil {
+				itemReport.Body = &body
+				total = total.Add(body.Stats)
+				difficultWords += body.DifficultWords
+				grades = append(grades, body.FKG)
+				eases = append(eases, body.FRES)
+			}
+		}
+		if itemReport.TitleReport == nil && itemReport.Body == nil {
+			continue
+		}
+		report.Items = append(report.Items, itemReport)
+	}
+	if len(grades) == 0 {
+		return FeedReport{}, errors.New("No feed item has a body with words and sentences. Cannot analyze the feed.")
+	}
+
+	var err error
+	if report.Feed, err = reportFromStats(total, difficultWords); err != nil {
+		return FeedReport{}, err
+	}
+	report.MedianFKG = math.Round(median(grades)*100) / 100
+	report.MedianFRES = math.Round(median(eases)*100) / 100
+	return report, nil
+}
+
+// ====== Functions ======
+
+// AnalyzeFeedFile accepts a path to an RSS or Atom feed and options and returns its FeedReport. The headings are kept in the bodies of the items.
+// See `input.ExtractFeed` and `Analyzer.AnalyzeFeed`.
+func AnalyzeFeedFile(path string, opts ...stats.Option) (FeedReport, error) {
+	feed, err := input.ExtractFeedFile(path, input.BlockOptions{})
+	if err != nil {
+		return FeedReport{}, err
+	}
+	return NewAnalyzer().AnalyzeFeed(feed, opts...)
+}
+
+// FeedFromURL accepts a context, the URL of an RSS or Atom feed, and options, fetches the feed within FETCH_TIMEOUT, and returns its FeedReport.
+// The feed must be no larger than MAX_PAGE_SIZE. See `AnalyzeFeedFile`.
+func FeedFromURL(ctx context.Context, rawURL string, opts ...stats.Option) (FeedReport, error) {
+	document, err := fetch(ctx, rawURL, "application/rss+xml, application/atom+xml, application/rdf+xml, application/xml;q=0.9, text/xml;q=0.9")
+	if err != nil {
+		return FeedReport{}, err
+	}
+	feed, err := input.ExtractFeed(bytes.NewReader(document.data), input.BlockOptions{})
+	if err != nil {
+		return FeedReport{}, err
+	}
+	return NewAnalyzer().AnalyzeFeed(feed, opts...)
+}
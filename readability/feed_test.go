@@ -0,0 +1,54 @@
+package readability_test
+
+import (
+	"goreadability/input"
+	"goreadability/readability"
+	"testing"
+)
+
+func TestAnalyzeFeed(t *testing.T) {
+	bodies := []string{"The cat sat. The dog ran.", "The children walked slowly to school, carrying their heavy backpacks.", "Notwithstanding considerable uncertainty, the committee deliberately postponed the evaluation."}
+	feed := input.Feed{Title: "News", Items: []input.FeedItem{
+		{Title: "Cats and dogs", Content: input.Content{Text: bodies[0]}},
+		{Title: "", Content: input.Content{Text: ""}},
+		{Title: "Fun", Content: input.Content{Text: bodies[1]}},
+		{Title: "Only a title"},
+		{Title: "Hard", Content: input.Content{Text: bodies[2]}},
+	}}
+	got, err := readability.NewAnalyzer().AnalyzeFeed(feed)
+	if err != nil {
+		t.Fatalf("AnalyzeFeed() returned error: %v", err)
+	}
+
+	// The item with neither a title nor a body is skipped, and the item with a title only has no body report.
+	titles := []string{"Cats and dogs", "Fun", "Only a title", "Hard"}
+	if len(got.Items) != len(titles) {
+		t.Fatalf("AnalyzeFeed() = %d items, want %d", len(got.Items), len(titles))
+	}
+	for i, title := range titles {
+		item := got.Items[i]
+		if item.Title != title || item.TitleReport == nil || item.TitleReport.Stats.Sentences != 1 || (item.Body == nil) != (title == "Only a title") {
+			t.Errorf("AnalyzeFeed() item %d = %q, title report %+v, body %+v", i, item.Title, item.TitleReport, item.Body)
+		}
+	}
+
+	// The medians are the ones of the second body, harder than the first one and easier than the third one.
+	var words uint
+	for _, body := range bodies {
+		report, _ := readability.NewAnalyzer().Analyze(body)
+		words += report.Stats.Words
+	}
+	easy, _ := readability.NewAnalyzer().Analyze(bodies[0])
+	middle, _ := readability.NewAnalyzer().Analyze(bodies[1])
+	hard, _ := readability.NewAnalyzer().Analyze(bodies[2])
+	if easy.FKG >= middle.FKG || middle.FKG >= hard.FKG {
+		t.Fatalf("the grades of the bodies = %v, %v, %v, want them increasing", easy.FKG, middle.FKG, hard.FKG)
+	}
+	if got.Feed.Stats.Words != words || got.MedianFKG != middle.FKG || got.MedianFRES != middle.FRES {
+		t.Errorf("AnalyzeFeed() = %d words, median FKG %v, FRES %v, want %d words, %v, %v", got.Feed.Stats.Words, got.MedianFKG, got.MedianFRES, words, middle.FKG, middle.FRES)
+	}
+
+	if _, err := readability.NewAnalyzer().AnalyzeFeed(input.Feed{Items: []input.FeedItem{{Title: "Only a title"}}}); err == nil {
+		t.Errorf("AnalyzeFeed() of a feed without bodies returned no error")
+	}
+}
@@ -0,0 +1,251 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/input"
+	"goreadability/stats"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// ReadingBand represents the guided reading levels (A–Z) and the age band of the books typical for a US grade level.
+type ReadingBand struct {
+	Grade    uint   `json:"grade"`
+	Levels   string `json:"levels"`
+	Ages     string `json:"ages"`
+	Category string `json:"category"`
+}
+
+// ReadingBands maps US grade levels to the guided reading levels and the age bands of the children's and young adult books.
+// Grades above the last band use the last band. The table is an approximation compiled from publicly available leveling charts and is exposed so callers can replace it.
+var ReadingBands = []ReadingBand{
+	{0, "A–D", "4–6", "Picture books"},
+	{1, "E–J", "6–7", "Early readers"},
+	{2, "K–M", "7–8", "Early readers"},
+	{3, "N–P", "8–9", "Chapter books"},
+	{4, "Q–S", "9–10", "Middle grade"},
+	{5, "T–V", "10–11", "Middle grade"},
+	{6, "W–Y", "11–12", "Middle grade"},
+	{7, "Z", "12–13", "Middle grade"},
+	{8, "Z+", "13–14", "Young adult"},
+	{9, "Z+", "14–18", "Young adult"},
+	{13, "Z+", "18+", "Adult"},
+}
+
+// FictionChapterReport represents the Report of a chapter of a work of fiction, with the share of its words spoken in dialogue.
+type FictionChapterReport struct {
+	ChapterReport
+	DialogueRatio float64 `json:"dialogue_ratio"`
+}
+
+// FictionReport represents the reports of the chapters of a work of fiction and the Report of the whole book, calculated from the sums of the chapter statistics,
+// with the share of the words spoken in dialogue (the rest is narration), the reports of the dialogue and of the narration scored separately
+// (nil if the book has none), the consensus grade level of the book, and its reading band.
+type FictionReport struct {
+	Chapters      []FictionChapterReport `json:"chapters"`
+	Book          Report                 `json:"book"`
+	DialogueRatio float64                `json:"dialogue_ratio"`
+	Dialogue      *Report                `json:"dialogue,omitempty"`
+	Narration     *Report                `json:"narration,omitempty"`
+	Grade         float64                `json:"grade"`
+	Band          ReadingBand            `json:"band"`
+}
+
+// ====== Methods ======
+
+// AnalyzeFiction accepts the chapters of a work of fiction (see `input.SplitChapters`, `input.ExtractEPUB`) and options and returns the FictionReport of the book.
+// The text is counted with `stats.WithFictionProfile`, so the dialogue attributions ("'Stop!' Tom said.") don't split the sentences.
+// The dialogue is the text in double quotes ("...", “...”, «...»), in single typographic quotes (‘...’), and after a dash at the start of a paragraph ("—Wait, she said.").
+// The dialogue and the narration are also scored on their own, and every quote and every piece of narration between the quotes is a sentence there
+// (“I know,” he said. is the sentences "I know." and "he said.").
+// The chapters without a word or a sentence are skipped, and the book must have at least one chapter with them. The grade is the median of the metrics (see `Consensus`)
+// of the whole text, and its band is looked up in ReadingBands.
+func (a *Analyzer) AnalyzeFiction(chapters []input.Chapter, opts ...stats.Option) (FictionReport, error) {
+	if len(ReadingBands) == 0 {
+		return FictionReport{}, errors.New("No reading bands defined. Cannot analyze the fiction.")
+	}
+	opts = append([]stats.Option{stats.WithFictionProfile()}, opts...)
+
+	var fiction FictionReport
+	var total stats.TotalStats
+	var difficultWords, dialogue, words uint
+	var texts, dialogueParts, narrationParts []string
+	for _, chapter := range chapters {
+		if len(chapter.Text) == 0 {
+			continue
+		}
+		report, err := a.Analyze(chapter.Text, opts...)
+		if err != nil {
+			continue
+		}
+		chapterDialogue, chapterWords := countDialogueWords(chapter.Text, opts...)
+		fiction.Chapters = append(fiction.Chapters, FictionChapterReport{ChapterReport{chapter.Title, report}, dialogueRatio(chapterDialogue, chapterWords)})
+		total = total.Add(report.Stats)
+		difficultWords += report.DifficultWords
+		dialogue += chapterDialogue
+		words += chapterWords
+		texts = append(texts, chapter.Text)
+		chapterDialogueParts, chapterNarrationParts := splitDialogue(chapter.Text)
+		dialogueParts = append(dialogueParts, chapterDialogueParts...)
+		narrationParts = append(narrationParts, chapterNarrationParts...)
+	}
+	if len(fiction.Chapters) == 0 {
+		return FictionReport{}, errors.New("No chapter has words and sentences. Cannot analyze the fiction.")
+	}
+
+	var err error
+	if fiction.Book, err = reportFromStats(total, difficultWords); err != nil {
+		return FictionReport{}, err
+	}
+	fiction.DialogueRatio = dialogueRatio(dialogue, words)
+	if report, err := a.Analyze(strings.Join(dialogueParts, " "), opts...); err == nil {
+		fiction.Dialogue = &report
+	}
+	if report, err := a.Analyze(strings.Join(narrationParts, " "), opts...); err == nil {
+		fiction.Narration = &report
+	}
+	consensus, err := Consensus(strings.Join(texts, "\n\n"), opts...)
+	if err != nil {
+		return FictionReport{}, err
+	}
+	fiction.Grade = consensus.Median
+	fiction.Band = readingBand(uint(fiction.Grade))
+	return fiction, nil
+}
+
+// ====== Functions ======
+
+// AnalyzeFictionText accepts a plain text work of fiction and options and returns its FictionReport. The chapters are split at their headings (see `input.SplitChapters`).
+// See `Analyzer.AnalyzeFiction`.
+func AnalyzeFictionText(s string, opts ...stats.Option) (FictionReport, error) {
+	if len(s) == 0 {
+		return FictionReport{}, errors.New("Empty string.")
+	}
+	return NewAnalyzer().AnalyzeFiction(input.SplitChapters(s), opts...)
+}
+
+// countDialogueWords returns the number of the words of the text that are in dialogue and the number of all its words.
+func countDialogueWords(s string, opts ...stats.Option) (dialogue, words uint) {
+	spans := dialogueSpans(s)
+	for _, word := range stats.Words(s, opts...) {
+		for len(spans) > 0 && spans[0][1] <= word.Start {
+			spans = spans[1:]
+		}
+		if len(spans) > 0 && spans[0][0] <= word.Start {
+			dialogue++
+		}
+		words++
+	}
+	return dialogue, words
+}
+
+// splitDialogue returns the quotes of the text (see `dialogueSpans`) and the pieces of narration between them, each one made a sentence (see `Analyzer.AnalyzeFiction`).
+func splitDialogue(s string) (dialogue, narration []string) {
+	end := 0
+	for _, span := range dialogueSpans(s) {
+		narration = appendUtterance(narration, s[end:span[0]])
+		dialogue = appendUtterance(dialogue, s[span[0]:span[1]])
+		end = span[1]
+	}
+	return dialogue, appendUtterance(narration, s[end:])
+}
+
+// appendUtterance appends the piece of dialogue or narration to the pieces as a sentence: without its quotes and the punctuation between the pieces, and with a period
+// if it doesn't end with a terminator. A piece without letters or numbers is skipped.
+func appendUtterance(pieces []string, piece string) []string {
+	piece = strings.Trim(piece, " \t\r\n\"“”«»‘’—―–,;:")
+	if strings.IndexFunc(piece, func(c rune) bool { return unicode.IsLetter(c) || unicode.IsNumber(c) }) < 0 {
+		return pieces
+	}
+	if !strings.ContainsRune(".!?…", lastRune(piece)) {
+		piece += "."
+	}
+	return append(pieces, piece)
+}
+
+// dialogueSpans returns the byte offsets of the dialogue of the text, in order. See `Analyzer.AnalyzeFiction`.
+// A quote left open at the end of a paragraph is closed there, as the quoted speech of several paragraphs repeats the opening quote in each of them.
+func dialogueSpans(s string) [][2]int {
+	var spans [][2]int
+	start := -1
+	var closing rune
+	open := func(i int, quote rune) {
+		start, closing = i, quote
+	}
+	closeAt := func(i int) {
+		if start >= 0 && i > start {
+			spans = append(spans, [2]int{start, i})
+		}
+		start = -1
+	}
+	lineStart := true
+	for i, char := range s {
+		switch {
+		case char == '\n':
+			if start >= 0 && closing == '\n' {
+				closeAt(i)
+			}
+			if next := strings.TrimLeft(s[i+1:], " \t\r"); strings.HasPrefix(next, "\n") || next == "" {
+				closeAt(i)
+			}
+			lineStart = true
+			continue
+		case start >= 0 && char == closing && (char != '’' || !unicode.IsLetter(firstRune(s[i+len("’"):]))):
+			// The apostrophes inside the single quotes ("‘I don’t know’") don't close them.
+			closeAt(i + utf8.RuneLen(char))
+		case start >= 0 && closing == '\n' && (char == '—' || char == '–') && i > 0 && s[i-1] == ' ':
+			// The narration after a dash inside a line of dash dialogue: "—Wait — she said."
+			closeAt(i)
+		case start < 0 && char == '"':
+			open(i, '"')
+		case start < 0 && char == '“':
+			open(i, '”')
+		case start < 0 && char == '«':
+			open(i, '»')
+		case start < 0 && char == '‘' && (i == 0 || !unicode.IsLetter(lastRune(s[:i]))):
+			open(i, '’')
+		case start < 0 && lineStart && (char == '—' || char == '―'):
+			open(i, '\n')
+		}
+		if !unicode.IsSpace(char) {
+			lineStart = false
+		}
+	}
+	closeAt(len(s))
+	return spans
+}
+
+// firstRune returns the first rune of the string.
+func firstRune(s string) rune {
+	char, _ := utf8.DecodeRuneInString(s)
+	return char
+}
+
+// lastRune returns the last rune of the string.
+func lastRune(s string) rune {
+	char, _ := utf8.DecodeLastRuneInString(s)
+	return char
+}
+
+// dialogueRatio returns the share of the dialogue words rounded to two decimal points, or 0 if there are no words.
+func dialogueRatio(dialogue, words uint) float64 {
+	if words == 0 {
+		return 0
+	}
+	return math.Round(float64(dialogue)/float64(words)*100) / 100
+}
+
+// readingBand returns the band of the grade: the band of the highest grade that doesn't exceed it.
+func readingBand(grade uint) ReadingBand {
+	band := ReadingBands[0]
+	for _, b := range ReadingBands {
+		if b.Grade <= grade {
+			band = b
+		}
+	}
+	return band
+}
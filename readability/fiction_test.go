@@ -0,0 +1,119 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"goreadability/stats"
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeFictionText(t *testing.T) {
+	text := "Chapter 1\n\n“I know,” he said. “Go away.”\n\nShe left the room.\n\nChapter 2\n\nThe rain fell all night.\n"
+	got, err := readability.AnalyzeFictionText(text)
+	if err != nil {
+		t.Fatalf("AnalyzeFictionText() returned error: %v", err)
+	}
+
+	chapters := []struct {
+		title string
+		text  string
+		ratio float64
+	}{
+		// 4 of the 10 words are in the quotes.
+		{"Chapter 1", "“I know,” he said. “Go away.”\n\nShe left the room.", 0.4},
+		{"Chapter 2", "The rain fell all night.", 0},
+	}
+	if len(got.Chapters) != len(chapters) {
+		t.Fatalf("AnalyzeFictionText() has %d chapters, want %d", len(got.Chapters), len(chapters))
+	}
+	for i, chapter := range chapters {
+		want, _ := readability.NewAnalyzer().Analyze(chapter.text, stats.WithFictionProfile())
+		if got.Chapters[i].Title != chapter.title || got.Chapters[i].Stats.Words != want.Stats.Words || got.Chapters[i].DialogueRatio != chapter.ratio {
+			t.Errorf("AnalyzeFictionText() chapter %d = %q, %d words, ratio %v, want %q, %d words, ratio %v",
+				i, got.Chapters[i].Title, got.Chapters[i].Stats.Words, got.Chapters[i].DialogueRatio, chapter.title, want.Stats.Words, chapter.ratio)
+		}
+	}
+	// 4 of the 15 words of the book are in the quotes.
+	if got.DialogueRatio != 0.27 || got.Book.Stats.Words != 15 {
+		t.Errorf("AnalyzeFictionText() = ratio %v of %d words, want 0.27 of 15 words", got.DialogueRatio, got.Book.Stats.Words)
+	}
+
+	parts := []struct {
+		name   string
+		report *readability.Report
+		text   string
+	}{
+		{"dialogue", got.Dialogue, "I know. Go away."},
+		{"narration", got.Narration, "he said. She left the room. The rain fell all night."},
+	}
+	for _, part := range parts {
+		want, _ := readability.NewAnalyzer().Analyze(part.text, stats.WithFictionProfile())
+		if part.report == nil || !reflect.DeepEqual(*part.report, want) {
+			t.Errorf("AnalyzeFictionText() %s = %+v, want the Report of %q", part.name, part.report, part.text)
+		}
+	}
+}
+
+func TestAnalyzeFictionDialogue(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		ratio     float64
+		dialogue  uint
+		narration uint
+	}{
+		{"double quotes", `"Stop!" Tom said. "Now."`, 0.5, 2, 2},
+		{"guillemets", "«Bonjour,» dit-il. Elle sourit.", 0.25, 1, 3},
+		{"single quotes with an apostrophe", "‘I don’t know,’ she said.", 0.6, 3, 2},
+		{"dash dialogue", "—Wait — she said.\nHe waited.", 0.2, 1, 4},
+		{"quote of several paragraphs", "“The first part.\n\n“The second part.”\n\nThe end.", 0.75, 6, 2},
+		{"narration only", "The rain fell all night. Nobody came.", 0, 0, 7},
+	}
+	for _, test := range tests {
+		got, err := readability.AnalyzeFictionText(test.text)
+		if err != nil {
+			t.Errorf("AnalyzeFictionText() %s returned error: %v", test.name, err)
+			continue
+		}
+		var dialogue, narration uint
+		if got.Dialogue != nil {
+			dialogue = got.Dialogue.Stats.Words
+		}
+		if got.Narration != nil {
+			narration = got.Narration.Stats.Words
+		}
+		if got.DialogueRatio != test.ratio || dialogue != test.dialogue || narration != test.narration {
+			t.Errorf("AnalyzeFictionText() %s = ratio %v, %d dialogue words, %d narration words, want %v, %d, %d",
+				test.name, got.DialogueRatio, dialogue, narration, test.ratio, test.dialogue, test.narration)
+		}
+	}
+
+	for _, text := range []string{"", "Chapter 1\n\n\n"} {
+		if _, err := readability.AnalyzeFictionText(text); err == nil {
+			t.Errorf("AnalyzeFictionText(%q) returned no error", text)
+		}
+	}
+}
+
+func TestAnalyzeFictionBand(t *testing.T) {
+	tests := []struct {
+		text     string
+		category string
+	}{
+		{"The cat sat. The dog ran. We had fun.", "Picture books"},
+		{
+			"Notwithstanding the considerable uncertainty surrounding her inheritance, Margaret deliberately postponed any conversation regarding the unfortunate circumstances of her grandfather's disappearance.",
+			"Adult",
+		},
+	}
+	for _, test := range tests {
+		got, err := readability.AnalyzeFictionText(test.text)
+		if err != nil {
+			t.Errorf("AnalyzeFictionText(%q) returned error: %v", test.text, err)
+			continue
+		}
+		if got.Band.Category != test.category {
+			t.Errorf("AnalyzeFictionText(%q) = grade %v, band %+v, want %q", test.text, got.Grade, got.Band, test.category)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package readability
+
+import (
+	"goreadability/input"
+	"goreadability/stats"
+)
+
+// ====== Types ======
+
+// FieldReport represents the Report of a string field of a structured document.
+type FieldReport struct {
+	Path string `json:"path"`
+	Report
+}
+
+// ====== Methods ======
+
+// AnalyzeFields accepts the string fields of a structured document (see `input.ExtractFields`) and options and returns the Report of every field, in the same order.
+// A field without a sentence terminator (a label, a title, a help text without a point) is analyzed as one sentence.
+// The fields without words are skipped, so the result may be shorter than the fields.
+func (a *Analyzer) AnalyzeFields(fields []input.Field, opts ...stats.Option) []FieldReport {
+	var reports []FieldReport
+	for _, field := range fields {
+		text := field.Text
+		if stats.CountWords(text, opts...) == 0 {
+			// The point added to a blank field would be counted as a word.
+			continue
+		}
+		if stats.CountSentences(text, opts...) == 0 {
+			text += "."
+		}
+		report, err := a.Analyze(text, opts...)
+		if err != nil {
+			continue
+		}
+		reports = append(reports, FieldReport{field.Path, report})
+	}
+	return reports
+}
+
+// ====== Functions ======
+
+// AnalyzeOpenAPIFile accepts a path to an OpenAPI or Swagger document (JSON or YAML) and options and returns the reports of its "summary" and "description" fields
+// by their JSON pointers. The headings are kept in the texts of the fields. See `input.ExtractOpenAPI` and `Analyzer.AnalyzeFields`.
+func AnalyzeOpenAPIFile(path string, opts ...stats.Option) ([]FieldReport, error) {
+	fields, err := input.ExtractOpenAPIFile(path, input.BlockOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return NewAnalyzer().AnalyzeFields(fields, opts...), nil
+}
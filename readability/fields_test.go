@@ -0,0 +1,67 @@
+package readability_test
+
+import (
+	"goreadability/input"
+	"goreadability/readability"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeFields(t *testing.T) {
+	fields := []input.Field{{Path: "title", Text: "User settings"}, {Path: "empty", Text: "  "}, {Path: "help", Text: "Choose a name. It is shown to others."}}
+	got := readability.NewAnalyzer().AnalyzeFields(fields)
+
+	// The title without a terminator is scored with a point, and the field without words is skipped.
+	tests := []struct {
+		path string
+		text string
+	}{
+		{"title", "User settings."},
+		{"help", "Choose a name. It is shown to others."},
+	}
+	if len(got) != len(tests) {
+		t.Fatalf("AnalyzeFields() = %d reports, want %d", len(got), len(tests))
+	}
+	for i, test := range tests {
+		want, _ := readability.NewAnalyzer().Analyze(test.text)
+		if got[i].Path != test.path || !reflect.DeepEqual(got[i].Report, want) {
+			t.Errorf("AnalyzeFields()[%d] = %q %+v, want %q %+v", i, got[i].Path, got[i].Report, test.path, want)
+		}
+	}
+}
+
+func TestAnalyzeOpenAPIFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "openapi.yaml")
+	document := "openapi: 3.0.0\ninfo:\n  title: Users\n  description: Manage the users\npaths:\n  /users:\n    get:\n      summary: List the users. Newest first.\n      description: \"\"\n"
+	if err := os.WriteFile(path, []byte(document), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readability.AnalyzeOpenAPIFile(path)
+	if err != nil {
+		t.Fatalf("AnalyzeOpenAPIFile() returned error: %v", err)
+	}
+
+	// The title is not a documentation field, and the empty description is skipped.
+	tests := []struct {
+		path string
+		text string
+	}{
+		{"/info/description", "Manage the users."},
+		{"/paths/~1users/get/summary", "List the users. Newest first."},
+	}
+	if len(got) != len(tests) {
+		t.Fatalf("AnalyzeOpenAPIFile() = %d reports, want %d", len(got), len(tests))
+	}
+	for i, test := range tests {
+		want, _ := readability.NewAnalyzer().Analyze(test.text)
+		if got[i].Path != test.path || !reflect.DeepEqual(got[i].Report, want) {
+			t.Errorf("AnalyzeOpenAPIFile()[%d] = %q %+v, want %q %+v", i, got[i].Path, got[i].Report, test.path, want)
+		}
+	}
+
+	if _, err := readability.AnalyzeOpenAPIFile(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Errorf("AnalyzeOpenAPIFile() of a missing file returned no error")
+	}
+}
@@ -0,0 +1,60 @@
+package readability
+
+import (
+	"goreadability/input"
+	"goreadability/stats"
+)
+
+// ====== Types ======
+
+// SymbolReport represents the Report of the doc comment of a Go declaration. The source location and the kind of the declaration are the ones of `input.DocComment`.
+type SymbolReport struct {
+	Package  string `json:"package"`
+	Symbol   string `json:"symbol"`
+	Kind     string `json:"kind"`
+	Exported bool   `json:"exported"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Report
+}
+
+// ====== Methods ======
+
+// AnalyzeGoDoc accepts the doc comments of Go declarations (see `input.ExtractGoDoc`) and options and returns the Report of every doc comment, in the same order.
+// The doc comments without a word or a sentence (a comment of a heading or a code block only) are skipped, so the result may be shorter than the comments.
+func (a *Analyzer) AnalyzeGoDoc(docs []input.DocComment, opts ...stats.Option) []SymbolReport {
+	var reports []SymbolReport
+	for _, doc := range docs {
+		if len(doc.Text) == 0 {
+			continue
+		}
+		report, err := a.Analyze(doc.Text, opts...)
+		if err != nil {
+			continue
+		}
+		reports = append(reports, SymbolReport{doc.Package, doc.Symbol, doc.Kind, doc.Exported, doc.File, doc.Line, report})
+	}
+	return reports
+}
+
+// ====== Functions ======
+
+// AnalyzeGoPackage accepts a path to a directory of a Go package, whether only the exported declarations are analyzed, and options
+// and returns the reports of the doc comments of the package and its declarations. The headings and list items are kept in the text of the comments.
+// See `input.ExtractGoDocDir` and `Analyzer.AnalyzeGoDoc`.
+func AnalyzeGoPackage(dir string, exportedOnly bool, opts ...stats.Option) ([]SymbolReport, error) {
+	docs, err := input.ExtractGoDocDir(dir, input.BlockOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if exportedOnly {
+		exported := docs[:0]
+		for _, doc := range docs {
+			if doc.Exported {
+				exported = append(exported, doc)
+			}
+		}
+		docs = exported
+	}
+	return NewAnalyzer().AnalyzeGoDoc(docs, opts...), nil
+}
@@ -0,0 +1,114 @@
+package readability
+
+import (
+	"errors"
+	"fmt"
+	"goreadability/stats"
+	"math"
+)
+
+// KINDERGARTEN_AGE is the minimal age of kindergarten (grade 0) readers. Each next grade adds a year.
+const KINDERGARTEN_AGE = 5
+
+// ====== Types ======
+
+// GradePoint maps a score of a metric to a US grade level.
+type GradePoint struct {
+	Score float64 `json:"score"`
+	Grade float64 `json:"grade"`
+}
+
+// GradeScale converts the scores of a metric to US grade levels by linear interpolation between its points.
+// The points must be sorted by score. Scores outside the points are extrapolated by the nearest segment.
+type GradeScale struct {
+	Metric string       `json:"metric"`
+	Points []GradePoint `json:"points"`
+}
+
+// ====== Methods ======
+
+// Grade returns the US grade level for the score. Negative grades are treated as 0.
+// Returns 0 if the scale has less than two points.
+func (s GradeScale) Grade(score Score) float64 {
+	if len(s.Points) < 2 {
+		return 0
+	}
+
+	x := float64(score)
+	i := 1
+	for i < len(s.Points)-1 && x > s.Points[i].Score {
+		i++
+	}
+	a, b := s.Points[i-1], s.Points[i]
+	grade := a.Grade + (x-a.Score)*(b.Grade-a.Grade)/(b.Score-a.Score)
+	return math.Max(grade, 0)
+}
+
+// ====== Tables ======
+
+// GradeScales maps the names of the built-in metrics which scores are not grade levels to their grade scales.
+// The points follow the grade levels of the interpretation tables (see `InterpretationTables`).
+var GradeScales = map[string]GradeScale{
+	"DCR": {
+		"DCR",
+		[]GradePoint{{0, 0}, {5, 5}, {6, 7}, {7, 9}, {8, 11}, {9, 13}, {10, 16}},
+	},
+	"FRES": {
+		"FRES",
+		[]GradePoint{{0, 18}, {10, 16}, {30, 13}, {50, 10}, {60, 8}, {70, 7}, {80, 6}, {90, 5}, {100, 4}},
+	},
+	"Gulpease": {
+		"Gulpease",
+		[]GradePoint{{0, 18}, {40, 12}, {60, 8}, {80, 5}, {100, 1}},
+	},
+}
+
+// ====== Functions ======
+
+// GradeAge returns the minimal age of the readers of the US grade level.
+func GradeAge(grade float64) float64 {
+	return grade + KINDERGARTEN_AGE
+}
+
+// NormalizeGrade accepts the name of a metric and its score and returns the US grade level the score corresponds to.
+// The score is converted by the grade scale of the metric in `GradeScales`, or by the registered GradeMetric with the name.
+// The grade is rounded to the first decimal point. Returns an error if the metric has neither.
+func NormalizeGrade(metric string, score Score) (GradeResult, error) {
+	var grade float64
+	if scale, ok := GradeScales[metric]; ok {
+		grade = scale.Grade(score)
+	} else if m, ok := Lookup(metric); ok {
+		gradeMetric, ok := m.(GradeMetric)
+		if !ok {
+			return GradeResult{}, fmt.Errorf("Metric %q has no grade scale. Cannot normalize grade.", metric)
+		}
+		grade = math.Max(gradeMetric.Grade(score), 0)
+	} else {
+		return GradeResult{}, fmt.Errorf("Unknown metric %q. Cannot normalize grade.", metric)
+	}
+	grade = math.Round(grade*10) / 10
+	return GradeResult{metric, float64(score), grade, GradeAge(grade)}, nil
+}
+
+// NormalizeAll accepts a non-empty string and options, calculates all registered metrics for it, and returns their scores converted to US grade levels, so all metrics can be shown on one scale.
+// Metrics that cannot be calculated for the string or have no grade scale are skipped.
+func NormalizeAll(s string, opts ...stats.Option) ([]GradeResult, error) {
+	if len(s) == 0 {
+		return nil, errors.New("Empty string.")
+	}
+
+	doc := stats.NewDocument(s, opts...)
+	var results []GradeResult
+	for _, m := range Metrics() {
+		score, err := m.Compute(doc)
+		if err != nil {
+			continue
+		}
+		result, err := NormalizeGrade(m.Name(), score)
+		if err != nil {
+			continue
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,160 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"math"
+	"testing"
+)
+
+func TestNormalizeGrade(t *testing.T) {
+	tests := []struct {
+		metric string
+		score  readability.Score
+		grade  float64
+	}{
+		{"DCR", 6.5, 8},
+		{"DCR", 12, 22},
+		{"FRES", 55, 9},
+		{"FRES", 120, 2},
+		{"Gulpease", 70, 6.5},
+		{"Gulpease", 150, 0},
+		{"FKG", 7.25, 7.3},
+		{"FKG", -3, 0},
+	}
+	for _, test := range tests {
+		result, err := readability.NormalizeGrade(test.metric, test.score)
+		if err != nil {
+			t.Errorf("NormalizeGrade(%q, %v) returned error: %v", test.metric, test.score, err)
+			continue
+		}
+		if result.Grade != test.grade || result.Age != readability.GradeAge(test.grade) {
+			t.Errorf("NormalizeGrade(%q, %v) = %+v, want grade %v", test.metric, test.score, result, test.grade)
+		}
+	}
+
+	if _, err := readability.NormalizeGrade("unknown", 1); err == nil {
+		t.Error("NormalizeGrade() of an unknown metric returned no error")
+	}
+}
+
+func TestNormalizeAll(t *testing.T) {
+	results, err := readability.NormalizeAll(string(readTestdata(t, "sample.txt")))
+	if err != nil {
+		t.Fatalf("NormalizeAll() returned error: %v", err)
+	}
+	checkGolden(t, "normalize_all", results)
+
+	if _, err := readability.NormalizeAll(""); err == nil {
+		t.Error("NormalizeAll(\"\") returned no error")
+	}
+}
+
+func TestGradeScale(t *testing.T) {
+	scale := readability.GradeScale{Metric: "test", Points: []readability.GradePoint{{Score: 0, Grade: 10}, {Score: 10, Grade: 5}, {Score: 20, Grade: 4}}}
+	tests := []struct {
+		score readability.Score
+		grade float64
+	}{
+		{0, 10},
+		{5, 7.5},
+		{10, 5},
+		{15, 4.5},
+		// The scores outside the points are extrapolated by the first and the last segments.
+		{-2, 11},
+		{30, 3},
+		// The negative grades are 0.
+		{100, 0},
+	}
+	for _, test := range tests {
+		if got := scale.Grade(test.score); got != test.grade {
+			t.Errorf("Grade(%v) = %v, want %v", test.score, got, test.grade)
+		}
+	}
+
+	if got := (readability.GradeScale{Points: []readability.GradePoint{{Score: 0, Grade: 10}}}).Grade(5); got != 0 {
+		t.Errorf("Grade() of a scale with one point = %v, want 0", got)
+	}
+}
+
+func TestNormalizeAllGrades(t *testing.T) {
+	// The scores of the text are ARI 7, CLI 9.5, DCR 8.87, FRES 78.2, FKG 4.8, SMOG 8.8, Gunning fog 8, and Gulpease 71 (see `TestAnalyzeReport`).
+	text := "The children played on the swings until it was dinnertime."
+	got, err := readability.NormalizeAll(text)
+	if err != nil {
+		t.Fatalf("NormalizeAll(%q) returned error: %v", text, err)
+	}
+	grades := map[string]float64{}
+	for _, result := range got {
+		grades[result.Metric] = result.Grade
+	}
+	tests := []struct {
+		metric string
+		grade  float64
+	}{
+		{"ARI", 6},
+		{"CLI", 9.5},
+		// 11 + 0.87 × (13 - 11) between the points 8 and 9.
+		{"DCR", 12.7},
+		// 7 - 0.82 × (7 - 6) between the points 70 and 80.
+		{"FRES", 6.2},
+		{"FKG", 4.8},
+		{"SMOG", 8.8},
+		{"Gunning fog", 8},
+		// 8 - 0.55 × (8 - 5) between the points 60 and 80.
+		{"Gulpease", 6.4},
+	}
+	for _, test := range tests {
+		if grade, ok := grades[test.metric]; !ok || grade != test.grade {
+			t.Errorf("NormalizeAll(%q) grade of %s = %v, %v, want %v", text, test.metric, grade, ok, test.grade)
+		}
+	}
+}
+
+func FuzzNormalizeGrade(f *testing.F) {
+	f.Add(0.0, 1.0)
+	f.Add(55.5, 60.0)
+	f.Add(-10.0, 200.0)
+	f.Fuzz(func(t *testing.T, a, b float64) {
+		if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+			return
+		}
+		a, b = min(a, b), max(a, b)
+		for metric := range readability.InterpretationTables {
+			low, err := readability.NormalizeGrade(metric, readability.Score(a))
+			if err != nil {
+				t.Fatalf("NormalizeGrade(%q, %v) returned error: %v", metric, a, err)
+			}
+			high, err := readability.NormalizeGrade(metric, readability.Score(b))
+			if err != nil {
+				t.Fatalf("NormalizeGrade(%q, %v) returned error: %v", metric, b, err)
+			}
+			if low.Grade < 0 || high.Grade < 0 {
+				t.Errorf("NormalizeGrade(%q) of %v and %v = %v and %v, want not negative", metric, a, b, low.Grade, high.Grade)
+			}
+			// The grade follows the direction of the metric.
+			if readability.InterpretationTables[metric].Direction == readability.HigherIsEasier {
+				low, high = high, low
+			}
+			if low.Grade > high.Grade {
+				t.Errorf("NormalizeGrade(%q) of %v and %v = %v and %v, want in the direction of the metric", metric, a, b, low.Grade, high.Grade)
+			}
+		}
+	})
+}
+
+func FuzzNormalizeAll(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")))
+	f.Add("One. Two three.")
+	f.Fuzz(func(t *testing.T, text string) {
+		results, err := readability.NormalizeAll(text)
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, results)
+		for _, result := range results {
+			if result.Grade < 0 {
+				t.Errorf("the grade of %s for %q = %v, want not negative", result.Metric, text, result.Grade)
+			}
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package readability_test
+
+import (
+	"goreadability/it"
+	"goreadability/readability"
+	"testing"
+)
+
+func TestGulpeaseResult(t *testing.T) {
+	score, err := it.CalcGulpease(rawText(t, "it"))
+	if err != nil {
+		t.Fatalf("CalcGulpease() returned error: %v", err)
+	}
+	results := []it.GulpeaseResult{it.CalcGulpeaseResult(score)}
+	for _, score := range []uint{0, 39, 40, 59, 60, 79, 80, 100} {
+		results = append(results, it.CalcGulpeaseResult(score))
+	}
+	checkGolden(t, "gulpease", results)
+}
+
+func FuzzGulpeaseResult(f *testing.F) {
+	f.Add(uint(0))
+	f.Add(uint(40))
+	f.Add(uint(79))
+	f.Add(^uint(0))
+	f.Fuzz(func(t *testing.T, score uint) {
+		result := it.CalcGulpeaseResult(score)
+		// A text easy for the less educated readers is easy for the more educated ones.
+		if result.EasyElementary && !result.EasyMiddleSchool || result.EasyMiddleSchool && !result.EasyHighSchool {
+			t.Errorf("CalcGulpeaseResult(%d) = %+v", score, result)
+		}
+		if got := readability.GulpeaseTable.Interpret(readability.Score(score)); got.Description != result.Description() {
+			t.Errorf("GulpeaseTable.Interpret(%d) = %q, want %q", score, got.Description, result.Description())
+		}
+	})
+}
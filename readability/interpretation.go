@@ -0,0 +1,176 @@
+package readability
+
+import (
+	"encoding/json"
+	"fmt"
+	"goreadability/en"
+	"goreadability/it"
+	"math"
+)
+
+// ====== Types ======
+
+// Direction represents which scores of a metric mean easier texts.
+type Direction int
+
+const (
+	// LowerIsEasier means lower scores correspond to easier texts (grade levels).
+	LowerIsEasier Direction = iota
+	// HigherIsEasier means higher scores correspond to easier texts (reading ease scores).
+	HigherIsEasier
+)
+
+// String returns the name of the direction.
+func (d Direction) String() string {
+	if d == HigherIsEasier {
+		return "higher is easier"
+	}
+	return "lower is easier"
+}
+
+// MarshalText encodes the direction as its name, so it is marshaled to JSON as a string.
+func (d Direction) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// InterpretationRange maps the scores from Min (inclusive) to Max (exclusive) to the audience the text is suitable for.
+type InterpretationRange struct {
+	Min      float64 `json:"min"`
+	Max      float64 `json:"max"`
+	Audience string  `json:"audience"`
+}
+
+// InterpretationTable represents the machine-readable interpretation of a metric.
+type InterpretationTable struct {
+	Metric    string                `json:"metric"`
+	Direction Direction             `json:"direction"`
+	Ranges    []InterpretationRange `json:"ranges"`
+}
+
+// ====== Methods ======
+
+// MarshalJSON encodes the range with infinite bounds as null, since JSON has no representation for infinity.
+func (r InterpretationRange) MarshalJSON() ([]byte, error) {
+	bound := func(v float64) *float64 {
+		if math.IsInf(v, 0) {
+			return nil
+		}
+		return &v
+	}
+	return json.Marshal(struct {
+		Min      *float64 `json:"min"`
+		Max      *float64 `json:"max"`
+		Audience string   `json:"audience"`
+	}{bound(r.Min), bound(r.Max), r.Audience})
+}
+
+// Lookup returns the range the score belongs to.
+func (t InterpretationTable) Lookup(score float64) (InterpretationRange, bool) {
+	for _, r := range t.Ranges {
+		if score >= r.Min && score < r.Max {
+			return r, true
+		}
+	}
+	return InterpretationRange{}, false
+}
+
+// Interpret returns the Interpretation of the score with the audience of its range, or "Unknown" if the score is out of all ranges.
+func (t InterpretationTable) Interpret(score Score) Interpretation {
+	if r, ok := t.Lookup(float64(score)); ok {
+		return Interpretation{r.Audience}
+	}
+	return Interpretation{"Unknown"}
+}
+
+// ====== Tables ======
+
+// gradeRanges maps US grade levels to school levels.
+var gradeRanges = []InterpretationRange{
+	{math.Inf(-1), 1, "Kindergarten"},
+	{1, 6, "Elementary school (grades 1-5)"},
+	{6, 9, "Middle school (grades 6-8)"},
+	{9, 13, "High school (grades 9-12)"},
+	{13, 17, "College"},
+	{17, math.Inf(1), "College graduate"},
+}
+
+// ARITable interprets the automated readability index (ARI). It is built from `en.CalcAriResult`.
+var ARITable = func() InterpretationTable {
+	table := InterpretationTable{Metric: "ARI", Direction: LowerIsEasier}
+	for score := 1; score <= 15; score++ {
+		result := en.CalcAriResult(score)
+		max := float64(score + 1)
+		if score == 15 {
+			max = math.Inf(1)
+		}
+		table.Ranges = append(table.Ranges, InterpretationRange{float64(score), max, fmt.Sprintf("Age %s, %s", result.Age(), result.GradeLevel())})
+	}
+	return table
+}()
+
+// CLITable interprets the Coleman–Liau index, which approximates the US grade level.
+var CLITable = InterpretationTable{"CLI", LowerIsEasier, gradeRanges}
+
+// FKGTable interprets the Flesch-Kincaid grade level.
+var FKGTable = InterpretationTable{"FKG", LowerIsEasier, gradeRanges}
+
+// SMOGTable interprets the SMOG grade.
+var SMOGTable = InterpretationTable{"SMOG", LowerIsEasier, gradeRanges}
+
+// GunningFogTable interprets the Gunning fog index.
+var GunningFogTable = InterpretationTable{"Gunning fog", LowerIsEasier, gradeRanges}
+
+// DCRTable interprets the Dale–Chall readability formula.
+var DCRTable = InterpretationTable{
+	"DCR",
+	LowerIsEasier,
+	[]InterpretationRange{
+		{math.Inf(-1), 5, "Average 4th-grade student or lower"},
+		{5, 6, "Average 5th or 6th-grade student"},
+		{6, 7, "Average 7th or 8th-grade student"},
+		{7, 8, "Average 9th or 10th-grade student"},
+		{8, 9, "Average 11th or 12th-grade student"},
+		{9, 10, "Average college student"},
+		{10, math.Inf(1), "College graduate"},
+	},
+}
+
+// FRESTable interprets the Flesch reading ease score.
+var FRESTable = InterpretationTable{
+	"FRES",
+	HigherIsEasier,
+	[]InterpretationRange{
+		{90, math.Inf(1), "5th grade: very easy to read"},
+		{80, 90, "6th grade: easy to read"},
+		{70, 80, "7th grade: fairly easy to read"},
+		{60, 70, "8th and 9th grade: plain English"},
+		{50, 60, "10th to 12th grade: fairly difficult to read"},
+		{30, 50, "College: difficult to read"},
+		{10, 30, "College graduate: very difficult to read"},
+		{math.Inf(-1), 10, "Professional: extremely difficult to read"},
+	},
+}
+
+// GulpeaseTable interprets the Gulpease index by the education level of the readers. It is built from `it.CalcGulpeaseResult`.
+var GulpeaseTable = InterpretationTable{
+	"Gulpease",
+	HigherIsEasier,
+	[]InterpretationRange{
+		{it.GULPEASE_ELEMENTARY_THRESHOLD, math.Inf(1), it.CalcGulpeaseResult(it.GULPEASE_ELEMENTARY_THRESHOLD).Description()},
+		{it.GULPEASE_MIDDLE_SCHOOL_THRESHOLD, it.GULPEASE_ELEMENTARY_THRESHOLD, it.CalcGulpeaseResult(it.GULPEASE_MIDDLE_SCHOOL_THRESHOLD).Description()},
+		{it.GULPEASE_HIGH_SCHOOL_THRESHOLD, it.GULPEASE_MIDDLE_SCHOOL_THRESHOLD, it.CalcGulpeaseResult(it.GULPEASE_HIGH_SCHOOL_THRESHOLD).Description()},
+		{math.Inf(-1), it.GULPEASE_HIGH_SCHOOL_THRESHOLD, it.CalcGulpeaseResult(0).Description()},
+	},
+}
+
+// InterpretationTables lists the interpretation tables of the built-in metrics by metric name.
+var InterpretationTables = map[string]InterpretationTable{
+	ARITable.Metric:        ARITable,
+	CLITable.Metric:        CLITable,
+	DCRTable.Metric:        DCRTable,
+	FRESTable.Metric:       FRESTable,
+	FKGTable.Metric:        FKGTable,
+	SMOGTable.Metric:       SMOGTable,
+	GunningFogTable.Metric: GunningFogTable,
+	GulpeaseTable.Metric:   GulpeaseTable,
+}
@@ -0,0 +1,66 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"math"
+	"testing"
+)
+
+func TestInterpretationTables(t *testing.T) {
+	checkGolden(t, "interpretation", readability.InterpretationTables)
+
+	for name, table := range readability.InterpretationTables {
+		if table.Metric != name {
+			t.Errorf("the table of %s is for %s", name, table.Metric)
+		}
+		m, ok := readability.Lookup(name)
+		if !ok {
+			t.Errorf("the table of %s has no metric", name)
+			continue
+		}
+		for _, r := range table.Ranges {
+			if score := r.Min; !math.IsInf(score, 0) {
+				if got := m.Interpret(readability.Score(score)); got.Description != r.Audience {
+					t.Errorf("%s.Interpret(%v) = %q, want %q", name, score, got.Description, r.Audience)
+				}
+			}
+		}
+	}
+
+	// The scores of ARI start at 1.
+	if got := readability.ARITable.Interpret(0); got.Description != "Unknown" {
+		t.Errorf("ARITable.Interpret(0) = %q, want Unknown", got.Description)
+	}
+	if _, ok := readability.ARITable.Lookup(math.NaN()); ok {
+		t.Errorf("ARITable.Lookup(NaN) found a range")
+	}
+}
+
+func FuzzInterpretationTables(f *testing.F) {
+	f.Add(0.0)
+	f.Add(1.0)
+	f.Add(-1.5)
+	f.Add(59.99)
+	f.Add(math.Inf(1))
+	f.Fuzz(func(t *testing.T, score float64) {
+		for name, table := range readability.InterpretationTables {
+			var found int
+			for _, r := range table.Ranges {
+				if score >= r.Min && score < r.Max {
+					found++
+				}
+			}
+			if found > 1 {
+				t.Errorf("%v is in %d ranges of %s", score, found, name)
+			}
+			// The ranges cover all the scores (the scores of ARI start at 1), except for the infinity, which is above all ranges.
+			if covered := !math.IsNaN(score) && !math.IsInf(score, 1) && (name != "ARI" || score >= 1); covered && found == 0 {
+				t.Errorf("%v is in no range of %s", score, name)
+			}
+			r, ok := table.Lookup(score)
+			if ok != (found == 1) {
+				t.Errorf("%s.Lookup(%v) = %v, %v, want a range in %d ranges", name, score, r, ok, found)
+			}
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package readability
+
+import (
+	"bufio"
+	"errors"
+	"goreadability/stats"
+	"io"
+	"os"
+	"strings"
+)
+
+// ====== Types ======
+
+// Glossary represents the terms of a project: the allowed terms, which the readers are expected to know, and the jargon, which is flagged in texts.
+// The terms are words or phrases matched case-insensitively, and a longer term wins over the terms it starts with. An allowed term wins over the jargon it contains, so with "API" in Jargon and "REST API" in Allowed,
+// only the "API" outside of "REST API" is flagged.
+type Glossary struct {
+	Allowed []string `json:"allowed"`
+	Jargon  []string `json:"jargon"`
+}
+
+// ====== Functions ======
+
+// LoadTerms accepts a reader of a term list and returns its terms. The list has one term (a word or a phrase) per line.
+// Blank lines and lines starting with "#" are skipped, and the whitespaces around the terms are trimmed.
+func LoadTerms(r io.Reader) ([]string, error) {
+	var terms []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		term := strings.TrimSpace(scanner.Text())
+		if term == "" || strings.HasPrefix(term, "#") {
+			continue
+		}
+		terms = append(terms, term)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return terms, nil
+}
+
+// LoadTermsFile accepts a path to a term list file and returns its terms. See `LoadTerms`.
+func LoadTermsFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadTerms(file)
+}
+
+// LoadGlossaryFiles accepts the paths to the allowed term list and the jargon list and returns the Glossary. Either path can be empty to leave the list empty.
+func LoadGlossaryFiles(allowedPath, jargonPath string) (Glossary, error) {
+	var g Glossary
+	var err error
+	if allowedPath != "" {
+		if g.Allowed, err = LoadTermsFile(allowedPath); err != nil {
+			return Glossary{}, err
+		}
+	}
+	if jargonPath != "" {
+		if g.Jargon, err = LoadTermsFile(jargonPath); err != nil {
+			return Glossary{}, err
+		}
+	}
+	return g, nil
+}
+
+// DetectJargon accepts a non-empty string, a glossary, and options and returns the occurrences of the jargon of the glossary in the string, in the order of their positions.
+// The occurrences inside the allowed terms are not flagged, and neither are the stop words if they are configured (see `stats.WithStopWords`).
+func DetectJargon(s string, g Glossary, opts ...stats.Option) ([]stats.Token, error) {
+	if len(s) == 0 {
+		return nil, errors.New("Empty string.")
+	}
+	words := stats.Words(s, opts...)
+	if len(words) == 0 {
+		return nil, errors.New("No words were parsed. Cannot detect jargon.")
+	}
+	return findJargon(s, words, g, stats.NewConfig(opts...).StopWords), nil
+}
+
+// findJargon returns the occurrences of the jargon of the glossary among the words of the text, except for the ones inside the allowed terms and the stop words.
+func findJargon(s string, words []stats.Token, g Glossary, isStopWord func(word string) bool) []stats.Token {
+	allowed := matchPhrases(s, words, g.Allowed)
+	var jargon []stats.Token
+	for _, term := range matchPhrases(s, words, g.Jargon) {
+		for len(allowed) > 0 && allowed[0].End <= term.Start {
+			allowed = allowed[1:]
+		}
+		if len(allowed) > 0 && allowed[0].Start <= term.Start && term.End <= allowed[0].End {
+			continue
+		}
+		if isStopWord != nil && isStopWord(normalizeWord(term.Text)) {
+			continue
+		}
+		jargon = append(jargon, term)
+	}
+	return jargon
+}
@@ -0,0 +1,100 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"goreadability/stats"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDetectJargon(t *testing.T) {
+	g, err := readability.LoadGlossaryFiles(filepath.Join("testdata", "glossary", "allowed.txt"), filepath.Join("testdata", "glossary", "jargon.txt"))
+	if err != nil {
+		t.Fatalf("LoadGlossaryFiles() returned error: %v", err)
+	}
+	text := string(readTestdata(t, "sample.txt"))
+	jargon, err := readability.DetectJargon(text, g)
+	if err != nil {
+		t.Fatalf("DetectJargon() returned error: %v", err)
+	}
+	withoutStopWords, err := readability.DetectJargon(text, g, stats.WithStopWords(func(word string) bool { return stats.IsStopWord("en", word) }))
+	if err != nil {
+		t.Fatalf("DetectJargon() without the stop words returned error: %v", err)
+	}
+	checkGolden(t, "jargon", map[string]any{"glossary": g, "jargon": jargon, "without_stop_words": withoutStopWords})
+
+	if _, err := readability.LoadGlossaryFiles(filepath.Join("testdata", "glossary", "missing.txt"), ""); err == nil {
+		t.Error("LoadGlossaryFiles() of a missing file returned no error")
+	}
+	for _, text := range []string{"", "   "} {
+		if _, err := readability.DetectJargon(text, g); err == nil {
+			t.Errorf("DetectJargon(%q) returned no error", text)
+		}
+	}
+}
+
+func TestDetectJargonTerms(t *testing.T) {
+	tests := []struct {
+		text string
+		g    readability.Glossary
+		opts []stats.Option
+		want []string
+	}{
+		{"Call the API. The api is fast.", readability.Glossary{Jargon: []string{"API"}}, nil, []string{"API", "api"}},
+		// The allowed term wins over the jargon it contains.
+		{"The REST API and the API.", readability.Glossary{Allowed: []string{"REST API"}, Jargon: []string{"API"}}, nil, []string{"API"}},
+		// The words of a phrase may be split by several spaces, and the longer phrase wins.
+		{"Use the load  balancer, not the load.", readability.Glossary{Jargon: []string{"load", "load balancer"}}, nil, []string{"load  balancer", "load"}},
+		{"Deploy the pods.", readability.Glossary{Jargon: []string{"pod"}}, nil, nil},
+		{"It is the end.", readability.Glossary{Jargon: []string{"the", "end"}}, []stats.Option{stats.WithStopWords(func(word string) bool { return word == "the" })}, []string{"end"}},
+	}
+	for _, test := range tests {
+		got, err := readability.DetectJargon(test.text, test.g, test.opts...)
+		if err != nil {
+			t.Errorf("DetectJargon(%q) returned error: %v", test.text, err)
+			continue
+		}
+		checkOccurrences(t, test.text, got)
+		var terms []string
+		for _, term := range got {
+			terms = append(terms, term.Text)
+		}
+		if !slices.Equal(terms, test.want) {
+			t.Errorf("DetectJargon(%q, %+v) = %q, want %q", test.text, test.g, terms, test.want)
+		}
+	}
+
+	list := "# Terms\n  API  \n\nload balancer\n#comment\n"
+	if got, err := readability.LoadTerms(strings.NewReader(list)); err != nil || !slices.Equal(got, []string{"API", "load balancer"}) {
+		t.Errorf("LoadTerms(%q) = %q, %v, want [\"API\" \"load balancer\"]", list, got, err)
+	}
+}
+
+func FuzzDetectJargon(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")), "WHO guidance", "WHO\nguidance")
+	f.Add("The REST API and the API.", "REST API", "API")
+	f.Add("a a a", "a a", "a\n\n# a")
+	f.Fuzz(func(t *testing.T, text, allowed, jargonList string) {
+		terms, err := readability.LoadTerms(strings.NewReader(jargonList))
+		if err != nil {
+			return
+		}
+		g := readability.Glossary{Allowed: []string{allowed}, Jargon: terms}
+		jargon, err := readability.DetectJargon(text, g)
+		if err != nil {
+			return
+		}
+		checkOccurrences(t, text, jargon)
+		// No jargon is inside an allowed term.
+		occurrences, _ := readability.DetectJargon(text, readability.Glossary{Jargon: g.Allowed})
+		for _, term := range jargon {
+			for _, occurrence := range occurrences {
+				if occurrence.Start <= term.Start && term.End <= occurrence.End {
+					t.Errorf("the jargon %+v of %q is inside the allowed term %+v", term, text, occurrence)
+				}
+			}
+		}
+	})
+}
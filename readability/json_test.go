@@ -0,0 +1,95 @@
+package readability_test
+
+import (
+	"encoding/json"
+	"goreadability/en"
+	"goreadability/ja"
+	"goreadability/readability"
+	"goreadability/zh"
+	"reflect"
+	"testing"
+)
+
+// jsonResults are the results of all the types marshaled to JSON.
+type jsonResults struct {
+	ARI       en.AriResult                `json:"ari"`
+	Consensus readability.ConsensusResult `json:"consensus"`
+	Report    readability.Report          `json:"report"`
+	CEFR      readability.CEFRResult      `json:"cefr"`
+	Lexile    readability.LexileResult    `json:"lexile"`
+	Japanese  ja.TextStats                `json:"japanese"`
+	Chinese   zh.TextStats                `json:"chinese"`
+}
+
+// jsonResultsOf returns the results for the English text and the Japanese and Chinese texts in testdata/raw.
+func jsonResultsOf(t testing.TB, text, japanese, chinese string) (jsonResults, bool) {
+	var results jsonResults
+	ari, err := en.CalcAri(text)
+	if err != nil {
+		return results, false
+	}
+	results.ARI = en.CalcAriResult(ari)
+	if results.Consensus, err = readability.Consensus(text); err != nil {
+		return results, false
+	}
+	if results.Report, err = readability.NewAnalyzer().Analyze(text); err != nil {
+		return results, false
+	}
+	if results.CEFR, err = readability.EstimateCEFR(text); err != nil {
+		return results, false
+	}
+	if results.Lexile, err = readability.EstimateLexile(text); err != nil {
+		return results, false
+	}
+	results.Japanese = ja.CountStats(japanese)
+	results.Chinese = zh.CountStats(chinese)
+	return results, true
+}
+
+// checkRoundTrip reports an error if the results are not the same after marshaling and unmarshaling them.
+func checkRoundTrip(t testing.TB, results jsonResults) {
+	t.Helper()
+	data, err := json.Marshal(results)
+	if err != nil {
+		t.Fatalf("the results cannot be marshaled: %v", err)
+	}
+	var decoded jsonResults
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("the results cannot be unmarshaled from %s: %v", data, err)
+	}
+	if !reflect.DeepEqual(decoded, results) {
+		t.Errorf("the results are %+v after the round trip, want %+v", decoded, results)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	results, ok := jsonResultsOf(t, string(readTestdata(t, "sample.txt")), rawText(t, "ja"), rawText(t, "zh"))
+	if !ok {
+		t.Fatal("the results of testdata/sample.txt cannot be calculated")
+	}
+	checkGolden(t, "json", results)
+	checkRoundTrip(t, results)
+
+	var ari en.AriResult
+	if err := json.Unmarshal([]byte(`{"score": "7"}`), &ari); err == nil {
+		t.Error("an AriResult with a string score was unmarshaled with no error")
+	}
+	var level readability.CEFRLevel
+	if err := json.Unmarshal([]byte(`"D1"`), &level); err == nil {
+		t.Error("the CEFR level D1 was unmarshaled with no error")
+	}
+	var script zh.Script
+	if err := json.Unmarshal([]byte(`"Klingon"`), &script); err != nil || script != zh.Unknown {
+		t.Errorf("the script Klingon was unmarshaled as %v, %v, want Unknown", script, err)
+	}
+}
+
+func FuzzJSON(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")), rawText(f, "ja"), rawText(f, "zh"))
+	f.Add("One. Two.", "。", "。")
+	f.Fuzz(func(t *testing.T, text, japanese, chinese string) {
+		if results, ok := jsonResultsOf(t, text, japanese, chinese); ok {
+			checkRoundTrip(t, results)
+		}
+	})
+}
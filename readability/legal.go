@@ -0,0 +1,76 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+)
+
+// ====== Types ======
+
+// ClauseReport represents the Report of a numbered clause of a legal text, with the label and the byte offsets of the clause (see `stats.Clause`).
+type ClauseReport struct {
+	Label string `json:"label,omitempty"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Report
+}
+
+// LegalReport represents the reports of the clauses of a legal text and the Report of the whole text, calculated from the sums of the clause statistics,
+// with the median Flesch-Kincaid grade level of the clauses and the label of the hardest one, for a plain-language review.
+type LegalReport struct {
+	Clauses      []ClauseReport `json:"clauses"`
+	Document     Report         `json:"document"`
+	MedianFKG    float64        `json:"median_fkg"`
+	HardestLabel string         `json:"hardest_label,omitempty"`
+}
+
+// ====== Methods ======
+
+// AnalyzeClauses accepts a non-empty legal text and options and returns the Report of every numbered clause (see `stats.Clauses`) and of the whole text.
+// The text is counted with `stats.WithLegalProfile`. An item of a list that ends with a semicolon or without a terminator ("(a) deliver the goods; and")
+// is analyzed as one sentence. The clauses without words are skipped, and at least one clause must have them.
+func (a *Analyzer) AnalyzeClauses(s string, opts ...stats.Option) (LegalReport, error) {
+	if len(s) == 0 {
+		return LegalReport{}, errors.New("Empty string.")
+	}
+	opts = append([]stats.Option{stats.WithLegalProfile()}, opts...)
+
+	var legal LegalReport
+	var total stats.TotalStats
+	var difficultWords uint
+	var grades []float64
+	hardest := -1.0
+	for _, clause := range stats.Clauses(s) {
+		text := clause.Text
+		if stats.CountWords(text, opts...) == 0 {
+			// The point added to a clause without words would be counted as a word.
+			continue
+		}
+		if stats.CountSentences(text, opts...) == 0 {
+			text += "."
+		}
+		report, err := a.Analyze(text, opts...)
+		if err != nil {
+			continue
+		}
+		legal.Clauses = append(legal.Clauses, ClauseReport{clause.Label, clause.Start, clause.End, report})
+		total = total.Add(report.Stats)
+		difficultWords += report.DifficultWords
+		grades = append(grades, report.FKG)
+		if report.FKG > hardest {
+			hardest = report.FKG
+			legal.HardestLabel = clause.Label
+		}
+	}
+	if len(legal.Clauses) == 0 {
+		return LegalReport{}, errors.New("No clause has words. Cannot analyze the legal text.")
+	}
+
+	var err error
+	if legal.Document, err = reportFromStats(total, difficultWords); err != nil {
+		return LegalReport{}, err
+	}
+	legal.MedianFKG = math.Round(median(grades)*100) / 100
+	return legal, nil
+}
@@ -0,0 +1,54 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"testing"
+)
+
+func TestAnalyzeClauses(t *testing.T) {
+	text := "1. The Buyer pays the price.\n2. The Seller delivers the goods; and\n3. Notwithstanding any contrary provision, the indemnifying party shall reimburse all reasonable expenses."
+	got, err := readability.NewAnalyzer().AnalyzeClauses(text)
+	if err != nil {
+		t.Fatalf("AnalyzeClauses() returned error: %v", err)
+	}
+
+	clauses := []struct {
+		label     string
+		text      string
+		words     uint
+		syllables uint
+		fkg       float64
+	}{
+		// 0.39 × 5 + 11.8 × 5 / 5 - 15.59.
+		{"1.", "The Buyer pays the price.", 5, 5, -1.8},
+		// The item without a terminator is one sentence: 0.39 × 6 + 11.8 × 9 / 6 - 15.59.
+		{"2.", "The Seller delivers the goods; and", 6, 9, 4.5},
+		// 0.39 × 12 + 11.8 × 30 / 12 - 15.59.
+		{"3.", "Notwithstanding any contrary provision, the indemnifying party shall reimburse all reasonable expenses.", 12, 30, 18.6},
+	}
+	if len(got.Clauses) != len(clauses) {
+		t.Fatalf("AnalyzeClauses() = %d clauses, want %d", len(got.Clauses), len(clauses))
+	}
+	for i, want := range clauses {
+		clause := got.Clauses[i]
+		if clause.Label != want.label || text[clause.Start:clause.End] != want.text || clause.Stats.Words != want.words || clause.Stats.Sentences != 1 ||
+			clause.Stats.Syllables != want.syllables || clause.FKG != want.fkg {
+			t.Errorf("AnalyzeClauses() clause %d = %q %q %+v FKG %v, want %+v", i, clause.Label, text[clause.Start:clause.End], clause.Stats, clause.FKG, want)
+		}
+	}
+	if got.Document.Stats.Words != 23 || got.Document.Stats.Sentences != 3 || got.MedianFKG != 4.5 || got.HardestLabel != "3." {
+		t.Errorf("AnalyzeClauses() = %+v, median FKG %v, hardest %q, want 23 words, 3 sentences, 4.5, \"3.\"", got.Document.Stats, got.MedianFKG, got.HardestLabel)
+	}
+
+	// The clause of a label only is skipped.
+	text = "1. (a)\n2. The Buyer pays."
+	if got, err := readability.NewAnalyzer().AnalyzeClauses(text); err != nil || len(got.Clauses) != 1 || got.Clauses[0].Label != "2." || got.Document.Stats.Words != 3 {
+		t.Errorf("AnalyzeClauses(%q) = %+v, %v, want the clause \"2.\" of 3 words only", text, got.Clauses, err)
+	}
+
+	for _, text := range []string{"", "   ", "1.\n2.", "1. (a)"} {
+		if _, err := readability.NewAnalyzer().AnalyzeClauses(text); err == nil {
+			t.Errorf("AnalyzeClauses(%q) returned no error", text)
+		}
+	}
+}
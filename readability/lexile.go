@@ -0,0 +1,78 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+)
+
+// ====== Types ======
+
+// LexileBand represents the approximate range of Lexile-style measures typical for texts of a US grade level.
+type LexileBand struct {
+	Grade uint `json:"grade"`
+	Min   int  `json:"min"`
+	Max   int  `json:"max"`
+}
+
+// LexileBands maps US grade levels to approximate Lexile-style bands. Grades above the last band use the last band.
+// The table is an approximation compiled from publicly available grade-to-Lexile charts and is exposed so callers can replace it.
+var LexileBands = []LexileBand{
+	{0, 0, 300},
+	{1, 190, 530},
+	{2, 420, 650},
+	{3, 520, 820},
+	{4, 740, 940},
+	{5, 830, 1010},
+	{6, 925, 1070},
+	{7, 970, 1120},
+	{8, 1010, 1185},
+	{9, 1050, 1260},
+	{10, 1080, 1335},
+	{11, 1185, 1385},
+	{12, 1185, 1385},
+	{13, 1300, 1600},
+}
+
+// LexileResult represents the approximate Lexile-style measure of a text.
+type LexileResult struct {
+	Grade   float64    `json:"grade"`
+	Band    LexileBand `json:"band"`
+	Measure int        `json:"measure"`
+}
+
+// ====== Functions ======
+
+// EstimateLexile accepts a non-empty English string and returns an approximate Lexile-style measure for it.
+// The consensus grade level of the text (see `Consensus`) is looked up in `LexileBands`, and the measure is interpolated inside the band by the fractional part of the grade.
+// The result is an approximation for comparison with commercial leveling tools, not an official Lexile measure.
+func EstimateLexile(s string, opts ...stats.Option) (LexileResult, error) {
+	if len(s) == 0 {
+		return LexileResult{}, errors.New("Empty string.")
+	}
+	if len(LexileBands) == 0 {
+		return LexileResult{}, errors.New("No Lexile bands defined. Cannot estimate Lexile measure.")
+	}
+
+	consensus, err := Consensus(s, opts...)
+	if err != nil {
+		return LexileResult{}, err
+	}
+
+	var result LexileResult
+	result.Grade = consensus.Median
+	whole, fraction := math.Modf(result.Grade)
+	result.Band = lexileBand(uint(whole))
+	result.Measure = result.Band.Min + int(math.Round(fraction*float64(result.Band.Max-result.Band.Min)))
+	return result, nil
+}
+
+// lexileBand returns the band of the grade, or the last band if the grade exceeds all of them.
+func lexileBand(grade uint) LexileBand {
+	for _, band := range LexileBands {
+		if band.Grade == grade {
+			return band
+		}
+	}
+	return LexileBands[len(LexileBands)-1]
+}
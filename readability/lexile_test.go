@@ -0,0 +1,75 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"testing"
+)
+
+func TestEstimateLexile(t *testing.T) {
+	results := map[string]readability.LexileResult{}
+	for name, text := range map[string]string{
+		"sample": string(readTestdata(t, "sample.txt")),
+		"simple": "The cat sat on the mat. It was a good day. We had fun.",
+	} {
+		result, err := readability.EstimateLexile(text)
+		if err != nil {
+			t.Fatalf("EstimateLexile(%q) returned error: %v", text, err)
+		}
+		results[name] = result
+	}
+	checkGolden(t, "lexile", results)
+
+	bands := readability.LexileBands
+	defer func() { readability.LexileBands = bands }()
+	readability.LexileBands = nil
+	if _, err := readability.EstimateLexile("The cat sat on the mat."); err == nil {
+		t.Errorf("EstimateLexile() without bands returned no error")
+	}
+}
+
+func TestEstimateLexileMeasure(t *testing.T) {
+	tests := []struct {
+		text    string
+		grade   float64
+		band    readability.LexileBand
+		measure int
+	}{
+		{"The cat sat on the mat.", 0, readability.LexileBand{Grade: 0, Min: 0, Max: 300}, 0},
+		{"The children played on the swings until it was dinnertime.", 8, readability.LexileBand{Grade: 8, Min: 1010, Max: 1185}, 1010},
+		// The measure is four fifths of the way from the minimum to the maximum of the band: 740 + 0.8 × 200.
+		{"My sister likes to paint pictures of the garden in the morning.", 4.8, readability.LexileBand{Grade: 4, Min: 740, Max: 940}, 900},
+	}
+	for _, test := range tests {
+		got, err := readability.EstimateLexile(test.text)
+		if err != nil {
+			t.Errorf("EstimateLexile(%q) returned error: %v", test.text, err)
+			continue
+		}
+		if got.Grade != test.grade || got.Band != test.band || got.Measure != test.measure {
+			t.Errorf("EstimateLexile(%q) = %+v, want grade %v, band %+v, measure %d", test.text, got, test.grade, test.band, test.measure)
+		}
+	}
+
+	// A grade above the bands uses the last band.
+	bands := readability.LexileBands
+	defer func() { readability.LexileBands = bands }()
+	readability.LexileBands = []readability.LexileBand{{Grade: 0, Min: 0, Max: 300}, {Grade: 1, Min: 190, Max: 530}}
+	text := "The children played on the swings until it was dinnertime."
+	if got, err := readability.EstimateLexile(text); err != nil || got.Band.Grade != 1 || got.Measure != 190 {
+		t.Errorf("EstimateLexile(%q) = %+v, %v, want the band of grade 1 and the measure 190", text, got, err)
+	}
+}
+
+func FuzzEstimateLexile(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")))
+	f.Add("Antidisestablishmentarianism notwithstanding.")
+	f.Fuzz(func(t *testing.T, text string) {
+		result, err := readability.EstimateLexile(text)
+		if err != nil {
+			return
+		}
+		if result.Measure < result.Band.Min || result.Measure > result.Band.Max {
+			t.Errorf("EstimateLexile(%q).Measure = %d, want it in the band %+v", text, result.Measure, result.Band)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package readability
+
+import (
+	"goreadability/input"
+	"goreadability/lang"
+	"goreadability/stats"
+	"strings"
+)
+
+// ====== Types ======
+
+// MessageReport represents the scores of the source text and the translation of a localization message, by the metrics of their languages (see `LanguageMetrics`).
+// Source or Translation is nil if the text is missing or cannot be analyzed.
+// WordRatio is the number of words of the translation per word of the source, or 0 if either has no words. A ratio far from the usual one of the language pair
+// points at a translation that expands or condenses the message.
+type MessageReport struct {
+	Key         string      `json:"key"`
+	Context     string      `json:"context,omitempty"`
+	Source      *AutoReport `json:"source,omitempty"`
+	Translation *AutoReport `json:"translation,omitempty"`
+	WordRatio   float64     `json:"word_ratio"`
+}
+
+// ====== Functions ======
+
+// AnalyzeCatalog accepts the messages of a localization file (see `input.ExtractCatalogFile`) and options and returns the report of every message, in the same order.
+// The source texts are scored by the metrics of the source language of the catalog, and the translations by the metrics of the target language,
+// English by default. The languages are ISO 639-1 codes or locales ("de_DE", "pt-BR"), of which the language code is used.
+// The placeholders are counted as one-syllable words (see `stats.WithPlaceholders`), and a message without a sentence terminator (a label, a button)
+// is scored as one sentence. The messages with neither text scored are skipped, so the result may be shorter than the messages.
+func AnalyzeCatalog(catalog input.Catalog, opts ...stats.Option) []MessageReport {
+	sourceLanguage := catalogLanguage(catalog.SourceLanguage)
+	targetLanguage := catalogLanguage(catalog.TargetLanguage)
+
+	var reports []MessageReport
+	for _, message := range catalog.Messages {
+		report := MessageReport{Key: message.Key, Context: message.Context}
+		source, sourceWords := analyzeMessage(message.Source, sourceLanguage, opts)
+		translation, translationWords := analyzeMessage(message.Translation, targetLanguage, opts)
+		if source == nil && translation == nil {
+			continue
+		}
+		report.Source, report.Translation = source, translation
+		if sourceWords > 0 && translationWords > 0 {
+			report.WordRatio = float64(translationWords) / float64(sourceWords)
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// analyzeMessage returns the scores of the message text in the language and its number of words, or nil if the text is empty or cannot be analyzed.
+func analyzeMessage(text, language string, opts []stats.Option) (*AutoReport, uint) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, 0
+	}
+	opts = append([]stats.Option{stats.WithPlaceholders()}, opts...)
+	languageOpts := append([]stats.Option{stats.WithLanguage(language)}, opts...)
+	if stats.CountSentences(text, languageOpts...) == 0 {
+		text += "."
+	}
+	report, err := analyzeLanguage(text, language, opts)
+	if err != nil {
+		return nil, 0
+	}
+	return &report, stats.CountWords(text, languageOpts...)
+}
+
+// catalogLanguage returns the ISO 639-1 code of the language or locale of a catalog ("pt-BR" is "pt"), or English if it is not declared.
+func catalogLanguage(locale string) string {
+	if locale == "" {
+		return lang.English
+	}
+	code, _, _ := strings.Cut(strings.ToLower(locale), "_")
+	code, _, _ = strings.Cut(code, "-")
+	return code
+}
@@ -0,0 +1,48 @@
+package readability_test
+
+import (
+	"goreadability/input"
+	"goreadability/readability"
+	"testing"
+)
+
+func TestAnalyzeCatalog(t *testing.T) {
+	catalog := input.Catalog{SourceLanguage: "en_US", TargetLanguage: "de-DE", Messages: []input.Message{
+		{Key: "save", Source: "Save the file.", Translation: "Datei speichern."},
+		{Key: "greeting", Context: "home", Source: "Hello, {name}", Translation: ""},
+		{Key: "blank", Source: "  "},
+	}}
+	got := readability.AnalyzeCatalog(catalog)
+
+	// The blank message is skipped, and the source without a terminator is scored as one sentence.
+	tests := []struct {
+		key         string
+		context     string
+		source      string
+		translation string
+		ratio       float64
+	}{
+		{"save", "", "en", "de", 2.0 / 3},
+		{"greeting", "home", "en", "", 0},
+	}
+	if len(got) != len(tests) {
+		t.Fatalf("AnalyzeCatalog() = %d reports, want %d", len(got), len(tests))
+	}
+	for i, test := range tests {
+		report := got[i]
+		var source, translation string
+		if report.Source != nil {
+			source = report.Source.Language
+		}
+		if report.Translation != nil {
+			translation = report.Translation.Language
+		}
+		if report.Key != test.key || report.Context != test.context || source != test.source || translation != test.translation || report.WordRatio != test.ratio {
+			t.Errorf("AnalyzeCatalog()[%d] = %q %q, %q, %q, ratio %v, want %q %q, %q, %q, ratio %v",
+				i, report.Key, report.Context, source, translation, report.WordRatio, test.key, test.context, test.source, test.translation, test.ratio)
+		}
+	}
+	if got[0].Translation == nil || len(got[0].Translation.Results) == 0 || got[0].Translation.Results[0].Metric != "Tränkle-Bailer 1" {
+		t.Errorf("AnalyzeCatalog() translation = %+v, want the German metrics", got[0].Translation)
+	}
+}
@@ -0,0 +1,112 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"sort"
+	"strings"
+)
+
+// HEALTH_LITERACY_GRADE is the US grade level the patient materials are recommended to be written at.
+const HEALTH_LITERACY_GRADE = 6
+
+// HEALTH_LITERACY_MAX_GRADE is the highest acceptable US grade level of the patient materials.
+const HEALTH_LITERACY_MAX_GRADE = 8
+
+// ====== Types ======
+
+// MedicalTerm represents an occurrence of medical jargon in a text, with the lay alternative that can replace it ("hypertension", "high blood pressure").
+type MedicalTerm struct {
+	stats.Token
+	Alternative string `json:"alternative"`
+}
+
+// HealthLiteracyResult represents the check of a patient-facing text against the health-literacy targets: the consensus grade level of the text,
+// whether it is at or below the recommended grade (Recommended) and the highest acceptable one (Acceptable), and the medical jargon to replace.
+type HealthLiteracyResult struct {
+	Consensus   ConsensusResult `json:"consensus"`
+	Grade       float64         `json:"grade"`
+	Recommended bool            `json:"recommended"`
+	Acceptable  bool            `json:"acceptable"`
+	Jargon      []MedicalTerm   `json:"jargon,omitempty"`
+}
+
+// medicalJargon maps the medical terms to their lay alternatives. The terms are in lower case, and a phrase is one term.
+var medicalJargon = map[string]string{
+	"abdomen": "belly", "abdominal": "belly", "abrasion": "scrape", "acute": "sudden", "adverse": "harmful", "ambulate": "walk",
+	"analgesic": "pain reliever", "anemia": "low red blood cell count", "anterior": "front", "antipyretic": "fever reducer", "arrhythmia": "irregular heartbeat",
+	"benign": "not cancer", "bilateral": "on both sides", "biopsy": "tissue sample", "bradycardia": "slow heartbeat", "cardiac": "heart",
+	"carcinoma": "cancer", "cerebrovascular accident": "stroke", "chronic": "long-lasting", "comorbidity": "other health problem", "contraindicated": "not safe to use",
+	"contusion": "bruise", "cutaneous": "skin", "dermatitis": "skin rash", "diaphoresis": "sweating", "dyspnea": "shortness of breath",
+	"edema": "swelling", "emesis": "vomiting", "erythema": "redness", "etiology": "cause", "febrile": "feverish", "fracture": "broken bone",
+	"gastrointestinal": "stomach and bowel", "hematoma": "bruise", "hemorrhage": "heavy bleeding", "hepatic": "liver", "hyperglycemia": "high blood sugar",
+	"hyperlipidemia": "high cholesterol", "hypertension": "high blood pressure", "hypoglycemia": "low blood sugar", "hypotension": "low blood pressure",
+	"idiopathic": "of unknown cause", "inflammation": "swelling and redness", "intravenous": "into a vein", "lesion": "sore", "malignant": "cancerous",
+	"metastasis": "spread of cancer", "myocardial infarction": "heart attack", "nausea": "upset stomach", "nephrology": "kidney care", "neoplasm": "tumor",
+	"nocturnal": "at night", "oral": "by mouth", "orally": "by mouth", "otitis media": "ear infection", "palpitations": "pounding heartbeat",
+	"pathogen": "germ", "posterior": "back", "prognosis": "outlook", "prophylaxis": "prevention", "pruritus": "itching", "pulmonary": "lung",
+	"renal": "kidney", "sedentary": "inactive", "subcutaneous": "under the skin", "sutures": "stitches", "syncope": "fainting", "tachycardia": "fast heartbeat",
+	"topical": "on the skin", "vertigo": "dizziness",
+}
+
+// medicalJargonTerms are the terms of medicalJargon with the phrases first, so a phrase wins over the words it contains.
+var medicalJargonTerms = func() []string {
+	terms := make([]string, 0, len(medicalJargon))
+	for term := range medicalJargon {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if wi, wj := len(strings.Fields(terms[i])), len(strings.Fields(terms[j])); wi != wj {
+			return wi > wj
+		}
+		return terms[i] < terms[j]
+	})
+	return terms
+}()
+
+// ====== Functions ======
+
+// DetectMedicalJargon accepts a non-empty English string and options and returns the occurrences of the medical jargon in it, with their lay alternatives,
+// in the order of their positions ("hypertension" is "high blood pressure", "myocardial infarction" is "heart attack"). The terms are matched case-insensitively.
+func DetectMedicalJargon(s string, opts ...stats.Option) ([]MedicalTerm, error) {
+	if len(s) == 0 {
+		return nil, errors.New("Empty string.")
+	}
+	words := stats.Words(s, opts...)
+	if len(words) == 0 {
+		return nil, errors.New("No words were parsed. Cannot detect medical jargon.")
+	}
+	return findMedicalJargon(s, words), nil
+}
+
+// CheckHealthLiteracy accepts a non-empty English string and options and checks it against the health-literacy targets: the median grade level of the metrics
+// (see `Consensus`) is compared with HEALTH_LITERACY_GRADE and HEALTH_LITERACY_MAX_GRADE, and the medical jargon is reported with its lay alternatives.
+// The text is counted with `stats.WithMedicalProfile`.
+func CheckHealthLiteracy(s string, opts ...stats.Option) (HealthLiteracyResult, error) {
+	opts = append([]stats.Option{stats.WithMedicalProfile()}, opts...)
+	consensus, err := Consensus(s, opts...)
+	if err != nil {
+		return HealthLiteracyResult{}, err
+	}
+	result := HealthLiteracyResult{
+		Consensus:   consensus,
+		Grade:       consensus.Median,
+		Recommended: consensus.Median <= HEALTH_LITERACY_GRADE,
+		Acceptable:  consensus.Median <= HEALTH_LITERACY_MAX_GRADE,
+		Jargon:      findMedicalJargon(s, stats.Words(s, opts...)),
+	}
+	return result, nil
+}
+
+// findMedicalJargon returns the occurrences of the medical jargon among the words of the text, with their lay alternatives.
+func findMedicalJargon(s string, words []stats.Token) []MedicalTerm {
+	var terms []MedicalTerm
+	for _, occurrence := range matchPhrases(s, words, medicalJargonTerms) {
+		fields := strings.Fields(occurrence.Text)
+		for i, field := range fields {
+			fields[i] = normalizeWord(field)
+		}
+		terms = append(terms, MedicalTerm{occurrence, medicalJargon[strings.Join(fields, " ")]})
+	}
+	return terms
+}
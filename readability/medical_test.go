@@ -0,0 +1,99 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"goreadability/stats"
+	"testing"
+)
+
+func TestDetectMedicalJargon(t *testing.T) {
+	tests := []struct {
+		text string
+		want [][2]string
+	}{
+		{"Your blood pressure is fine.", nil},
+		{"You have hypertension and mild edema.", [][2]string{{"hypertension", "high blood pressure"}, {"edema", "swelling"}}},
+		{"Hypertension. HYPERTENSION!", [][2]string{{"Hypertension", "high blood pressure"}, {"HYPERTENSION", "high blood pressure"}}},
+		// A phrase wins over the words it contains, and its words may be split by several spaces.
+		{"He had a myocardial  infarction and acute pain.", [][2]string{{"myocardial  infarction", "heart attack"}, {"acute", "sudden"}}},
+		{"Take the analgesic orally, twice a day.", [][2]string{{"analgesic", "pain reliever"}, {"orally", "by mouth"}}},
+	}
+	for _, test := range tests {
+		got, err := readability.DetectMedicalJargon(test.text)
+		if err != nil {
+			t.Errorf("DetectMedicalJargon(%q) returned error: %v", test.text, err)
+			continue
+		}
+		checkOccurrences(t, test.text, tokens(got))
+		if len(got) != len(test.want) {
+			t.Errorf("DetectMedicalJargon(%q) = %+v, want %v", test.text, got, test.want)
+			continue
+		}
+		for i, term := range got {
+			if term.Text != test.want[i][0] || term.Alternative != test.want[i][1] {
+				t.Errorf("DetectMedicalJargon(%q)[%d] = %q (%q), want %q (%q)", test.text, i, term.Text, term.Alternative, test.want[i][0], test.want[i][1])
+			}
+		}
+	}
+
+	for _, text := range []string{"", "..."} {
+		if _, err := readability.DetectMedicalJargon(text); err == nil {
+			t.Errorf("DetectMedicalJargon(%q) returned no error", text)
+		}
+	}
+}
+
+func TestCheckHealthLiteracy(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		recommended bool
+		acceptable  bool
+		jargon      int
+	}{
+		{"plain", "Take one pill each day. Drink a lot of water. Call us if you feel sick. Rest when you are tired.", true, true, 0},
+		{
+			"acceptable",
+			"Your doctor prescribed this medicine to lower your blood pressure. Take it every morning with breakfast, and do not stop taking it without talking to your doctor first.",
+			false, true, 0,
+		},
+		{
+			"technical",
+			"Patients presenting with uncontrolled hypertension and concomitant hyperlipidemia necessitate comprehensive pharmacological intervention. " +
+				"Subsequent cardiovascular complications, including myocardial infarction, demonstrate considerable correlation with inadequate therapeutic adherence.",
+			false, false, 3,
+		},
+	}
+	for _, test := range tests {
+		got, err := readability.CheckHealthLiteracy(test.text)
+		if err != nil {
+			t.Errorf("CheckHealthLiteracy() %s returned error: %v", test.name, err)
+			continue
+		}
+		if got.Recommended != test.recommended || got.Acceptable != test.acceptable || len(got.Jargon) != test.jargon {
+			t.Errorf("CheckHealthLiteracy() %s = grade %v, recommended %v, acceptable %v, %d terms, want %v, %v, %d terms",
+				test.name, got.Grade, got.Recommended, got.Acceptable, len(got.Jargon), test.recommended, test.acceptable, test.jargon)
+		}
+		// The grade is the median of the consensus of the text counted with the medical profile.
+		consensus, err := readability.Consensus(test.text, stats.WithMedicalProfile())
+		if err != nil || got.Grade != consensus.Median {
+			t.Errorf("CheckHealthLiteracy() %s grade = %v, want %v (%v)", test.name, got.Grade, consensus.Median, err)
+		}
+		if got.Recommended != (got.Grade <= readability.HEALTH_LITERACY_GRADE) || got.Acceptable != (got.Grade <= readability.HEALTH_LITERACY_MAX_GRADE) {
+			t.Errorf("CheckHealthLiteracy() %s = grade %v, recommended %v, acceptable %v", test.name, got.Grade, got.Recommended, got.Acceptable)
+		}
+	}
+
+	if _, err := readability.CheckHealthLiteracy(""); err == nil {
+		t.Errorf("CheckHealthLiteracy(%q) returned no error", "")
+	}
+}
+
+// tokens returns the tokens of the medical terms.
+func tokens(terms []readability.MedicalTerm) []stats.Token {
+	result := make([]stats.Token, len(terms))
+	for i, term := range terms {
+		result[i] = term.Token
+	}
+	return result
+}
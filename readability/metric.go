@@ -0,0 +1,218 @@
+package readability
+
+import (
+	"errors"
+	"fmt"
+	"goreadability/en"
+	"goreadability/it"
+	"goreadability/lang"
+	"goreadability/stats"
+	"strings"
+	"sync"
+)
+
+// ====== Types ======
+
+// Score represents the raw value of a readability metric.
+type Score float64
+
+// Interpretation represents the human-readable meaning of a Score.
+type Interpretation struct {
+	Description string `json:"description"`
+}
+
+// Metric represents a readability formula.
+type Metric interface {
+	// Name returns the unique name of the metric.
+	Name() string
+	// Compute returns the score of the metric for the document.
+	Compute(doc *stats.Document) (Score, error)
+	// Interpret returns the meaning of the score.
+	Interpret(score Score) Interpretation
+}
+
+// GradeMetric represents a readability formula which score can be converted to a US grade level.
+type GradeMetric interface {
+	Metric
+	// Grade returns the US grade level for the score.
+	Grade(score Score) float64
+}
+
+// funcMetric implements Metric with functions.
+type funcMetric struct {
+	name      string
+	compute   func(*stats.Document) (Score, error)
+	interpret func(Score) Interpretation
+}
+
+func (m funcMetric) Name() string                               { return m.name }
+func (m funcMetric) Compute(doc *stats.Document) (Score, error) { return m.compute(doc) }
+func (m funcMetric) Interpret(score Score) Interpretation       { return m.interpret(score) }
+
+// funcGradeMetric implements GradeMetric with functions.
+type funcGradeMetric struct {
+	funcMetric
+	grade func(Score) float64
+}
+
+func (m funcGradeMetric) Grade(score Score) float64 { return m.grade(score) }
+
+// registry keeps the registered metrics in the order of registration, and the languages of the metrics by name.
+var registry = struct {
+	sync.RWMutex
+	metrics   []Metric
+	byName    map[string]Metric
+	languages map[string]string
+}{byName: map[string]Metric{}, languages: map[string]string{}}
+
+// ====== Functions ======
+
+// NewMetric accepts a name, a compute function, and an interpret function and returns a Metric built from them.
+func NewMetric(name string, compute func(*stats.Document) (Score, error), interpret func(Score) Interpretation) Metric {
+	return funcMetric{name, compute, interpret}
+}
+
+// NewGradeMetric accepts a name, a compute function, an interpret function, and a function converting the score to a US grade level and returns a GradeMetric built from them.
+func NewGradeMetric(name string, compute func(*stats.Document) (Score, error), interpret func(Score) Interpretation, grade func(Score) float64) GradeMetric {
+	return funcGradeMetric{funcMetric{name, compute, interpret}, grade}
+}
+
+// Register adds the metric to the registry for the texts in every language, so it is used by `Consensus` (if it is a GradeMetric) and returned by `Metrics`.
+// Returns an error if a metric with the same name is already registered. See `RegisterLanguage`.
+func Register(m Metric) error {
+	return RegisterLanguage("", m)
+}
+
+// RegisterLanguage adds the metric to the registry for the texts in the language, given by its ISO 639-1 code ("en", "it", "es"), or for every language if the code is empty.
+// `Consensus` uses the grade metrics of the language of the text only (see `stats.WithLanguage`), while `Metrics` and `ComputeAll` include the metrics of all the languages.
+// Returns an error if a metric with the same name is already registered.
+func RegisterLanguage(language string, m Metric) error {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, ok := registry.byName[m.Name()]; ok {
+		return fmt.Errorf("Metric %q is already registered.", m.Name())
+	}
+	registry.metrics = append(registry.metrics, m)
+	registry.byName[m.Name()] = m
+	registry.languages[m.Name()] = strings.ToLower(language)
+	return nil
+}
+
+// Lookup returns the registered metric with the given name.
+func Lookup(name string) (Metric, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	m, ok := registry.byName[name]
+	return m, ok
+}
+
+// Metrics returns all registered metrics in the order of registration.
+func Metrics() []Metric {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	metrics := make([]Metric, len(registry.metrics))
+	copy(metrics, registry.metrics)
+	return metrics
+}
+
+// MetricLanguage returns the ISO 639-1 code of the language of the registered metric with the given name (see `RegisterLanguage`), or an empty string if it is for every language,
+// and whether the metric is registered.
+func MetricLanguage(name string) (string, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	language, ok := registry.languages[name]
+	return language, ok
+}
+
+// LanguageMetrics returns the names of the metrics registered for the texts in the language, given by its ISO 639-1 code, in the order of registration.
+// The metrics registered for every language are not included. See `RegisterLanguage`.
+func LanguageMetrics(language string) []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	language = strings.ToLower(language)
+	var names []string
+	for _, m := range registry.metrics {
+		if language != "" && registry.languages[m.Name()] == language {
+			names = append(names, m.Name())
+		}
+	}
+	return names
+}
+
+// ComputeAll accepts a non-empty string and options and returns the scores of all registered metrics that can be calculated for it, mapped by metric name.
+func ComputeAll(s string, opts ...stats.Option) (map[string]Score, error) {
+	if len(s) == 0 {
+		return nil, errors.New("Empty string.")
+	}
+
+	doc := stats.NewDocument(s, opts...)
+	scores := map[string]Score{}
+	for _, m := range Metrics() {
+		if score, err := m.Compute(doc); err == nil {
+			scores[m.Name()] = score
+		}
+	}
+	return scores, nil
+}
+
+// float64Metric adapts a float64 calculation to the signature of Metric.Compute.
+func float64Metric(calc func(*stats.Document) (float64, error)) func(*stats.Document) (Score, error) {
+	return func(doc *stats.Document) (Score, error) {
+		score, err := calc(doc)
+		return Score(score), err
+	}
+}
+
+// sameGrade returns the score as a US grade level.
+func sameGrade(score Score) float64 {
+	return float64(score)
+}
+
+func init() {
+	english := []Metric{
+		NewGradeMetric(
+			"ARI",
+			func(doc *stats.Document) (Score, error) {
+				score, err := en.CalcAriDoc(doc)
+				return Score(score), err
+			},
+			ARITable.Interpret,
+			// ARI score 1 corresponds to kindergarten (see `en.CalcAriResult`).
+			func(score Score) float64 { return float64(score) - 1 },
+		),
+		NewGradeMetric("CLI", float64Metric(en.CalcCliDoc), CLITable.Interpret, sameGrade),
+		NewMetric("DCR", float64Metric(en.CalcDCRDoc), DCRTable.Interpret),
+		NewMetric("FRES", float64Metric(en.CalcFRESDoc), FRESTable.Interpret),
+		NewGradeMetric("FKG", float64Metric(en.CalcFKGDoc), FKGTable.Interpret, sameGrade),
+		NewGradeMetric("SMOG", float64Metric(en.CalcSMOGDoc), SMOGTable.Interpret, sameGrade),
+		NewGradeMetric("Gunning fog", float64Metric(en.CalcGunningFogDoc), GunningFogTable.Interpret, sameGrade),
+	}
+	for _, m := range english {
+		if err := RegisterLanguage(lang.English, m); err != nil {
+			panic(err)
+		}
+	}
+	gulpease := NewMetric(
+		"Gulpease",
+		func(doc *stats.Document) (Score, error) {
+			score, err := it.CalcGulpeaseDoc(doc)
+			return Score(score), err
+		},
+		GulpeaseTable.Interpret,
+	)
+	if err := RegisterLanguage(lang.Italian, gulpease); err != nil {
+		panic(err)
+	}
+	for _, language := range languageMetrics {
+		for _, m := range language.metrics {
+			if err := RegisterLanguage(language.language, m); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
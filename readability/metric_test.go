@@ -0,0 +1,78 @@
+package readability_test
+
+import (
+	"errors"
+	"goreadability/readability"
+	"goreadability/stats"
+	"slices"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	builtins := []string{"ARI", "CLI", "DCR", "FRES", "FKG", "SMOG", "Gunning fog", "Gulpease"}
+	var names []string
+	for _, m := range readability.Metrics() {
+		names = append(names, m.Name())
+	}
+	if len(names) < len(builtins) || !slices.Equal(names[:len(builtins)], builtins) {
+		t.Errorf("Metrics() = %v, want to start with the built-in metrics %v", names, builtins)
+	}
+
+	ari, ok := readability.Lookup("ARI")
+	if !ok {
+		t.Fatalf("Lookup(\"ARI\") found no metric")
+	}
+	if _, ok := ari.(readability.GradeMetric); !ok {
+		t.Errorf("Lookup(\"ARI\") = %T, want a GradeMetric", ari)
+	}
+	if err := readability.Register(ari); err == nil {
+		t.Errorf("Register() of a registered name returned no error")
+	}
+
+	// The metric cannot be calculated, so it doesn't change the results of the other tests.
+	failing := readability.NewMetric("test failing",
+		func(*stats.Document) (readability.Score, error) { return 0, errors.New("Failing.") },
+		func(readability.Score) readability.Interpretation { return readability.Interpretation{} })
+	if _, ok := readability.Lookup(failing.Name()); !ok {
+		if err := readability.Register(failing); err != nil {
+			t.Fatalf("Register() returned error: %v", err)
+		}
+	}
+	if m, ok := readability.Lookup("test failing"); !ok || m.Name() != "test failing" {
+		t.Errorf("Lookup(\"test failing\") = %v, %v, want the registered metric", m, ok)
+	}
+	if metrics := readability.Metrics(); metrics[len(metrics)-1].Name() != "test failing" {
+		t.Errorf("Metrics() doesn't end with the last registered metric")
+	}
+}
+
+func TestComputeAll(t *testing.T) {
+	scores, err := readability.ComputeAll(string(readTestdata(t, "sample.txt")))
+	if err != nil {
+		t.Fatalf("ComputeAll() returned error: %v", err)
+	}
+	checkGolden(t, "compute_all", scores)
+
+	if _, err := readability.ComputeAll(""); err == nil {
+		t.Errorf("ComputeAll(\"\") returned no error")
+	}
+}
+
+func FuzzComputeAll(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")))
+	f.Add("Hello")
+	f.Fuzz(func(t *testing.T, text string) {
+		scores, err := readability.ComputeAll(text)
+		if err != nil {
+			return
+		}
+		// Every metric computes the same score from its own document.
+		for name, score := range scores {
+			m, _ := readability.Lookup(name)
+			if got, err := m.Compute(stats.NewDocument(text)); err != nil || got != score {
+				t.Errorf("Compute(%q) of %s = %v, %v, want %v", text, name, got, err, score)
+			}
+		}
+		checkJSON(t, text, scores)
+	})
+}
@@ -0,0 +1,92 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/lang"
+	"goreadability/stats"
+	"math"
+)
+
+// ====== Types ======
+
+// Section represents consecutive paragraphs of a text in the same language, with their byte offsets and the scores of the metrics for the language.
+// Weight is the number of characters (letters and digits) in the section.
+type Section struct {
+	Start    int            `json:"start"`
+	End      int            `json:"end"`
+	Language string         `json:"language"`
+	Weight   uint           `json:"weight"`
+	Results  []MetricResult `json:"results"`
+}
+
+// MixedReport represents the sections of a text in different languages and their aggregate.
+// Languages maps the languages to their shares of the text characters. Scores maps the metrics to their means over the sections they were calculated for, weighted by the section characters.
+type MixedReport struct {
+	Sections  []Section          `json:"sections"`
+	Languages map[string]float64 `json:"languages"`
+	Scores    map[string]Score   `json:"scores"`
+}
+
+// ====== Functions ======
+
+// AnalyzeMixed accepts a non-empty string and options and analyzes a text with parts in different languages.
+// The language of each paragraph (separated by blank lines) is detected, consecutive paragraphs in the same language are joined into a section,
+// and each section is scored with the metrics of its language (see `AnalyzeAuto`). Paragraphs whose language cannot be detected join the neighboring section.
+// The aggregate scores are rounded to the first decimal point, and the language shares to the second one.
+func AnalyzeMixed(s string, opts ...stats.Option) (MixedReport, error) {
+	if len(s) == 0 {
+		return MixedReport{}, errors.New("Empty string.")
+	}
+
+	var sections []Section
+	pending := -1
+	for _, p := range stats.Paragraphs(s) {
+		language, err := lang.Detect(p.Text)
+		switch {
+		case err != nil && len(sections) == 0:
+			if pending < 0 {
+				pending = p.Start
+			}
+		case len(sections) > 0 && (err != nil || sections[len(sections)-1].Language == language):
+			sections[len(sections)-1].End = p.End
+		default:
+			start := p.Start
+			if pending >= 0 {
+				start, pending = pending, -1
+			}
+			sections = append(sections, Section{Start: start, End: p.End, Language: language})
+		}
+	}
+	if len(sections) == 0 {
+		return MixedReport{}, errors.New("No language could be detected. Cannot analyze the text.")
+	}
+
+	report := MixedReport{Languages: map[string]float64{}, Scores: map[string]Score{}}
+	var totalWeight float64
+	weights := map[string]float64{}
+	for i := range sections {
+		section := &sections[i]
+		text := s[section.Start:section.End]
+		section.Weight = stats.CountCharacters(text)
+		if auto, err := analyzeLanguage(text, section.Language, opts); err == nil {
+			section.Results = auto.Results
+		}
+
+		weight := float64(section.Weight)
+		totalWeight += weight
+		report.Languages[section.Language] += weight
+		for _, r := range section.Results {
+			report.Scores[r.Metric] += r.Score * Score(weight)
+			weights[r.Metric] += weight
+		}
+	}
+	report.Sections = sections
+
+	for metric, score := range report.Scores {
+		report.Scores[metric] = Score(math.Round(float64(score)/weights[metric]*10) / 10)
+	}
+	for language, weight := range report.Languages {
+		report.Languages[language] = math.Round(weight/totalWeight*100) / 100
+	}
+	return report, nil
+}
@@ -0,0 +1,118 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// mixedText returns the texts of the languages in testdata joined as paragraphs.
+func mixedText(t testing.TB, languages ...string) string {
+	var texts []string
+	for _, language := range languages {
+		texts = append(texts, strings.TrimSpace(rawText(t, language)))
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+func TestAnalyzeMixed(t *testing.T) {
+	report, err := readability.AnalyzeMixed("12345\n\n" + mixedText(t, "en", "de", "de", "fr", "ja"))
+	if err != nil {
+		t.Fatalf("AnalyzeMixed() returned error: %v", err)
+	}
+	checkGolden(t, "mixed", report)
+
+	var languages []string
+	for _, section := range report.Sections {
+		languages = append(languages, section.Language)
+	}
+	if got := strings.Join(languages, " "); got != "en de fr ja" {
+		t.Errorf("AnalyzeMixed() found the sections in %s, want en de fr ja", got)
+	}
+
+	for _, text := range []string{"", "   ", "12345.\n\n67890."} {
+		if _, err := readability.AnalyzeMixed(text); err == nil {
+			t.Errorf("AnalyzeMixed(%q) returned no error", text)
+		}
+	}
+}
+
+func TestAnalyzeMixedSections(t *testing.T) {
+	french1 := "Le chat dort sur le lit. Le chien court dans le jardin."
+	dutch := "De kat zit op de mat. De hond ligt in het gras en de zon schijnt."
+	french2 := "Le chien mange du pain. La fille lit un livre."
+	got, err := readability.AnalyzeMixed(french1 + "\n\n" + dutch + "\n\n" + french2)
+	if err != nil {
+		t.Fatalf("AnalyzeMixed() returned error: %v", err)
+	}
+
+	sections := []struct {
+		start    int
+		end      int
+		language string
+		weight   uint
+		score    readability.Score
+	}{
+		// 12 words of 13 syllables in 2 sentences: Kandel-Moles 207 - 1.015 × 6 - 73.6 × 13 / 12.
+		{0, 55, "fr", 42, 121.2},
+		// 16 words of one syllable in 2 sentences: Flesch-Douma 206.84 - 0.77 × 100 - 0.93 × 8.
+		{57, 122, "nl", 48, 122.4},
+		// 10 words of one syllable in 2 sentences: Kandel-Moles 207 - 1.015 × 5 - 73.6.
+		{124, 170, "fr", 35, 128.3},
+	}
+	if len(got.Sections) != len(sections) {
+		t.Fatalf("AnalyzeMixed() = %d sections, want %d", len(got.Sections), len(sections))
+	}
+	for i, want := range sections {
+		section := got.Sections[i]
+		if section.Start != want.start || section.End != want.end || section.Language != want.language || section.Weight != want.weight ||
+			len(section.Results) != 1 || section.Results[0].Score != want.score {
+			t.Errorf("AnalyzeMixed() section %d = %+v, want %+v", i, section, want)
+		}
+	}
+	// The French sections have 77 of the 125 letters, and the French score is their weighted mean: (121.2 × 42 + 128.3 × 35) / 77.
+	languages := map[string]float64{"fr": 0.62, "nl": 0.38}
+	scores := map[string]readability.Score{"Kandel-Moles": 124.4, "Flesch-Douma": 122.4}
+	if !reflect.DeepEqual(got.Languages, languages) || !reflect.DeepEqual(got.Scores, scores) {
+		t.Errorf("AnalyzeMixed() = %v %v, want %v %v", got.Languages, got.Scores, languages, scores)
+	}
+
+	// The paragraphs without a language join the next section at the start and the previous one after it.
+	text := "12345\n\n" + french1 + "\n\n67890\n\n" + dutch
+	if got, err := readability.AnalyzeMixed(text); err != nil || len(got.Sections) != 2 || got.Sections[0].Start != 0 || got.Sections[0].End != 69 || got.Sections[1].Start != 71 {
+		t.Errorf("AnalyzeMixed(%q) = %+v, %v, want the sections 0–69 and 71–136", text, got.Sections, err)
+	}
+}
+
+func FuzzAnalyzeMixed(f *testing.F) {
+	f.Add(mixedText(f, "en", "de", "fr"))
+	f.Add(mixedText(f, "ru", "ar", "zh", "ja", "es", "nl", "it"))
+	f.Add("12345\n\nDas ist gut.\n\n67890")
+	f.Fuzz(func(t *testing.T, text string) {
+		report, err := readability.AnalyzeMixed(text)
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, report)
+		// The sections cover the text from the first paragraph to the last one.
+		end := 0
+		for i, section := range report.Sections {
+			if section.Start < end || section.End < section.Start || section.End > len(text) {
+				t.Fatalf("the section %+v of %q is out of order or out of the text", section, text)
+			}
+			if i > 0 && report.Sections[i-1].Language == section.Language {
+				t.Errorf("the sections of %q in %s are not joined", text, section.Language)
+			}
+			end = section.End
+		}
+		var shares float64
+		for _, share := range report.Languages {
+			shares += share
+		}
+		if math.Abs(shares-1) > 0.01*float64(len(report.Languages)) {
+			t.Errorf("the languages of %q have the shares %v", text, report.Languages)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"strings"
+)
+
+// ====== Types ======
+
+// Nominalization represents a noun made of a verb or an adjective ("utilization", "failure to", "awareness"), with its position in the text.
+// HiddenVerb is true if the noun is followed by "of" ("the implementation of"), so the sentence can likely be rewritten with the verb ("implement").
+type Nominalization struct {
+	stats.Token
+	Suffix     string `json:"suffix"`
+	HiddenVerb bool   `json:"hidden_verb"`
+}
+
+// nominalizationSuffixes are the English suffixes that make nouns of verbs and adjectives, longer ones first.
+var nominalizationSuffixes = []string{"ation", "tion", "sion", "ment", "ance", "ence", "ancy", "ency", "ity", "ness"}
+
+// notNominalizations are the English words with a nominalization suffix that are not made of a verb or an adjective.
+var notNominalizations = map[string]bool{
+	"nation": true, "station": true, "ration": true, "portion": true, "potion": true, "lotion": true, "motion": true, "question": true, "mention": true,
+	"mansion": true, "pension": true, "tension": true, "version": true, "occasion": true,
+	"moment": true, "element": true, "comment": true, "segment": true, "fragment": true, "pigment": true, "garment": true, "apartment": true,
+	"department": true, "document": true, "instrument": true, "monument": true, "ornament": true, "parliament": true, "sentiment": true, "torment": true,
+	"balance": true, "finance": true, "distance": true, "instance": true, "substance": true, "circumstance": true, "province": true, "evidence": true,
+	"science": true, "sentence": true, "silence": true, "audience": true, "licence": true, "license": true, "fence": true, "sequence": true,
+	"agency": true, "currency": true, "city": true, "university": true, "community": true, "committee": true, "entity": true, "deity": true, "quantity": true,
+	"business": true, "witness": true, "wilderness": true, "harness": true,
+}
+
+// ====== Functions ======
+
+// DetectNominalizations accepts a non-empty English string and options and returns its nominalizations in the order of their positions.
+// Nominalizations are detected by their suffixes ("-tion", "-sion", "-ment", "-ance", "-ence", "-ity", "-ness") in words of at least 7 letters,
+// except for the common nouns with these suffixes that are not made of verbs ("nation", "moment", "science").
+// Nominalizations make prose abstract and raise its grade level, so they are worth rewriting with verbs, especially the ones followed by "of".
+func DetectNominalizations(s string, opts ...stats.Option) ([]Nominalization, error) {
+	if len(s) == 0 {
+		return nil, errors.New("Empty string.")
+	}
+	words := stats.Words(s, opts...)
+	if len(words) == 0 {
+		return nil, errors.New("No words were parsed. Cannot detect nominalizations.")
+	}
+
+	var nominalizations []Nominalization
+	for i, word := range words {
+		suffix, ok := nominalizationSuffix(normalizeWord(word.Text))
+		if !ok {
+			continue
+		}
+		hiddenVerb := i+1 < len(words) && normalizeWord(words[i+1].Text) == "of"
+		nominalizations = append(nominalizations, Nominalization{word, suffix, hiddenVerb})
+	}
+	return nominalizations, nil
+}
+
+// nominalizationSuffix returns the nominalization suffix of the lower case word, and false if the word doesn't look like a nominalization.
+func nominalizationSuffix(word string) (string, bool) {
+	// The words ending with "s" in the singular ("business") are looked up as they are, and "-es" plurals ("witnesses") without "-es".
+	singular := strings.TrimSuffix(word, "s")
+	if len([]rune(word)) < 7 || notNominalizations[word] || notNominalizations[singular] || notNominalizations[strings.TrimSuffix(word, "es")] {
+		return "", false
+	}
+	for _, suffix := range nominalizationSuffixes {
+		if strings.HasSuffix(word, suffix) || strings.HasSuffix(singular, suffix) {
+			return suffix, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,105 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"goreadability/stats"
+	"slices"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestDetectNominalizations(t *testing.T) {
+	nominalizations, err := readability.DetectNominalizations(string(readTestdata(t, "sample.txt")))
+	if err != nil {
+		t.Fatalf("DetectNominalizations() returned error: %v", err)
+	}
+	checkGolden(t, "nominalizations", nominalizations)
+
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"The implementation of the plan needs awareness.", []string{"implementation", "awareness"}},
+		{"The business and its businesses hired witnesses.", nil},
+		{"Several nations sent their departments.", nil},
+		{"The utilizations were failures.", []string{"utilizations"}},
+	}
+	for _, tt := range tests {
+		got, err := readability.DetectNominalizations(tt.text)
+		if err != nil {
+			t.Fatalf("DetectNominalizations(%q) returned error: %v", tt.text, err)
+		}
+		var words []string
+		for _, n := range got {
+			words = append(words, n.Text)
+		}
+		if len(words) != len(tt.want) {
+			t.Errorf("DetectNominalizations(%q) = %v, want %v", tt.text, words, tt.want)
+			continue
+		}
+		for i := range words {
+			if words[i] != tt.want[i] {
+				t.Errorf("DetectNominalizations(%q) = %v, want %v", tt.text, words, tt.want)
+				break
+			}
+		}
+	}
+
+	got, _ := readability.DetectNominalizations("The implementation of it.")
+	if len(got) != 1 || !got[0].HiddenVerb || got[0].Suffix != "ation" {
+		t.Errorf("DetectNominalizations() = %+v, want a hidden verb with the suffix \"ation\"", got)
+	}
+}
+
+func TestDetectNominalizationsSuffixes(t *testing.T) {
+	type nominalization struct {
+		text       string
+		suffix     string
+		hiddenVerb bool
+	}
+	tests := []struct {
+		text string
+		want []nominalization
+	}{
+		{"The implementation of the plan needs awareness.", []nominalization{{"implementation", "ation", true}, {"awareness", "ness", false}}},
+		{"Its complexity and the movement of reliance.", []nominalization{{"complexity", "ity", false}, {"movement", "ment", true}, {"reliance", "ance", false}}},
+		// The plurals and the capitalized words are matched too.
+		{"The Utilizations OF data.", []nominalization{{"Utilizations", "ation", true}}},
+		// The words shorter than 7 letters are not nominalizations.
+		{"No notion, action, or unity.", nil},
+	}
+	for _, test := range tests {
+		got, err := readability.DetectNominalizations(test.text)
+		if err != nil {
+			t.Errorf("DetectNominalizations(%q) returned error: %v", test.text, err)
+			continue
+		}
+		var nominalizations []nominalization
+		for _, n := range got {
+			nominalizations = append(nominalizations, nominalization{n.Text, n.Suffix, n.HiddenVerb})
+		}
+		if !slices.Equal(nominalizations, test.want) {
+			t.Errorf("DetectNominalizations(%q) = %+v, want %+v", test.text, nominalizations, test.want)
+		}
+	}
+}
+
+func FuzzDetectNominalizations(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")))
+	f.Add("The implementation of the plan needs awareness.")
+	f.Add("The business and its businesses hired witnesses.")
+	f.Fuzz(func(t *testing.T, text string) {
+		nominalizations, err := readability.DetectNominalizations(text)
+		if err != nil {
+			return
+		}
+		words := make([]stats.Token, 0, len(nominalizations))
+		for _, n := range nominalizations {
+			words = append(words, n.Token)
+			if utf8.RuneCountInString(n.Text) < 7 || n.Suffix == "" {
+				t.Errorf("the nominalization %+v of %q is too short or has no suffix", n, text)
+			}
+		}
+		checkOccurrences(t, text, words)
+	})
+}
@@ -0,0 +1,64 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/input"
+	"goreadability/stats"
+)
+
+// ====== Types ======
+
+// CellReport represents the Report of a Markdown cell of a Jupyter notebook, with the index of the cell in the notebook.
+type CellReport struct {
+	Index int `json:"index"`
+	Report
+}
+
+// NotebookReport represents the reports of the Markdown cells of a notebook and the Report of all of them together, calculated from the sums of the cell statistics.
+type NotebookReport struct {
+	Cells    []CellReport `json:"cells"`
+	Notebook Report       `json:"notebook"`
+}
+
+// ====== Methods ======
+
+// AnalyzeNotebook accepts the Markdown cells of a notebook (see `input.ExtractNotebook`) and options and returns the Report of every cell and of all the cells together.
+// The cells without a word or a sentence (a heading, a list of links without terminators) are skipped, and the notebook must have at least one cell with them.
+func (a *Analyzer) AnalyzeNotebook(cells []input.Cell, opts ...stats.Option) (NotebookReport, error) {
+	var notebook NotebookReport
+	var total stats.TotalStats
+	var difficultWords uint
+	for _, cell := range cells {
+		if len(cell.Text) == 0 {
+			continue
+		}
+		report, err := a.Analyze(cell.Text, opts...)
+		if err != nil {
+			continue
+		}
+		notebook.Cells = append(notebook.Cells, CellReport{cell.Index, report})
+		total = total.Add(report.Stats)
+		difficultWords += report.DifficultWords
+	}
+	if len(notebook.Cells) == 0 {
+		return NotebookReport{}, errors.New("No Markdown cell has words and sentences. Cannot analyze the notebook.")
+	}
+
+	var err error
+	if notebook.Notebook, err = reportFromStats(total, difficultWords); err != nil {
+		return NotebookReport{}, err
+	}
+	return notebook, nil
+}
+
+// ====== Functions ======
+
+// AnalyzeNotebookFile accepts a path to a Jupyter notebook (".ipynb") and options and returns its NotebookReport. The headings are kept in the text of the cells.
+// See `input.ExtractNotebook` and `Analyzer.AnalyzeNotebook`.
+func AnalyzeNotebookFile(path string, opts ...stats.Option) (NotebookReport, error) {
+	cells, err := input.ExtractNotebookFile(path, input.BlockOptions{})
+	if err != nil {
+		return NotebookReport{}, err
+	}
+	return NewAnalyzer().AnalyzeNotebook(cells, opts...)
+}
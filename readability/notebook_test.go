@@ -0,0 +1,49 @@
+package readability_test
+
+import (
+	"goreadability/input"
+	"goreadability/readability"
+	"goreadability/stats"
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeNotebook(t *testing.T) {
+	cells := []input.Cell{
+		{Index: 0, Content: input.Content{Text: "Results"}},
+		{Index: 2, Content: input.Content{Text: "We load the data. It has ten rows."}},
+		{Index: 3, Content: input.Content{Text: ""}},
+		{Index: 5, Content: input.Content{Text: "The model fits well."}},
+	}
+	got, err := readability.NewAnalyzer().AnalyzeNotebook(cells)
+	if err != nil {
+		t.Fatalf("AnalyzeNotebook() returned error: %v", err)
+	}
+
+	// The heading cell without a sentence and the empty cell are skipped.
+	tests := []struct {
+		index int
+		text  string
+	}{
+		{2, "We load the data. It has ten rows."},
+		{5, "The model fits well."},
+	}
+	if len(got.Cells) != len(tests) {
+		t.Fatalf("AnalyzeNotebook() = %d cells, want %d", len(got.Cells), len(tests))
+	}
+	var total stats.TotalStats
+	for i, test := range tests {
+		want, _ := readability.NewAnalyzer().Analyze(test.text)
+		if got.Cells[i].Index != test.index || !reflect.DeepEqual(got.Cells[i].Report, want) {
+			t.Errorf("AnalyzeNotebook() cell %d = %d %+v, want %d %+v", i, got.Cells[i].Index, got.Cells[i].Report, test.index, want)
+		}
+		total = total.Add(want.Stats)
+	}
+	if got.Notebook.Stats != total || got.Notebook.Stats.Words != 12 || got.Notebook.Stats.Sentences != 3 {
+		t.Errorf("AnalyzeNotebook() notebook = %+v, want %+v (12 words, 3 sentences)", got.Notebook.Stats, total)
+	}
+
+	if _, err := readability.NewAnalyzer().AnalyzeNotebook([]input.Cell{{Content: input.Content{Text: "Title"}}}); err == nil {
+		t.Errorf("AnalyzeNotebook() of a notebook without sentences returned no error")
+	}
+}
@@ -0,0 +1,47 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"goreadability/stats"
+	"testing"
+)
+
+func TestOptions(t *testing.T) {
+	text := "The well-known co-op re-opened in a state-of-the-art building. Its long-term members were happy."
+	reports := map[string]readability.Report{}
+	for name, opts := range map[string][]stats.Option{
+		"default":       nil,
+		"split_hyphens": {stats.WithSplitHyphens()},
+	} {
+		report, err := readability.NewAnalyzer().Analyze(text, opts...)
+		if err != nil {
+			t.Fatalf("Analyze(%q, %s) returned error: %v", text, name, err)
+		}
+		reports[name] = report
+
+		// The options are passed down to every calculation.
+		if want := stats.CountAllStats(text, opts...); report.Stats != want {
+			t.Errorf("Analyze(%q, %s).Stats = %+v, want %+v", text, name, report.Stats, want)
+		}
+		consensus, err := readability.Consensus(text, opts...)
+		if err != nil {
+			t.Fatalf("Consensus(%q, %s) returned error: %v", text, name, err)
+		}
+		scores, err := readability.ComputeAll(text, opts...)
+		if err != nil {
+			t.Fatalf("ComputeAll(%q, %s) returned error: %v", text, name, err)
+		}
+		for _, result := range consensus.Results {
+			if score := scores[result.Metric]; float64(score) != result.Score {
+				t.Errorf("Consensus(%q, %s) has the %s score %v, want %v", text, name, result.Metric, result.Score, score)
+			}
+		}
+		if cefr, err := readability.EstimateCEFR(text, opts...); err != nil || cefr.Grade != consensus.Median {
+			t.Errorf("EstimateCEFR(%q, %s).Grade = %v, %v, want %v", text, name, cefr.Grade, err, consensus.Median)
+		}
+		if lexile, err := readability.EstimateLexile(text, opts...); err != nil || lexile.Grade != consensus.Median {
+			t.Errorf("EstimateLexile(%q, %s).Grade = %v, %v, want %v", text, name, lexile.Grade, err, consensus.Median)
+		}
+	}
+	checkGolden(t, "options", reports)
+}
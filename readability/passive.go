@@ -0,0 +1,100 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+	"strings"
+)
+
+// ====== Types ======
+
+// SentenceVoice represents a sentence of a text with the number of passive constructions in it.
+type SentenceVoice struct {
+	stats.Sentence
+	Passive       bool `json:"passive"`
+	Constructions uint `json:"constructions"`
+}
+
+// PassiveVoiceReport represents the sentences of a text flagged for the passive voice and the share of the passive sentences.
+// Percentage is the percentage of the sentences with at least one passive construction.
+type PassiveVoiceReport struct {
+	Sentences        []SentenceVoice `json:"sentences"`
+	PassiveSentences uint            `json:"passive_sentences"`
+	Percentage       float64         `json:"percentage"`
+}
+
+// beForms are the forms of "to be" that start a passive construction.
+var beForms = map[string]bool{
+	"am": true, "is": true, "are": true, "was": true, "were": true,
+	"be": true, "been": true, "being": true,
+}
+
+// irregularParticiples are the common past participles of English irregular verbs.
+var irregularParticiples = map[string]bool{
+	"begun": true, "bitten": true, "blown": true, "born": true, "borne": true, "bought": true, "brought": true, "broken": true, "built": true,
+	"caught": true, "chosen": true, "done": true, "drawn": true, "driven": true, "eaten": true, "fallen": true, "felt": true, "forbidden": true,
+	"forgiven": true, "forgotten": true, "found": true, "frozen": true, "given": true, "gone": true, "grown": true, "heard": true, "held": true,
+	"hidden": true, "hit": true, "hurt": true, "kept": true, "known": true, "laid": true, "led": true, "left": true, "lent": true, "lost": true,
+	"made": true, "meant": true, "met": true, "paid": true, "put": true, "read": true, "ridden": true, "run": true, "said": true, "seen": true,
+	"sent": true, "set": true, "shaken": true, "shot": true, "shown": true, "shut": true, "sold": true, "spent": true, "spoken": true, "stolen": true,
+	"struck": true, "sung": true, "sworn": true, "taken": true, "taught": true, "thought": true, "thrown": true, "told": true, "torn": true,
+	"understood": true, "woken": true, "won": true, "worn": true, "written": true,
+}
+
+// passiveModifiers are the words that can stand between a form of "to be" and a past participle, besides the adverbs ending in "-ly".
+var passiveModifiers = map[string]bool{
+	"not": true, "never": true, "also": true, "often": true, "always": true, "already": true, "just": true, "still": true,
+}
+
+// ====== Functions ======
+
+// DetectPassiveVoice accepts a non-empty English string and options and returns its sentences flagged for the passive voice, and the percentage of the passive sentences.
+// Passive constructions are detected heuristically, as a form of "to be" ("was", "were", "been", "being") followed by a past participle ("was written", "were made"),
+// optionally with an adverb or a negation between them ("was quickly written", "were not seen"). The percentage is rounded to the first decimal point.
+func DetectPassiveVoice(s string, opts ...stats.Option) (PassiveVoiceReport, error) {
+	if len(s) == 0 {
+		return PassiveVoiceReport{}, errors.New("Empty string.")
+	}
+
+	sentences := stats.Sentences(s, opts...)
+	if len(sentences) == 0 {
+		return PassiveVoiceReport{}, errors.New("No sentences were parsed. Cannot detect passive voice.")
+	}
+
+	tokenizer := stats.NewConfig(opts...).Tokenizer
+	report := PassiveVoiceReport{Sentences: make([]SentenceVoice, 0, len(sentences))}
+	for _, sentence := range sentences {
+		constructions := countPassiveConstructions(tokenizer(sentence.Text))
+		report.Sentences = append(report.Sentences, SentenceVoice{sentence, constructions > 0, constructions})
+		if constructions > 0 {
+			report.PassiveSentences++
+		}
+	}
+	report.Percentage = math.Round(float64(report.PassiveSentences)/float64(len(sentences))*1000) / 10
+	return report, nil
+}
+
+// countPassiveConstructions returns the number of forms of "to be" followed by a past participle in the words,
+// optionally with an adverb ending in "-ly" or a negation between them ("was quickly written", "were not seen").
+func countPassiveConstructions(words []string) uint {
+	var count uint
+	for i := 0; i < len(words)-1; i++ {
+		if !beForms[normalizeWord(words[i])] {
+			continue
+		}
+		next := normalizeWord(words[i+1])
+		if (strings.HasSuffix(next, "ly") || passiveModifiers[next]) && i+2 < len(words) {
+			next = normalizeWord(words[i+2])
+		}
+		if isPastParticiple(next) {
+			count++
+		}
+	}
+	return count
+}
+
+// isPastParticiple reports whether the lower case word looks like a past participle.
+func isPastParticiple(word string) bool {
+	return irregularParticiples[word] || (len(word) > 3 && strings.HasSuffix(word, "ed"))
+}
@@ -0,0 +1,106 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"slices"
+	"testing"
+)
+
+func TestDetectPassiveVoice(t *testing.T) {
+	report, err := readability.DetectPassiveVoice(string(readTestdata(t, "sample.txt")))
+	if err != nil {
+		t.Fatalf("DetectPassiveVoice() returned error: %v", err)
+	}
+	checkGolden(t, "passive", report)
+
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"The letter was written.", 1},
+		{"The letters were quickly written and were not sent.", 2},
+		{"The house is being built.", 1},
+		{"She has written a letter.", 0},
+		{"He was happy.", 0},
+		{"It was read. It is “known”.", 2},
+	}
+	for _, test := range tests {
+		report, err := readability.DetectPassiveVoice(test.text)
+		if err != nil {
+			t.Errorf("DetectPassiveVoice(%q) returned error: %v", test.text, err)
+			continue
+		}
+		var constructions uint
+		for _, sentence := range report.Sentences {
+			constructions += sentence.Constructions
+		}
+		if constructions != test.want {
+			t.Errorf("DetectPassiveVoice(%q) found %d passive constructions, want %d", test.text, constructions, test.want)
+		}
+	}
+
+	for _, text := range []string{"", "   "} {
+		if _, err := readability.DetectPassiveVoice(text); err == nil {
+			t.Errorf("DetectPassiveVoice(%q) returned no error", text)
+		}
+	}
+}
+
+func TestDetectPassiveVoiceSentences(t *testing.T) {
+	tests := []struct {
+		text       string
+		sentences  []string
+		passive    []bool
+		percentage float64
+	}{
+		{"The cake was eaten. We laughed. The door was never opened.", []string{"The cake was eaten.", "We laughed.", "The door was never opened."}, []bool{true, false, true}, 66.7},
+		// "red" is too short to be a past participle, and "has" is not a form of "to be".
+		{"The shed is red. He has closed it. It has been decided.", []string{"The shed is red.", "He has closed it.", "It has been decided."}, []bool{false, false, true}, 33.3},
+		{"Nothing happened.", []string{"Nothing happened."}, []bool{false}, 0},
+	}
+	for _, test := range tests {
+		got, err := readability.DetectPassiveVoice(test.text)
+		if err != nil {
+			t.Errorf("DetectPassiveVoice(%q) returned error: %v", test.text, err)
+			continue
+		}
+		var sentences []string
+		var passive []bool
+		for _, sentence := range got.Sentences {
+			sentences = append(sentences, sentence.Text)
+			passive = append(passive, sentence.Passive)
+		}
+		if !slices.Equal(sentences, test.sentences) || !slices.Equal(passive, test.passive) || got.Percentage != test.percentage {
+			t.Errorf("DetectPassiveVoice(%q) = %q %v (%v%%), want %q %v (%v%%)", test.text, sentences, passive, got.Percentage, test.sentences, test.passive, test.percentage)
+		}
+	}
+}
+
+func FuzzDetectPassiveVoice(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")))
+	f.Add("The letters were quickly written and were not sent. Was it seen?")
+	f.Fuzz(func(t *testing.T, text string) {
+		report, err := readability.DetectPassiveVoice(text)
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, report)
+		var passive uint
+		end := 0
+		for _, sentence := range report.Sentences {
+			if sentence.Start < end || sentence.End < sentence.Start || sentence.End > len(text) || text[sentence.Start:sentence.End] != sentence.Text {
+				t.Fatalf("the sentence %+v of %q is not at its offsets after %d", sentence.Sentence, text, end)
+			}
+			end = sentence.End
+			if sentence.Passive != (sentence.Constructions > 0) {
+				t.Errorf("the sentence %+v of %q is flagged wrong", sentence, text)
+			}
+			if sentence.Passive {
+				passive++
+			}
+		}
+		if passive != report.PassiveSentences || report.Percentage < 0 || report.Percentage > 100 {
+			t.Errorf("DetectPassiveVoice(%q) = %d passive sentences (%v%%), want %d", text, report.PassiveSentences, report.Percentage, passive)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package readability_test
+
+import (
+	"goreadability/ar"
+	"goreadability/de"
+	"goreadability/en"
+	"goreadability/es"
+	"goreadability/fr"
+	"goreadability/it"
+	"goreadability/ja"
+	"goreadability/nl"
+	"goreadability/ru"
+	"goreadability/stats"
+	"goreadability/zh"
+	"math"
+	"testing"
+)
+
+// rawIndex is a readability index calculated with and without rounding.
+type rawIndex struct {
+	name    string
+	rounded func(string) (float64, error)
+	raw     func(string) (float64, error)
+}
+
+// rawScore is the score of a readability index with and without rounding.
+type rawScore struct {
+	Score float64 `json:"score"`
+	// Raw is rounded to the sixth decimal point, as its last digits depend on the architecture.
+	Raw float64 `json:"raw"`
+}
+
+// rawIndices are the indices of all the languages, by the languages of their texts (see `rawText`).
+var rawIndices = map[string][]rawIndex{
+	"en": {
+		{"ARI", func(s string) (float64, error) { score, err := en.CalcAri(s); return float64(score), err }, withoutOptions(en.CalcAriRaw)},
+		{"CLI", withoutOptions(en.CalcCli), withoutOptions(en.CalcCliRaw)},
+		{"DCR", withoutOptions(en.CalcDCR), withoutOptions(en.CalcDCRRaw)},
+		{"FRES", withoutOptions(en.CalcFRES), withoutOptions(en.CalcFRESRaw)},
+		{"FKG", withoutOptions(en.CalcFKG), withoutOptions(en.CalcFKGRaw)},
+		{"SMOG", withoutOptions(en.CalcSMOG), withoutOptions(en.CalcSMOGRaw)},
+		{"Gunning fog", withoutOptions(en.CalcGunningFog), withoutOptions(en.CalcGunningFogRaw)},
+	},
+	"it": {{"Gulpease", func(s string) (float64, error) { score, err := it.CalcGulpease(s); return float64(score), err }, withoutOptions(it.CalcGulpeaseRaw)}},
+	"es": {
+		{"Gutiérrez de Polini", withoutOptions(es.CalcGutierrezDePolini), withoutOptions(es.CalcGutierrezDePoliniRaw)},
+		{"Crawford", withoutOptions(es.CalcCrawford), withoutOptions(es.CalcCrawfordRaw)},
+	},
+	"de": {
+		{"Tränkle–Bailer 1", withoutOptions(de.CalcTraenkleBailer1), withoutOptions(de.CalcTraenkleBailer1Raw)},
+		{"Tränkle–Bailer 2", withoutOptions(de.CalcTraenkleBailer2), withoutOptions(de.CalcTraenkleBailer2Raw)},
+	},
+	"fr": {{"Kandel–Moles", withoutOptions(fr.CalcKandelMoles), withoutOptions(fr.CalcKandelMolesRaw)}},
+	"nl": {{"Flesch–Douma", withoutOptions(nl.CalcFleschDouma), withoutOptions(nl.CalcFleschDoumaRaw)}},
+	"ru": {
+		{"Oborneva", withoutOptions(ru.CalcOborneva), withoutOptions(ru.CalcObornevaRaw)},
+		{"Matskovskiy", withoutOptions(ru.CalcMatskovskiy), withoutOptions(ru.CalcMatskovskiyRaw)},
+	},
+	"ar": {{"Osman", withoutOptions(ar.CalcOsman), withoutOptions(ar.CalcOsmanRaw)}},
+	"ja": {{"Tateishi", withoutOptions(ja.CalcTateishi), withoutOptions(ja.CalcTateishiRaw)}},
+	"zh": {{"Readability", withoutOptions(zh.CalcReadability), withoutOptions(zh.CalcReadabilityRaw)}},
+}
+
+// rawText returns the text of the language: testdata/sample.txt for English, or the text in testdata/raw.
+func rawText(t testing.TB, language string) string {
+	if language == "en" {
+		return string(readTestdata(t, "sample.txt"))
+	}
+	return string(readTestdata(t, "raw/"+language+".txt"))
+}
+
+// withoutOptions returns the calculation with the default options.
+func withoutOptions(calc func(string, ...stats.Option) (float64, error)) func(string) (float64, error) {
+	return func(s string) (float64, error) { return calc(s) }
+}
+
+func TestRawScores(t *testing.T) {
+	scores := map[string]rawScore{}
+	for language, indices := range rawIndices {
+		text := rawText(t, language)
+		for _, index := range indices {
+			rounded, err := index.rounded(text)
+			if err != nil {
+				t.Fatalf("%s of %s returned error: %v", index.name, language, err)
+			}
+			raw, err := index.raw(text)
+			if err != nil {
+				t.Fatalf("%s of %s without rounding returned error: %v", index.name, language, err)
+			}
+			scores[index.name] = rawScore{rounded, math.Round(raw*1e6) / 1e6}
+		}
+	}
+	checkGolden(t, "raw", scores)
+}
+
+func FuzzRawScores(f *testing.F) {
+	for language := range rawIndices {
+		f.Add(rawText(f, language))
+	}
+	f.Add("0000000000000000000000000000")
+	f.Fuzz(func(t *testing.T, text string) {
+		for _, indices := range rawIndices {
+			for _, index := range indices {
+				rounded, roundedErr := index.rounded(text)
+				raw, rawErr := index.raw(text)
+				if (roundedErr == nil) != (rawErr == nil) {
+					t.Errorf("%s of %q returned the errors %v and %v without rounding", index.name, text, roundedErr, rawErr)
+				}
+				// The scores are rounded to a whole number at most, ARI is rounded up, and Gulpease is not negative.
+				if index.name == "Gulpease" {
+					raw = max(raw, 0)
+				}
+				if roundedErr == nil && rawErr == nil && math.Abs(rounded-raw) > 1 {
+					t.Errorf("%s of %q = %v, which is not %v rounded", index.name, text, rounded, raw)
+				}
+			}
+		}
+	})
+}
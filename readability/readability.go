@@ -0,0 +1,89 @@
+// Package `readability` provides functions and types to combine the readability indices of the language packages into a single result.
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+	"sort"
+)
+
+// ====== Types ======
+
+// GradeResult represents the score of one readability index, the US grade level it corresponds to, and the minimal age of the readers of the grade.
+type GradeResult struct {
+	Metric string  `json:"metric"`
+	Score  float64 `json:"score"`
+	Grade  float64 `json:"grade"`
+	Age    float64 `json:"age"`
+}
+
+// ConsensusResult represents the consensus grade level of several readability indices together with the individual results.
+type ConsensusResult struct {
+	Results []GradeResult `json:"results"`
+	Median  float64       `json:"median"`
+	Mean    float64       `json:"mean"`
+}
+
+// ====== Functions ======
+
+// Consensus accepts a non-empty string, calculates the registered grade metrics of its language for it (see `RegisterLanguage`; for English, by default ARI,
+// Coleman–Liau index, Flesch-Kincaid grade level, SMOG grade, and Gunning fog index), converts each score to a US grade level, and returns the median and the mean grade
+// together with the individual results. The language is set with `stats.WithLanguage` and defaults to English; the grade metrics registered for every language are used too.
+// The string is counted once with the given options, and all metrics share the same statistics.
+// Indices that cannot be calculated for the string are skipped. Negative grades are treated as 0.
+// The median and the mean are rounded to the first decimal point.
+func Consensus(s string, opts ...stats.Option) (ConsensusResult, error) {
+	if len(s) == 0 {
+		return ConsensusResult{}, errors.New("Empty string.")
+	}
+
+	doc := stats.NewDocument(s, opts...)
+	language := doc.Config().Language
+	var result ConsensusResult
+	for _, m := range Metrics() {
+		metric, ok := m.(GradeMetric)
+		if !ok {
+			continue
+		}
+		if metricLanguage, _ := MetricLanguage(m.Name()); metricLanguage != "" && metricLanguage != language {
+			continue
+		}
+		score, err := metric.Compute(doc)
+		if err != nil {
+			continue
+		}
+		grade := math.Max(metric.Grade(score), 0)
+		result.Results = append(result.Results, GradeResult{metric.Name(), float64(score), grade, GradeAge(grade)})
+	}
+	if len(result.Results) == 0 {
+		return ConsensusResult{}, errors.New("No readability index could be calculated. Cannot calculate consensus grade.")
+	}
+
+	grades := make([]float64, 0, len(result.Results))
+	for _, r := range result.Results {
+		grades = append(grades, r.Grade)
+	}
+	result.Median = math.Round(median(grades)*10) / 10
+	result.Mean = math.Round(mean(grades)*10) / 10
+	return result, nil
+}
+
+// median returns the median of a non-empty slice. The slice is sorted in place.
+func median(values []float64) float64 {
+	sort.Float64s(values)
+	middle := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[middle-1] + values[middle]) / 2
+	}
+	return values[middle]
+}
+
+// mean returns the arithmetic mean of a non-empty slice.
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
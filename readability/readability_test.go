@@ -0,0 +1,160 @@
+package readability_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"goreadability/readability"
+	"goreadability/stats"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// readTestdata returns the content of the file in testdata.
+func readTestdata(t testing.TB, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// checkGolden compares the value, marshaled to indented JSON, with the golden file in testdata, or writes the file with -update.
+func checkGolden(t testing.TB, name string, value any) {
+	t.Helper()
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		t.Fatal(err)
+	}
+	got := b.Bytes()
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s: got\n%s\nwant\n%s", name, got, want)
+	}
+}
+
+// checkJSON reports an error if the result for the text cannot be marshaled to JSON, as when a score is NaN or infinite.
+func checkJSON(t testing.TB, text string, value any) {
+	t.Helper()
+	if _, err := json.Marshal(value); err != nil {
+		t.Errorf("the result for %q cannot be marshaled: %v", text, err)
+	}
+}
+
+func TestConsensus(t *testing.T) {
+	result, err := readability.Consensus(string(readTestdata(t, "sample.txt")))
+	if err != nil {
+		t.Fatalf("Consensus() returned error: %v", err)
+	}
+	checkGolden(t, "consensus", result)
+
+	for _, text := range []string{"", "   "} {
+		if _, err := readability.Consensus(text); err == nil {
+			t.Errorf("Consensus(%q) returned no error", text)
+		}
+	}
+}
+
+func FuzzConsensus(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")))
+	f.Add("A. B. C.")
+	f.Add("Supercalifragilisticexpialidocious")
+	f.Fuzz(func(t *testing.T, text string) {
+		result, err := readability.Consensus(text)
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, result)
+		for _, r := range result.Results {
+			if r.Grade < 0 {
+				t.Errorf("Consensus(%q) has the negative grade %v of %s", text, r.Grade, r.Metric)
+			}
+		}
+	})
+}
+
+func TestConsensusGrades(t *testing.T) {
+	tests := []struct {
+		text   string
+		grades []float64
+		median float64
+		mean   float64
+	}{
+		// 6 words of 17 letters and 6 syllables in 1 sentence: the negative grades of ARI (-6), CLI (-4.1), and FKG (-1.4) are 0.
+		{"The cat sat on the mat.", []float64{0, 0, 0, 3.1, 2.4}, 0, 1.1},
+		// 10 words of 48 letters and 14 syllables in 1 sentence, with 1 polysyllable: ARI 7 (rounded up) is grade 6.
+		{"The children played on the swings until it was dinnertime.", []float64{6, 9.5, 4.8, 8.8, 8}, 8, 7.4},
+	}
+	for _, test := range tests {
+		got, err := readability.Consensus(test.text)
+		if err != nil {
+			t.Errorf("Consensus(%q) returned error: %v", test.text, err)
+			continue
+		}
+		var metrics []string
+		var grades []float64
+		for _, r := range got.Results {
+			metrics = append(metrics, r.Metric)
+			grades = append(grades, r.Grade)
+		}
+		if !slices.Equal(metrics, []string{"ARI", "CLI", "FKG", "SMOG", "Gunning fog"}) || !slices.Equal(grades, test.grades) || got.Median != test.median || got.Mean != test.mean {
+			t.Errorf("Consensus(%q) = %v %v, median %v, mean %v, want %v, median %v, mean %v", test.text, metrics, grades, got.Median, got.Mean, test.grades, test.median, test.mean)
+		}
+	}
+}
+
+func TestConsensusLanguage(t *testing.T) {
+	// The metric is calculated for the Italian text of the test only, so it doesn't change the results of the other tests.
+	text := "Il gatto dorme sul divano. Il cane corre nel giardino."
+	italian := readability.NewGradeMetric("test Italian grade",
+		func(doc *stats.Document) (readability.Score, error) {
+			if doc.Config().Language != "it" || doc.Text() != text {
+				return 0, errors.New("Not the test text.")
+			}
+			return readability.Score(doc.Words()), nil
+		},
+		func(readability.Score) readability.Interpretation { return readability.Interpretation{} },
+		func(score readability.Score) float64 { return float64(score) })
+	if _, ok := readability.Lookup(italian.Name()); !ok {
+		if err := readability.RegisterLanguage("IT", italian); err != nil {
+			t.Fatalf("RegisterLanguage() returned error: %v", err)
+		}
+	}
+	if language, ok := readability.MetricLanguage(italian.Name()); !ok || language != "it" {
+		t.Errorf("MetricLanguage(%q) = %q, %v, want \"it\"", italian.Name(), language, ok)
+	}
+	if language, ok := readability.MetricLanguage("FKG"); !ok || language != "en" {
+		t.Errorf("MetricLanguage(\"FKG\") = %q, %v, want \"en\"", language, ok)
+	}
+
+	// The English grade metrics are not used for the Italian text.
+	got, err := readability.Consensus(text, stats.WithLanguage("it"))
+	if err != nil || len(got.Results) != 1 || got.Results[0].Metric != italian.Name() || got.Median != 10 {
+		t.Errorf("Consensus(%q) in Italian = %+v, %v, want the test metric only, with the grade 10", text, got, err)
+	}
+	if _, err := readability.Consensus(text, stats.WithLanguage("es")); err == nil {
+		t.Errorf("Consensus(%q) in Spanish returned no error", text)
+	}
+	if got, err := readability.Consensus(text); err != nil || len(got.Results) != 5 {
+		t.Errorf("Consensus(%q) in English = %+v, %v, want the 5 English metrics", text, got, err)
+	}
+}
@@ -0,0 +1,108 @@
+package readability
+
+import (
+	"errors"
+	"fmt"
+	"goreadability/stats"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// ====== Types ======
+
+// RecommendationKind represents the problem a Recommendation points at.
+type RecommendationKind string
+
+const (
+	LongSentence      RecommendationKind = "long_sentence"
+	ComplexSentence   RecommendationKind = "complex_sentence"
+	PassiveSentence   RecommendationKind = "passive_sentence"
+	LongWordParagraph RecommendationKind = "long_word_paragraph"
+)
+
+// Recommendation represents an actionable suggestion for a part of a text, with the byte offsets of the part.
+type Recommendation struct {
+	Kind    RecommendationKind `json:"kind"`
+	Start   int                `json:"start"`
+	End     int                `json:"end"`
+	Message string             `json:"message"`
+}
+
+// RecommendationOptions represents the thresholds above which the parts of a text are flagged. A zero threshold disables the check.
+type RecommendationOptions struct {
+	// MaxSentenceWords is the maximal number of words in a sentence.
+	MaxSentenceWords uint `json:"max_sentence_words"`
+	// MaxSentenceSyllables is the maximal number of syllables in a sentence.
+	MaxSentenceSyllables uint `json:"max_sentence_syllables"`
+	// MaxPassiveConstructions is the maximal number of passive constructions ("was written", "are being made") in a sentence.
+	MaxPassiveConstructions uint `json:"max_passive_constructions"`
+	// MaxAverageWordLength is the maximal average number of characters per word in a paragraph.
+	MaxAverageWordLength float64 `json:"max_average_word_length"`
+}
+
+// DefaultRecommendationOptions are the thresholds commonly recommended for plain English.
+var DefaultRecommendationOptions = RecommendationOptions{
+	MaxSentenceWords:        25,
+	MaxSentenceSyllables:    40,
+	MaxPassiveConstructions: 1,
+	MaxAverageWordLength:    6,
+}
+
+// ====== Functions ======
+
+// Recommend accepts a non-empty English string, the thresholds, and options and returns the recommendations for the text in the order of their offsets:
+// sentences with more words or syllables than allowed, sentences with too many passive constructions, and paragraphs (separated by blank lines) with too long words on average.
+// Passive constructions are detected as in `DetectPassiveVoice`.
+func Recommend(s string, ro RecommendationOptions, opts ...stats.Option) ([]Recommendation, error) {
+	if len(s) == 0 {
+		return nil, errors.New("Empty string.")
+	}
+
+	sentences, err := AnalyzeSentences(s, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenizer := stats.NewConfig(opts...).Tokenizer
+	var recommendations []Recommendation
+	for _, p := range stats.Paragraphs(s) {
+		for len(sentences) > 0 && sentences[0].Start < p.End {
+			sentence := sentences[0]
+			sentences = sentences[1:]
+			if ro.MaxSentenceWords > 0 && sentence.Words > ro.MaxSentenceWords {
+				recommendations = append(recommendations, Recommendation{LongSentence, sentence.Start, sentence.End,
+					fmt.Sprintf("Split this %d-word sentence.", sentence.Words)})
+			}
+			if ro.MaxSentenceSyllables > 0 && sentence.Syllables > ro.MaxSentenceSyllables {
+				recommendations = append(recommendations, Recommendation{ComplexSentence, sentence.Start, sentence.End,
+					fmt.Sprintf("Use simpler words in this sentence: it has %d syllables.", sentence.Syllables)})
+			}
+			if passives := countPassiveConstructions(tokenizer(sentence.Text)); ro.MaxPassiveConstructions > 0 && passives > ro.MaxPassiveConstructions {
+				recommendations = append(recommendations, Recommendation{PassiveSentence, sentence.Start, sentence.End,
+					fmt.Sprintf("Rewrite this sentence in the active voice: it has %d passive constructions.", passives)})
+			}
+		}
+
+		if ro.MaxAverageWordLength <= 0 {
+			continue
+		}
+		st := stats.CountAllStats(s[p.Start:p.End], opts...)
+		if st.Words == 0 {
+			continue
+		}
+		if average := float64(st.Characters) / float64(st.Words); average > ro.MaxAverageWordLength {
+			recommendations = append(recommendations, Recommendation{LongWordParagraph, p.Start, p.End,
+				fmt.Sprintf("Use shorter words in this paragraph: the average word is %.1f characters long.", math.Round(average*10)/10)})
+		}
+	}
+	return recommendations, nil
+}
+
+// normalizeWord returns the word in lower case with the leading and trailing punctuation trimmed off and the typographic apostrophes made straight.
+func normalizeWord(word string) string {
+	word = strings.ReplaceAll(word, "’", "'")
+	return strings.ToLower(strings.TrimFunc(word, func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsNumber(c)
+	}))
+}
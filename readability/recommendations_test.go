@@ -0,0 +1,55 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"testing"
+)
+
+func TestRecommend(t *testing.T) {
+	text := string(readTestdata(t, "sample.txt"))
+	recommendations := map[string][]readability.Recommendation{}
+	for name, ro := range map[string]readability.RecommendationOptions{
+		"default":  readability.DefaultRecommendationOptions,
+		"strict":   {MaxSentenceWords: 15, MaxSentenceSyllables: 20, MaxPassiveConstructions: 0, MaxAverageWordLength: 4.5},
+		"disabled": {},
+	} {
+		var err error
+		if recommendations[name], err = readability.Recommend(text, ro); err != nil {
+			t.Fatalf("Recommend() with the %s options returned error: %v", name, err)
+		}
+	}
+	checkGolden(t, "recommendations", recommendations)
+	if len(recommendations["disabled"]) != 0 {
+		t.Errorf("Recommend() with the checks disabled = %v, want none", recommendations["disabled"])
+	}
+
+	for _, text := range []string{"", "   "} {
+		if _, err := readability.Recommend(text, readability.DefaultRecommendationOptions); err == nil {
+			t.Errorf("Recommend(%q) returned no error", text)
+		}
+	}
+}
+
+func FuzzRecommend(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")), uint(25), 6.0)
+	f.Add("Short.\n\nThe report was written and was reviewed.", uint(1), 2.0)
+	f.Add("Heading\n \nText", uint(0), 0.0)
+	f.Fuzz(func(t *testing.T, text string, maxWords uint, maxLength float64) {
+		ro := readability.RecommendationOptions{MaxSentenceWords: maxWords, MaxSentenceSyllables: 2 * maxWords, MaxPassiveConstructions: 1, MaxAverageWordLength: maxLength}
+		recommendations, err := readability.Recommend(text, ro)
+		if err != nil {
+			return
+		}
+		// The sentences of a paragraph are followed by the paragraph itself, so the recommendations are sorted by their ends.
+		end := 0
+		for _, r := range recommendations {
+			if r.Start < 0 || r.End < r.Start || r.End > len(text) || r.End < end {
+				t.Fatalf("the recommendation %+v for %q is out of order or out of the text", r, text)
+			}
+			end = r.End
+			if r.Message == "" {
+				t.Errorf("the recommendation %+v for %q has no message", r, text)
+			}
+		}
+	})
+}
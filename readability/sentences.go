@@ -0,0 +1,58 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/en"
+	"goreadability/stats"
+	"math"
+)
+
+// LONG_WORD_LENGTH is the minimal number of letters for a word to be counted as a long word.
+const LONG_WORD_LENGTH = 7
+
+// ====== Types ======
+
+// SentenceStats represents the readability statistics of one sentence of a text.
+type SentenceStats struct {
+	stats.Sentence
+	Words         uint    `json:"words"`
+	Syllables     uint    `json:"syllables"`
+	Polysyllables uint    `json:"polysyllables"`
+	LongWords     uint    `json:"long_words"`
+	Grade         float64 `json:"grade"`
+}
+
+// ====== Functions ======
+
+// AnalyzeSentences accepts a non-empty string and options and returns the readability statistics of each of its sentences, with byte offsets into the string (see `stats.Sentences`),
+// so the sentences that make the text difficult can be found.
+// The grade of a sentence is its Flesch-Kincaid grade level, negative grades are treated as 0.
+func AnalyzeSentences(s string, opts ...stats.Option) ([]SentenceStats, error) {
+	if len(s) == 0 {
+		return nil, errors.New("Empty string.")
+	}
+
+	sentences := stats.Sentences(s, opts...)
+	if len(sentences) == 0 {
+		return nil, errors.New("No sentences were parsed. Cannot analyze sentences.")
+	}
+
+	results := make([]SentenceStats, 0, len(sentences))
+	for _, sentence := range sentences {
+		st := stats.CountAllStats(sentence.Text, opts...)
+		st.Sentences = 1
+
+		result := SentenceStats{
+			Sentence:      sentence,
+			Words:         st.Words,
+			Syllables:     st.Syllables,
+			Polysyllables: st.Polysyllables,
+			LongWords:     stats.CountLongWords(sentence.Text, LONG_WORD_LENGTH, opts...),
+		}
+		if grade, err := en.CalcFKGFromStats(st); err == nil {
+			result.Grade = math.Max(grade, 0)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
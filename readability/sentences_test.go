@@ -0,0 +1,47 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"math"
+	"testing"
+)
+
+func TestAnalyzeSentences(t *testing.T) {
+	sentences, err := readability.AnalyzeSentences(string(readTestdata(t, "sample.txt")))
+	if err != nil {
+		t.Fatalf("AnalyzeSentences() returned error: %v", err)
+	}
+	checkGolden(t, "sentences", sentences)
+
+	for _, text := range []string{"", "   "} {
+		if _, err := readability.AnalyzeSentences(text); err == nil {
+			t.Errorf("AnalyzeSentences(%q) returned no error", text)
+		}
+	}
+}
+
+func FuzzAnalyzeSentences(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")))
+	f.Add("Heading\n\nDr. Smith arrived. Then?! No")
+	f.Add("“Quoted.” (Parenthesized.) …")
+	f.Fuzz(func(t *testing.T, text string) {
+		sentences, err := readability.AnalyzeSentences(text)
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, sentences)
+		end := 0
+		for _, sentence := range sentences {
+			if sentence.Start < end || sentence.End < sentence.Start || sentence.End > len(text) || text[sentence.Start:sentence.End] != sentence.Text {
+				t.Fatalf("the sentence %+v of %q is not at its offsets after %d", sentence.Sentence, text, end)
+			}
+			end = sentence.End
+			if sentence.Grade < 0 || math.IsInf(sentence.Grade, 0) {
+				t.Errorf("the grade of %q in %q = %v", sentence.Text, text, sentence.Grade)
+			}
+			if sentence.Polysyllables > sentence.Words || sentence.LongWords > sentence.Words {
+				t.Errorf("the sentence %q of %q has more polysyllables or long words than words: %+v", sentence.Text, text, sentence)
+			}
+		}
+	})
+}
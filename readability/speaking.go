@@ -0,0 +1,70 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+	"time"
+)
+
+// ====== Types ======
+
+// SpeakingOptions represents the pace of reading a text aloud.
+type SpeakingOptions struct {
+	// SyllablesPerSecond is the speech rate. Narration is usually slower (3.5–4) than conversation (5 and more).
+	SyllablesPerSecond float64 `json:"syllables_per_second"`
+	// SentencePause is the pause after each sentence.
+	SentencePause time.Duration `json:"sentence_pause"`
+	// ParagraphPause is the pause between paragraphs (separated by blank lines), in addition to the sentence pause.
+	ParagraphPause time.Duration `json:"paragraph_pause"`
+}
+
+// DefaultSpeakingOptions is the pace of an unhurried narration: 4 syllables per second, half a second after a sentence, and a second between paragraphs.
+var DefaultSpeakingOptions = SpeakingOptions{
+	SyllablesPerSecond: 4,
+	SentencePause:      500 * time.Millisecond,
+	ParagraphPause:     time.Second,
+}
+
+// SpeakingTime represents the estimated time of reading a text aloud and the resulting pace in words per minute.
+type SpeakingTime struct {
+	Duration       time.Duration `json:"duration"`
+	Syllables      uint          `json:"syllables"`
+	Words          uint          `json:"words"`
+	WordsPerMinute float64       `json:"words_per_minute"`
+}
+
+// ====== Functions ======
+
+// EstimateSpeakingTime accepts a non-empty string, the pace, and options and returns the estimated time of reading the text aloud:
+// the syllables divided by the speech rate, plus the pauses after the sentences and between the paragraphs. The duration is rounded to tenths of a second,
+// and the words per minute to the first decimal point. Syllables estimate the speech time better than words, as long words take longer to say.
+func EstimateSpeakingTime(s string, so SpeakingOptions, opts ...stats.Option) (SpeakingTime, error) {
+	if len(s) == 0 {
+		return SpeakingTime{}, errors.New("Empty string.")
+	}
+	if so.SyllablesPerSecond <= 0 || math.IsNaN(so.SyllablesPerSecond) {
+		return SpeakingTime{}, errors.New("Syllables per second must be positive. Cannot estimate speaking time.")
+	}
+
+	st := stats.CountAllStats(s, opts...)
+	if st.Words == 0 {
+		return SpeakingTime{}, errors.New("No words were parsed. Cannot estimate speaking time.")
+	}
+
+	seconds := float64(st.Syllables) / so.SyllablesPerSecond
+	if seconds*float64(time.Second) >= math.MaxInt64 {
+		return SpeakingTime{}, errors.New("Speaking time is too long for a duration. Cannot estimate speaking time.")
+	}
+	duration := time.Duration(seconds*float64(time.Second)) + time.Duration(st.Sentences)*so.SentencePause
+	if paragraphs := stats.CountParagraphs(s, opts...); paragraphs > 1 {
+		duration += time.Duration(paragraphs-1) * so.ParagraphPause
+	}
+	duration = duration.Round(100 * time.Millisecond)
+
+	result := SpeakingTime{Duration: duration, Syllables: st.Syllables, Words: st.Words}
+	if duration > 0 {
+		result.WordsPerMinute = math.Round(float64(st.Words)/duration.Minutes()*10) / 10
+	}
+	return result, nil
+}
@@ -0,0 +1,54 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEstimateSpeakingTime(t *testing.T) {
+	text := string(readTestdata(t, "sample.txt"))
+	times := map[string]readability.SpeakingTime{}
+	for name, so := range map[string]readability.SpeakingOptions{
+		"default":      readability.DefaultSpeakingOptions,
+		"conversation": {SyllablesPerSecond: 5},
+	} {
+		var err error
+		if times[name], err = readability.EstimateSpeakingTime(text, so); err != nil {
+			t.Fatalf("EstimateSpeakingTime() with the %s pace returned error: %v", name, err)
+		}
+	}
+	checkGolden(t, "speaking", times)
+
+	// The pauses are added after the sentences and between the paragraphs.
+	got, err := readability.EstimateSpeakingTime("Go now. Go.\n\nGo.", readability.SpeakingOptions{SyllablesPerSecond: 1, SentencePause: time.Second, ParagraphPause: 10 * time.Second})
+	if err != nil || got.Duration != 17*time.Second {
+		t.Errorf("EstimateSpeakingTime() = %+v, %v, want 17s", got, err)
+	}
+
+	for _, test := range []struct {
+		text string
+		rate float64
+	}{{"", 4}, {"   ", 4}, {"Go.", 0}, {"Go.", -1}, {"Go.", math.NaN()}, {"Go.", 1e-300}} {
+		if _, err := readability.EstimateSpeakingTime(test.text, readability.SpeakingOptions{SyllablesPerSecond: test.rate}); err == nil {
+			t.Errorf("EstimateSpeakingTime(%q) at %v syllables per second returned no error", test.text, test.rate)
+		}
+	}
+}
+
+func FuzzEstimateSpeakingTime(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")), 4.0, int64(time.Second))
+	f.Add("Go.\n\nGo.", 0.001, int64(0))
+	f.Fuzz(func(t *testing.T, text string, rate float64, pause int64) {
+		so := readability.SpeakingOptions{SyllablesPerSecond: rate, SentencePause: time.Duration(pause), ParagraphPause: time.Duration(pause)}
+		result, err := readability.EstimateSpeakingTime(text, so)
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, result)
+		if pause >= 0 && pause <= int64(time.Hour) && result.Duration < 0 {
+			t.Errorf("EstimateSpeakingTime(%q, %+v) = %v, want not negative", text, so, result.Duration)
+		}
+	})
+}
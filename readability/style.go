@@ -0,0 +1,132 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ====== Types ======
+
+// WordDensity represents the occurrences of a kind of words in a text and their density: the number of occurrences per 100 words.
+type WordDensity struct {
+	Count       uint          `json:"count"`
+	Per100Words float64       `json:"per_100_words"`
+	Words       []stats.Token `json:"words"`
+}
+
+// StyleDensity represents the densities of the words that weaken a text: adverbs ending in "-ly", hedging words, and fillers.
+type StyleDensity struct {
+	Words   uint        `json:"words"`
+	Adverbs WordDensity `json:"adverbs"`
+	Hedges  WordDensity `json:"hedges"`
+	Fillers WordDensity `json:"fillers"`
+}
+
+// HedgeWords are the English words and phrases that make a statement less certain.
+var HedgeWords = []string{
+	"apparently", "arguably", "perhaps", "maybe", "possibly", "probably", "presumably", "seemingly", "somewhat", "relatively", "fairly", "rather",
+	"might", "could", "seem", "seems", "seemed", "suggest", "suggests", "appear", "appears", "appeared",
+	"i think", "i believe", "i feel", "i guess", "in my opinion", "it seems", "sort of", "kind of", "to some extent", "more or less",
+}
+
+// DefaultFillers are the English words and phrases that usually add nothing to a sentence.
+var DefaultFillers = []string{
+	"actually", "basically", "really", "very", "just", "literally", "totally", "simply", "quite", "definitely", "certainly", "essentially", "honestly",
+	"anyway", "obviously", "clearly", "needless to say", "in order to", "the fact that", "at the end of the day", "for all intents and purposes",
+}
+
+// notAdverbs are the English words ending in "-ly" that are not adverbs.
+var notAdverbs = map[string]bool{
+	"ally": true, "anomaly": true, "apply": true, "assembly": true, "belly": true, "bully": true, "comply": true, "costly": true, "curly": true,
+	"daily": true, "early": true, "family": true, "friendly": true, "holy": true, "homely": true, "italy": true, "jelly": true, "july": true,
+	"likely": true, "lively": true, "lonely": true, "lovely": true, "monopoly": true, "only": true, "rally": true, "reply": true, "silly": true,
+	"supply": true, "ugly": true, "elderly": true, "orderly": true, "timely": true, "unlikely": true, "butterfly": true, "lily": true, "jolly": true,
+}
+
+// ====== Functions ======
+
+// AnalyzeStyleDensity accepts a non-empty English string, the filler words and phrases, and options and returns the densities of the adverbs ending in "-ly",
+// the hedging words (see `HedgeWords`), and the fillers in it, with their positions. If the fillers are nil, `DefaultFillers` are used.
+// The words and phrases are matched case-insensitively and as whole words. The densities are rounded to the first decimal point.
+func AnalyzeStyleDensity(s string, fillers []string, opts ...stats.Option) (StyleDensity, error) {
+	if len(s) == 0 {
+		return StyleDensity{}, errors.New("Empty string.")
+	}
+	words := stats.Words(s, opts...)
+	if len(words) == 0 {
+		return StyleDensity{}, errors.New("No words were parsed. Cannot calculate style density.")
+	}
+	if fillers == nil {
+		fillers = DefaultFillers
+	}
+
+	var adverbs []stats.Token
+	for _, word := range words {
+		if isLyAdverb(normalizeWord(word.Text)) {
+			adverbs = append(adverbs, word)
+		}
+	}
+	total := uint(len(words))
+	return StyleDensity{
+		Words:   total,
+		Adverbs: newWordDensity(adverbs, total),
+		Hedges:  newWordDensity(matchPhrases(s, words, HedgeWords), total),
+		Fillers: newWordDensity(matchPhrases(s, words, fillers), total),
+	}, nil
+}
+
+// newWordDensity returns the WordDensity of the occurrences among the total number of words.
+func newWordDensity(occurrences []stats.Token, total uint) WordDensity {
+	return WordDensity{
+		Count:       uint(len(occurrences)),
+		Per100Words: math.Round(float64(len(occurrences))/float64(total)*1000) / 10,
+		Words:       occurrences,
+	}
+}
+
+// isLyAdverb reports whether the lower case word looks like an adverb ending in "-ly" ("quickly", "really").
+func isLyAdverb(word string) bool {
+	return len(word) > 4 && strings.HasSuffix(word, "ly") && !notAdverbs[word]
+}
+
+// matchPhrases returns the occurrences of the words and phrases among the words of the text, in the order of their positions. The phrases are matched case-insensitively.
+// An occurrence of a phrase spans all its words, the longest phrase wins at each position ("load balancer" over "load"), and the occurrences do not overlap.
+func matchPhrases(s string, words []stats.Token, phrases []string) []stats.Token {
+	split := make([][]string, 0, len(phrases))
+	for _, phrase := range phrases {
+		if fields := strings.Fields(strings.ToLower(phrase)); len(fields) > 0 {
+			split = append(split, fields)
+		}
+	}
+	sort.SliceStable(split, func(i, j int) bool { return len(split[i]) > len(split[j]) })
+
+	var occurrences []stats.Token
+	for i := 0; i < len(words); i++ {
+		for _, phrase := range split {
+			if !matchesAt(words, i, phrase) {
+				continue
+			}
+			last := words[i+len(phrase)-1]
+			occurrences = append(occurrences, stats.Token{Text: s[words[i].Start:last.End], Start: words[i].Start, End: last.End})
+			i += len(phrase) - 1
+			break
+		}
+	}
+	return occurrences
+}
+
+// matchesAt reports whether the words starting at the index are the words of the phrase.
+func matchesAt(words []stats.Token, i int, phrase []string) bool {
+	if i+len(phrase) > len(words) {
+		return false
+	}
+	for j, word := range phrase {
+		if normalizeWord(words[i+j].Text) != word {
+			return false
+		}
+	}
+	return true
+}
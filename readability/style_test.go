@@ -0,0 +1,104 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"goreadability/stats"
+	"slices"
+	"testing"
+)
+
+func TestAnalyzeStyleDensity(t *testing.T) {
+	text := string(readTestdata(t, "sample.txt"))
+	densities := map[string]readability.StyleDensity{}
+	for name, fillers := range map[string][]string{"default": nil, "custom": {"a team of", "WHO"}, "none": {}} {
+		var err error
+		if densities[name], err = readability.AnalyzeStyleDensity(text, fillers); err != nil {
+			t.Fatalf("AnalyzeStyleDensity() with the %s fillers returned error: %v", name, err)
+		}
+	}
+	checkGolden(t, "style", densities)
+
+	for _, text := range []string{"", "   "} {
+		if _, err := readability.AnalyzeStyleDensity(text, nil); err == nil {
+			t.Errorf("AnalyzeStyleDensity(%q) returned no error", text)
+		}
+	}
+}
+
+func TestAnalyzeStyleDensityWords(t *testing.T) {
+	tests := []struct {
+		text    string
+		fillers []string
+		words   uint
+		adverbs []string
+		hedges  []string
+		filler  []string
+		per100  [3]float64
+	}{
+		// An adverb can be a filler too.
+		{"I think it is really quite good. She quickly left, perhaps.", nil, 11, []string{"really", "quickly"}, []string{"I think", "perhaps"}, []string{"really", "quite"}, [3]float64{18.2, 18.2, 18.2}},
+		{"The family had an early, lovely day.", nil, 7, nil, nil, nil, [3]float64{0, 0, 0}},
+		{"A team of doctors, who knew, said it might work.", []string{"a team of", "WHO"}, 10, nil, []string{"might"}, []string{"A team of", "who"}, [3]float64{0, 10, 20}},
+		{"It is very, VERY simple.", []string{}, 5, nil, nil, nil, [3]float64{0, 0, 0}},
+	}
+	for _, test := range tests {
+		got, err := readability.AnalyzeStyleDensity(test.text, test.fillers)
+		if err != nil {
+			t.Errorf("AnalyzeStyleDensity(%q) returned error: %v", test.text, err)
+			continue
+		}
+		densities := []struct {
+			name    string
+			density readability.WordDensity
+			want    []string
+			per100  float64
+		}{
+			{"adverbs", got.Adverbs, test.adverbs, test.per100[0]},
+			{"hedges", got.Hedges, test.hedges, test.per100[1]},
+			{"fillers", got.Fillers, test.filler, test.per100[2]},
+		}
+		for _, density := range densities {
+			var words []string
+			for _, word := range density.density.Words {
+				words = append(words, word.Text)
+			}
+			if !slices.Equal(words, density.want) || density.density.Count != uint(len(density.want)) || density.density.Per100Words != density.per100 {
+				t.Errorf("AnalyzeStyleDensity(%q) %s = %q (%v per 100 words), want %q (%v per 100 words)", test.text, density.name, words, density.density.Per100Words, density.want, density.per100)
+			}
+		}
+		if got.Words != test.words {
+			t.Errorf("AnalyzeStyleDensity(%q) = %d words, want %d", test.text, got.Words, test.words)
+		}
+	}
+}
+
+// checkOccurrences reports an error if the occurrences of the words are not in the order of their positions in the text or overlap.
+func checkOccurrences(t testing.TB, text string, occurrences []stats.Token) {
+	t.Helper()
+	end := 0
+	for _, word := range occurrences {
+		if word.Start < end || word.End < word.Start || word.End > len(text) || text[word.Start:word.End] != word.Text {
+			t.Fatalf("the occurrence %+v in %q is not at its offsets after %d", word, text, end)
+		}
+		end = word.End
+	}
+}
+
+func FuzzAnalyzeStyleDensity(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")), "basically")
+	f.Add("It is really, really quite, you know, sort of fine.", "you know")
+	f.Add("Perhaps.", "")
+	f.Fuzz(func(t *testing.T, text, filler string) {
+		density, err := readability.AnalyzeStyleDensity(text, []string{filler, "sort of"})
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, density)
+		for _, words := range []readability.WordDensity{density.Adverbs, density.Hedges, density.Fillers} {
+			checkOccurrences(t, text, words.Words)
+			if words.Count != uint(len(words.Words)) || words.Count > density.Words || words.Per100Words > 100 {
+				t.Errorf("the density of %q = %+v of %d words", text, words, density.Words)
+			}
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package readability
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+)
+
+// ====== Types ======
+
+// TargetAudience represents the readers a text is written for.
+type TargetAudience struct {
+	MaxGrade float64 `json:"max_grade"`
+}
+
+// TargetViolation represents a metric which grade level exceeds the target audience.
+type TargetViolation struct {
+	Metric string  `json:"metric"`
+	Grade  float64 `json:"grade"`
+	Excess float64 `json:"excess"`
+}
+
+// TargetResult represents whether a text suits the target audience, with the grade levels of all metrics and the metrics that exceeded the target.
+type TargetResult struct {
+	Pass       bool              `json:"pass"`
+	Target     TargetAudience    `json:"target"`
+	Results    []GradeResult     `json:"results"`
+	Violations []TargetViolation `json:"violations"`
+}
+
+// ====== Functions ======
+
+// CheckTarget accepts a non-empty string, a target audience, and options and checks whether the text suits the audience.
+// All registered metrics are converted to US grade levels (see `NormalizeAll`), and the text passes if none of them exceeds `MaxGrade`.
+// Returns an error if no metric could be calculated for the string.
+func CheckTarget(s string, target TargetAudience, opts ...stats.Option) (TargetResult, error) {
+	if len(s) == 0 {
+		return TargetResult{}, errors.New("Empty string.")
+	}
+
+	results, err := NormalizeAll(s, opts...)
+	if err != nil {
+		return TargetResult{}, err
+	}
+	if len(results) == 0 {
+		return TargetResult{}, errors.New("No readability index could be calculated. Cannot check target audience.")
+	}
+
+	result := TargetResult{Target: target, Results: results}
+	for _, r := range results {
+		if r.Grade > target.MaxGrade {
+			excess := math.Round((r.Grade-target.MaxGrade)*10) / 10
+			result.Violations = append(result.Violations, TargetViolation{r.Metric, r.Grade, excess})
+		}
+	}
+	result.Pass = len(result.Violations) == 0
+	return result, nil
+}
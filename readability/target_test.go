@@ -0,0 +1,60 @@
+package readability_test
+
+import (
+	"goreadability/readability"
+	"math"
+	"testing"
+)
+
+func TestCheckTarget(t *testing.T) {
+	text := string(readTestdata(t, "sample.txt"))
+	var results []readability.TargetResult
+	for _, grade := range []float64{8, 20} {
+		result, err := readability.CheckTarget(text, readability.TargetAudience{MaxGrade: grade})
+		if err != nil {
+			t.Fatalf("CheckTarget(%v) returned error: %v", grade, err)
+		}
+		results = append(results, result)
+	}
+	checkGolden(t, "target", results)
+	if results[0].Pass || !results[1].Pass {
+		t.Errorf("CheckTarget() passed %v and %v, want false and true", results[0].Pass, results[1].Pass)
+	}
+
+	for _, text := range []string{"", "   "} {
+		if _, err := readability.CheckTarget(text, readability.TargetAudience{MaxGrade: 8}); err == nil {
+			t.Errorf("CheckTarget(%q) returned no error", text)
+		}
+	}
+}
+
+func FuzzCheckTarget(f *testing.F) {
+	f.Add(string(readTestdata(f, "sample.txt")), 8.0)
+	f.Add("One. Two three.", 0.0)
+	f.Add("Incomprehensibilities notwithstanding.", -1.0)
+	f.Fuzz(func(t *testing.T, text string, maxGrade float64) {
+		if math.IsNaN(maxGrade) {
+			return
+		}
+		result, err := readability.CheckTarget(text, readability.TargetAudience{MaxGrade: maxGrade})
+		if err != nil {
+			return
+		}
+		checkJSON(t, text, result)
+		// The violations are the results above the target, in the same order.
+		var violations []string
+		for _, r := range result.Results {
+			if r.Grade > maxGrade {
+				violations = append(violations, r.Metric)
+			}
+		}
+		if len(violations) != len(result.Violations) || result.Pass != (len(violations) == 0) {
+			t.Fatalf("CheckTarget(%q, %v) = %+v, want the violations %v", text, maxGrade, result, violations)
+		}
+		for i, v := range result.Violations {
+			if v.Metric != violations[i] || v.Excess < 0 {
+				t.Errorf("CheckTarget(%q, %v) has the violation %+v, want %s", text, maxGrade, v, violations[i])
+			}
+		}
+	})
+}
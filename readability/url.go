@@ -0,0 +1,139 @@
+package readability
+
+import (
+	"context"
+	"fmt"
+	"goreadability/input"
+	"goreadability/stats"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// FETCH_TIMEOUT is the time `FromURL` and `FeedFromURL` wait for a document, unless the context ends earlier.
+const FETCH_TIMEOUT = 30 * time.Second
+
+// MAX_PAGE_SIZE is the maximal size of a document `FromURL` and `FeedFromURL` read, in bytes.
+const MAX_PAGE_SIZE = 5 << 20
+
+// ====== Types ======
+
+// PageReport represents the Report of the article of a web page, with the URL the page was fetched from (after the redirects) and its title.
+type PageReport struct {
+	URL   string `json:"url"`
+	Title string `json:"title"`
+	Report
+}
+
+// fetchedDocument represents a document fetched from a URL: its content, its media type and charset, and its URL after the redirects.
+type fetchedDocument struct {
+	data      []byte
+	mediaType string
+	charset   string
+	url       string
+}
+
+// ====== Functions ======
+
+// FromURL accepts a context, the URL of a web page, and options, fetches the page, and returns the PageReport of its article (see `input.ExtractArticle`).
+// The headings are kept in the text of the article. A plain text page is analyzed as it is.
+// The page must be HTML or plain text in UTF-8, US-ASCII, or ISO-8859-1, and no larger than MAX_PAGE_SIZE. The page is fetched within FETCH_TIMEOUT.
+func FromURL(ctx context.Context, rawURL string, opts ...stats.Option) (PageReport, error) {
+	document, err := fetch(ctx, rawURL, "text/html, application/xhtml+xml, text/plain;q=0.9")
+	if err != nil {
+		return PageReport{}, err
+	}
+	body, err := decodeCharset(document.data, document.charset)
+	if err != nil {
+		return PageReport{}, err
+	}
+
+	page := PageReport{URL: document.url}
+	text := body
+	if document.mediaType != "text/plain" {
+		article := input.ExtractArticle(body, input.BlockOptions{})
+		page.Title = article.Title
+		text = article.Text
+	}
+	if strings.TrimSpace(text) == "" {
+		return PageReport{}, fmt.Errorf("No text was found at %q. Cannot analyze the page.", document.url)
+	}
+	if page.Report, err = NewAnalyzer().Analyze(text, opts...); err != nil {
+		return PageReport{}, err
+	}
+	return page, nil
+}
+
+// fetch fetches the document at the URL, if its media type is accepted, and returns it.
+func fetch(ctx context.Context, rawURL, accept string) (fetchedDocument, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fetchedDocument{}, err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fetchedDocument{}, fmt.Errorf("Unsupported URL scheme %q. Cannot fetch %q.", parsed.Scheme, rawURL)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, FETCH_TIMEOUT)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return fetchedDocument{}, err
+	}
+	request.Header.Set("Accept", accept)
+	request.Header.Set("User-Agent", "goreadability")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fetchedDocument{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fetchedDocument{}, fmt.Errorf("Server responded with %q. Cannot fetch %q.", response.Status, rawURL)
+	}
+	if response.ContentLength > MAX_PAGE_SIZE {
+		return fetchedDocument{}, fmt.Errorf("Document is larger than %d bytes. Cannot fetch %q.", MAX_PAGE_SIZE, rawURL)
+	}
+	// The accepted media types are the ones of the Accept header, and the first one is assumed if the server doesn't send a valid one.
+	var accepted []string
+	for _, mediaType := range strings.Split(accept, ",") {
+		mediaType, _, _ = strings.Cut(mediaType, ";")
+		accepted = append(accepted, strings.TrimSpace(mediaType))
+	}
+	mediaType, params, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = accepted[0], nil
+	}
+	if !slices.Contains(accepted, mediaType) {
+		return fetchedDocument{}, fmt.Errorf("Unsupported content type %q. Cannot analyze %q.", mediaType, rawURL)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, MAX_PAGE_SIZE+1))
+	if err != nil {
+		return fetchedDocument{}, err
+	}
+	if len(data) > MAX_PAGE_SIZE {
+		return fetchedDocument{}, fmt.Errorf("Document is larger than %d bytes. Cannot fetch %q.", MAX_PAGE_SIZE, rawURL)
+	}
+	return fetchedDocument{data, mediaType, params["charset"], response.Request.URL.String()}, nil
+}
+
+// decodeCharset returns the data in the charset as a UTF-8 string. An undeclared charset is UTF-8.
+func decodeCharset(data []byte, charset string) (string, error) {
+	switch strings.ToLower(charset) {
+	case "", "utf-8", "utf8", "us-ascii", "ascii":
+		return strings.ToValidUTF8(strings.TrimPrefix(string(data), "\ufeff"), "\ufffd"), nil
+	case "iso-8859-1", "latin1", "l1":
+		decoded := make([]byte, 0, len(data))
+		for _, b := range data {
+			decoded = utf8.AppendRune(decoded, rune(b))
+		}
+		return string(decoded), nil
+	}
+	return "", fmt.Errorf("Unsupported charset %q. Cannot decode the page.", charset)
+}
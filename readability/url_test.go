@@ -0,0 +1,125 @@
+package readability_test
+
+import (
+	"context"
+	"errors"
+	"goreadability/readability"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFromURL(t *testing.T) {
+	article := "The cat sat on the mat, and the dog slept by the warm fire. They were both very happy that day."
+	page := `<html><head><title>Cats and dogs</title></head><body>
+<nav><a href="/">Home</a> <a href="/news">News</a> <a href="/about">About us and our team</a></nav>
+<div class="sidebar"><p>Subscribe to our newsletter, and get the best stories of the week in your inbox every Monday.</p></div>
+<article><p>` + article + `</p></article>
+<footer><p>Copyright 2024, all rights reserved by the publishers of this fine and very long website.</p></footer>
+</body></html>`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/article":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte(page))
+		case "/plain":
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte(article))
+		case "/latin1":
+			w.Header().Set("Content-Type", "text/plain; charset=iso-8859-1")
+			w.Write([]byte("Un caf\xe9 noir. Il est bon."))
+		}
+	}))
+	defer server.Close()
+
+	want, err := readability.NewAnalyzer().Analyze(article)
+	if err != nil {
+		t.Fatalf("Analyze(%q) returned error: %v", article, err)
+	}
+	tests := []struct {
+		path  string
+		title string
+		words uint
+	}{
+		// The navigation, the sidebar, and the footer are not a part of the article.
+		{"/article", "Cats and dogs", want.Stats.Words},
+		{"/plain", "", want.Stats.Words},
+		{"/latin1", "", 6},
+	}
+	for _, test := range tests {
+		got, err := readability.FromURL(context.Background(), server.URL+test.path)
+		if err != nil {
+			t.Errorf("FromURL(%q) returned error: %v", test.path, err)
+			continue
+		}
+		if got.URL != server.URL+test.path || got.Title != test.title || got.Stats.Words != test.words {
+			t.Errorf("FromURL(%q) = %q, %q, %d words, want %q, %q, %d words", test.path, got.URL, got.Title, got.Stats.Words, server.URL+test.path, test.title, test.words)
+		}
+	}
+
+	got, err := readability.FromURL(context.Background(), server.URL+"/article")
+	if err != nil {
+		t.Fatalf("FromURL(%q) returned error: %v", "/article", err)
+	}
+	if !reflect.DeepEqual(got.Report, want) {
+		t.Errorf("FromURL(%q).Report = %+v, want the Report of the article %+v", "/article", got.Report, want)
+	}
+}
+
+func TestFromURLErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			http.NotFound(w, r)
+		case "/failing":
+			http.Error(w, "Internal error.", http.StatusInternalServerError)
+		case "/large":
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte(strings.Repeat("Word. ", readability.MAX_PAGE_SIZE/6+1)))
+		case "/declared-large":
+			w.Header().Set("Content-Type", "text/plain")
+			w.Header().Set("Content-Length", "10000000")
+		case "/image":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write([]byte("PNG"))
+		case "/empty":
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte("<html><body><nav>Home</nav></body></html>"))
+		case "/slow":
+			<-r.Context().Done()
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/missing", `Server responded with "404 Not Found".`},
+		{"/failing", `Server responded with "500 Internal Server Error".`},
+		{"/large", "Document is larger than 5242880 bytes."},
+		{"/declared-large", "Document is larger than 5242880 bytes."},
+		{"/image", `Unsupported content type "image/png".`},
+		{"/empty", "No text was found"},
+	}
+	for _, test := range tests {
+		_, err := readability.FromURL(context.Background(), server.URL+test.path)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("FromURL(%q) returned error %v, want %q", test.path, err, test.want)
+		}
+	}
+
+	if _, err := readability.FromURL(context.Background(), "ftp://example.com/text"); err == nil || !strings.Contains(err.Error(), `Unsupported URL scheme "ftp".`) {
+		t.Errorf("FromURL(%q) returned error %v, want the unsupported scheme", "ftp://example.com/text", err)
+	}
+
+	// The context ends the request earlier than FETCH_TIMEOUT.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := readability.FromURL(ctx, server.URL+"/slow"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("FromURL(%q) returned error %v, want %v", "/slow", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,141 @@
+// Package `ru` provides functions and types to calculate the readability for texts in Russian language.
+// 1. Oborneva adaptation of Flesch reading ease score
+// 2. Matskovskiy formula
+package ru
+
+import (
+	"errors"
+	"goreadability/stats"
+	"math"
+	"strings"
+)
+
+// CalcOborneva accepts a non-empty string and returns the Oborneva adaptation of the Flesch reading ease score for Russian texts. The string must contain at least one word (a number is considered a word, for example `18.` is a valid string) and at least one sentence.
+// The formula is 206.835 - 1.3 * (words / sentences) - 60.1 * (syllables / words).
+// The calculated score is rounded to the first decimal point.
+func CalcOborneva(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcObornevaDoc(newDocument(s, opts))
+}
+
+// CalcObornevaRaw accepts a non-empty string and options and returns the Oborneva readability score for it without rounding. See `CalcOborneva`.
+func CalcObornevaRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return obornevaRaw(newDocument(s, opts))
+}
+
+// CalcObornevaDoc accepts a Document and returns the Oborneva readability score for it. See `CalcOborneva`.
+func CalcObornevaDoc(doc *stats.Document) (float64, error) {
+	score, err := obornevaRaw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(score*10) / 10, nil
+}
+
+// obornevaRaw returns the Oborneva readability score without rounding.
+func obornevaRaw(doc *stats.Document) (float64, error) {
+	words := float64(doc.Words())
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate Oborneva readability score.")
+	}
+	sentences := float64(doc.Sentences())
+	if sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate Oborneva readability score.")
+	}
+	syllables := float64(doc.Syllables())
+
+	score := 206.835 - 1.3*(words/sentences) - 60.1*(syllables/words)
+	return score, nil
+}
+
+// CalcMatskovskiy accepts a non-empty string and returns the Matskovskiy readability formula for it, that is the number of years of education required to understand the text. The string must contain at least one word and at least one sentence.
+// The formula is 0.62 * (words / sentences) + 0.123 * (percentage of words with more than 3 syllables) + 0.051.
+// The calculated result is rounded to the first decimal point.
+func CalcMatskovskiy(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcMatskovskiyDoc(newDocument(s, opts))
+}
+
+// CalcMatskovskiyRaw accepts a non-empty string and options and returns the Matskovskiy readability formula for it without rounding. See `CalcMatskovskiy`.
+func CalcMatskovskiyRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return matskovskiyRaw(newDocument(s, opts))
+}
+
+// CalcMatskovskiyDoc accepts a Document and returns the Matskovskiy readability formula for it. See `CalcMatskovskiy`.
+func CalcMatskovskiyDoc(doc *stats.Document) (float64, error) {
+	score, err := matskovskiyRaw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(score*10) / 10, nil
+}
+
+// matskovskiyRaw returns the Matskovskiy readability formula without rounding.
+func matskovskiyRaw(doc *stats.Document) (float64, error) {
+	words := float64(doc.Words())
+	if words == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate Matskovskiy formula.")
+	}
+	sentences := float64(doc.Sentences())
+	if sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate Matskovskiy formula.")
+	}
+	longWords := float64(countLongWords(doc))
+	longWordsPerc := longWords / words * 100
+
+	score := 0.62*(words/sentences) + 0.123*longWordsPerc + 0.051
+	return score, nil
+}
+
+// countLongWords accepts a Document and returns the number of words with more than 3 syllables in it.
+func countLongWords(doc *stats.Document) uint {
+	var longWords uint
+	for _, word := range doc.CountedWords() {
+		if doc.CountSyllables(word) > 3 {
+			longWords++
+		}
+	}
+	return longWords
+}
+
+// CountSyllables accepts a string that represents a Russian word and returns the number of syllables in it.
+// In Russian every vowel forms its own syllable, so the result is the number of Cyrillic vowels in the word.
+// A word without vowels (for example, a number) counts as one syllable.
+func CountSyllables(s string) uint {
+	var syllables uint
+	for _, char := range strings.ToLower(s) {
+		if isVowel(char) {
+			syllables++
+		}
+	}
+
+	if syllables == 0 {
+		syllables++
+	}
+	return syllables
+}
+
+// isVowel reports whether the rune is a Russian vowel.
+func isVowel(char rune) bool {
+	return strings.ContainsRune("аеёиоуыэюя", char)
+}
+
+// init registers `CountSyllables` as the syllable counter of Russian texts (see `stats.RegisterSyllableCounter`).
+func init() {
+	stats.RegisterSyllableCounter("ru", stats.SyllableCounterFunc(CountSyllables))
+}
+
+// newDocument returns a Document for a Russian text, with the given options applied over the language defaults.
+func newDocument(s string, opts []stats.Option) *stats.Document {
+	return stats.NewDocument(s, append([]stats.Option{stats.WithLanguage("ru")}, opts...)...)
+}
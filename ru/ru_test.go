@@ -0,0 +1,75 @@
+package ru_test
+
+import (
+	"goreadability/ru"
+	"testing"
+)
+
+func TestCountSyllables(t *testing.T) {
+	tests := []struct {
+		word string
+		want uint
+	}{
+		{"дом", 1},
+		{"мама", 2},
+		{"мой", 1}, // "й", "ъ", and "ь" are not vowels
+		{"подъезд", 2},
+		{"ёлка", 2},
+		{"ЁЖ", 1},
+		{"изображение", 6}, // every vowel is a syllable, also in a row
+		{"кто-нибудь", 3},
+		{"т.е", 1},
+		{"ТАСС", 1}, // a word without vowels
+		{"2024", 1},
+	}
+	for _, tt := range tests {
+		if got := ru.CountSyllables(tt.word); got != tt.want {
+			t.Errorf("CountSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestCalcOborneva(t *testing.T) {
+	tests := []struct {
+		text string
+		want float64
+	}{
+		// 6 words, 2 sentences, and 13 syllables: 206.835 - 1.3 * 3 - 60.1 * 13 / 6 = 72.7.
+		{"Мама мыла раму. Папа читал газету.", 72.7},
+		// "т.е." is one word of one syllable and doesn't end the sentence: 206.835 - 1.3 * 4 - 60.1 * 7 / 4 = 96.5.
+		{"Это дом, т.е. жилище.", 96.5},
+	}
+	for _, tt := range tests {
+		if got, err := ru.CalcOborneva(tt.text); err != nil || got != tt.want {
+			t.Errorf("CalcOborneva(%q) = %v, %v, want %v", tt.text, got, err, tt.want)
+		}
+	}
+	for _, text := range []string{"", "Привет"} {
+		if _, err := ru.CalcOborneva(text); err == nil {
+			t.Errorf("CalcOborneva(%q) returned no error", text)
+		}
+	}
+}
+
+func TestCalcMatskovskiy(t *testing.T) {
+	tests := []struct {
+		text string
+		want float64
+	}{
+		// No words of more than 3 syllables: 0.62 * 3 + 0.051 = 1.9.
+		{"Мама мыла раму. Папа читал газету.", 1.9},
+		// 3 of 5 words have more than 3 syllables: 0.62 * 5 + 0.123 * 60 + 0.051 = 10.5.
+		{"Современное образование требует значительных усилий.", 10.5},
+		{"Это дом, т.е. жилище.", 2.5},
+	}
+	for _, tt := range tests {
+		if got, err := ru.CalcMatskovskiy(tt.text); err != nil || got != tt.want {
+			t.Errorf("CalcMatskovskiy(%q) = %v, %v, want %v", tt.text, got, err, tt.want)
+		}
+	}
+	for _, text := range []string{"", "Привет"} {
+		if _, err := ru.CalcMatskovskiy(text); err == nil {
+			t.Errorf("CalcMatskovskiy(%q) returned no error", text)
+		}
+	}
+}
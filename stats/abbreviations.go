@@ -0,0 +1,92 @@
+package stats
+
+import "strings"
+
+// languageAbbreviations maps the ISO 639-1 language codes to the default abbreviations of the language.
+var languageAbbreviations = map[string]map[string]int{
+	"en": abbreviations,
+	"it": toAbbreviations(
+		"sig.", "sigg.", "sig.ra", "dott.", "dr.", "prof.", "ing.", "avv.", "arch.", "on.",
+		"ecc.", "pag.", "pagg.", "cap.", "es.", "p.es.", "cfr.", "ca.", "tel.", "vol.", "sec.", "a.c.", "d.c.",
+	),
+	"es": toAbbreviations(
+		"sr.", "sra.", "srta.", "dr.", "dra.", "lic.", "ing.", "prof.", "ud.", "uds.",
+		"etc.", "pág.", "págs.", "p.ej.", "ej.", "aprox.", "av.", "núm.", "tel.", "cap.", "vol.", "a.c.", "d.c.",
+	),
+	"fr": toAbbreviations(
+		"m.", "mm.", "mme.", "mmes.", "mlle.", "mlles.", "dr.", "pr.", "me.",
+		"etc.", "cf.", "p.", "env.", "av.", "bd.", "apr.", "j.-c.", "n.b.", "vol.", "chap.", "éd.",
+	),
+	"de": toAbbreviations(
+		"dr.", "prof.", "hr.", "fr.", "str.", "nr.", "abs.", "s.", "jh.", "jhd.", "mio.", "mrd.",
+		"z.b.", "u.a.", "usw.", "u.s.w.", "bzw.", "d.h.", "ca.", "vgl.", "evtl.", "ggf.", "inkl.", "z.t.", "o.ä.", "bspw.", "v.chr.", "n.chr.",
+	),
+	"nl": toAbbreviations(
+		"dhr.", "mevr.", "mr.", "dr.", "prof.", "ir.", "ing.", "drs.",
+		"bijv.", "bv.", "d.w.z.", "o.a.", "m.a.w.", "enz.", "etc.", "ca.", "nr.", "blz.", "zgn.", "i.p.v.", "t.o.v.", "e.d.",
+	),
+	"ru": toAbbreviations(
+		"проф.", "акад.", "им.", "ул.", "д.", "кв.", "стр.", "рис.", "см.", "тыс.", "млн.", "млрд.", "руб.", "коп.",
+		"т.е.", "т.к.", "т.д.", "т.п.", "т.н.", "и.о.", "напр.", "др.", "пр.", "г.", "гг.", "в.", "вв.",
+	),
+}
+
+// ====== Functions ======
+
+// DefaultAbbreviations accepts an ISO 639-1 language code and returns a copy of the default abbreviations of the language
+// (in lower case, with the points) mapped to the number of points in them, so it can be changed and passed to `WithAbbreviations`.
+// Returns the English abbreviations for the languages without their own.
+func DefaultAbbreviations(language string) map[string]int {
+	defaults, ok := languageAbbreviations[strings.ToLower(language)]
+	if !ok {
+		defaults = abbreviations
+	}
+	copied := make(map[string]int, len(defaults))
+	for abbreviation, points := range defaults {
+		copied[abbreviation] = points
+	}
+	return copied
+}
+
+// WithExtraAbbreviations adds the abbreviations ("approx.", "Inc.", "Fig.") to the abbreviations of the language or to the ones set by `WithAbbreviations`.
+func WithExtraAbbreviations(abbreviations ...string) Option {
+	return func(c *Config) {
+		c.extraAbbreviations = append(c.extraAbbreviations, abbreviations...)
+	}
+}
+
+// WithoutAbbreviations removes the abbreviations from the abbreviations of the language or from the ones set by `WithAbbreviations`.
+func WithoutAbbreviations(abbreviations ...string) Option {
+	return func(c *Config) {
+		c.removedAbbreviations = append(c.removedAbbreviations, abbreviations...)
+	}
+}
+
+// applyAbbreviationChanges returns a copy of the abbreviations with the extra ones added and the removed ones deleted.
+// Returns the abbreviations themselves if there are no changes.
+func applyAbbreviationChanges(abbreviations map[string]int, extra, removed []string) map[string]int {
+	if len(extra) == 0 && len(removed) == 0 {
+		return abbreviations
+	}
+	changed := make(map[string]int, len(abbreviations)+len(extra))
+	for abbreviation, points := range abbreviations {
+		changed[abbreviation] = points
+	}
+	for abbreviation, points := range toAbbreviations(extra...) {
+		changed[abbreviation] = points
+	}
+	for _, abbreviation := range removed {
+		delete(changed, strings.ToLower(abbreviation))
+	}
+	return changed
+}
+
+// toAbbreviations returns the abbreviations in lower case mapped to the number of points in them.
+func toAbbreviations(abbreviations ...string) map[string]int {
+	result := make(map[string]int, len(abbreviations))
+	for _, abbreviation := range abbreviations {
+		abbreviation = strings.ToLower(abbreviation)
+		result[abbreviation] = strings.Count(abbreviation, ".")
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package stats
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ====== Types ======
+
+// academicAbbreviations are the abbreviations of the scholarly texts (see `WithAcademicProfile`): the references to the works and their parts,
+// the figures, the tables, and the equations, and the Latin of the citations.
+var academicAbbreviations = []string{
+	"al.", "ibid.", "id.", "cf.", "viz.", "resp.", "ca.", "op.", "cit.", "loc.", "passim.",
+	"fig.", "figs.", "eq.", "eqs.", "tab.", "tbl.", "sec.", "secs.", "ch.", "chap.", "app.", "appx.", "suppl.", "ref.", "refs.", "n.d.",
+	"vol.", "vols.", "no.", "nos.", "p.", "pp.", "ed.", "eds.", "trans.", "repr.", "rev.", "univ.", "dept.", "inst.",
+	"thm.", "lem.", "prop.", "def.", "cor.", "approx.", "vs.",
+}
+
+// equationOperators are the operators of the lines that are equations ("E = mc^2").
+const equationOperators = "=<>≤≥≈≠∑∫"
+
+// ====== Functions ======
+
+// WithAcademicProfile configures the counting for the scholarly texts: the abbreviations of the references and the citations ("et al.", "ibid.", "cf.", "Fig.", "Eq.", "pp.")
+// are added (see `WithExtraAbbreviations`), the inline references are removed (see `WithoutCitations`), and every equation counts as one word of one syllable (see `ReplaceEquations`).
+func WithAcademicProfile() Option {
+	return func(c *Config) {
+		c.extraAbbreviations = append(c.extraAbbreviations, academicAbbreviations...)
+		c.RemoveCitations = true
+		c.Equations = true
+	}
+}
+
+// ReplaceEquations accepts a string and a replacement and returns the string with its equations replaced with the replacement:
+// the TeX math in dollars ("$x^2$", "$$\sum_i x_i$$") and in brackets ("\(x\)", "\[x\]"), and the lines that are equations, with an operator ("=", "≤", "≈")
+// and mostly without words ("E = mc^2", "f(x) = sin(x) + 1   (3)"). As in Pandoc, the math in dollars doesn't start with a space or a digit
+// and doesn't end with a space, so the prices ("$5 or $10") are not equations.
+func ReplaceEquations(s, replacement string) string {
+	if !strings.ContainsAny(s, "$\\"+equationOperators) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if end := mathEnd(s, i); end > i {
+			b.WriteString(replacement)
+			i = end
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+
+	lines := strings.SplitAfter(b.String(), "\n")
+	for i, line := range lines {
+		if isEquationLine(strings.TrimSpace(line)) {
+			trimmed := strings.TrimRight(line, "\r\n")
+			indent := len(trimmed) - len(strings.TrimLeft(trimmed, " \t"))
+			lines[i] = trimmed[:indent] + replacement + line[len(trimmed):]
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+// mathEnd returns the index after the TeX math that starts at the index, or -1 if there is none.
+func mathEnd(s string, i int) int {
+	for _, delimiters := range [][2]string{{"$$", "$$"}, {"\\[", "\\]"}, {"\\(", "\\)"}} {
+		if strings.HasPrefix(s[i:], delimiters[0]) {
+			if end := strings.Index(s[i+2:], delimiters[1]); end >= 0 {
+				return i + 2 + end + 2
+			}
+			return -1
+		}
+	}
+	if s[i] != '$' || (i > 0 && s[i-1] == '\\') {
+		return -1
+	}
+	end := strings.IndexAny(s[i+1:], "$\n")
+	if end <= 0 || s[i+1+end] != '$' || strings.IndexByte(" \t0123456789", s[i+1]) >= 0 || s[i+end] == ' ' {
+		return -1
+	}
+	return i + 1 + end + 1
+}
+
+// isEquationLine reports whether the trimmed line is an equation: it has an operator of equationOperators and fewer than a third of its fields are words
+// of three or more letters, so "The value x = 5 is chosen." is prose.
+func isEquationLine(line string) bool {
+	if !strings.ContainsAny(line, equationOperators) {
+		return false
+	}
+	fields := strings.Fields(line)
+	words := 0
+	for _, field := range fields {
+		field = strings.TrimRight(field, ".,;:")
+		if len([]rune(field)) >= 3 && strings.IndexFunc(field, func(c rune) bool { return !unicode.IsLetter(c) }) < 0 {
+			words++
+		}
+	}
+	return words*3 < len(fields)
+}
@@ -0,0 +1,298 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// superscriptDigits are the superscript digits of the footnote markers ("word¹").
+const superscriptDigits = "⁰¹²³⁴⁵⁶⁷⁸⁹"
+
+// reporterAbbreviations are the abbreviations of the common US reporters and codes of the legal citations ("410 U.S. 113", "42 U.S.C. § 1983"),
+// longer ones first, so "F. Supp. 2d" is matched before "F.". Only these are citations, so "3 Dogs. 5" and "Fig. 2 Art. 5" are prose.
+var reporterAbbreviations = func() []string {
+	abbreviations := []string{
+		"U.S.", "U.S.C.", "U.S.C.A.", "U.S.C.S.", "S. Ct.", "L. Ed.", "L. Ed. 2d", "C.F.R.", "Fed. Reg.", "Stat.",
+		"F.", "F.2d", "F.3d", "F.4th", "F. Supp.", "F. Supp. 2d", "F. Supp. 3d", "F. App'x", "B.R.", "Fed. Cl.", "T.C.",
+		"A.", "A.2d", "A.3d", "P.", "P.2d", "P.3d", "N.E.", "N.E.2d", "N.E.3d", "N.W.", "N.W.2d", "S.E.", "S.E.2d",
+		"S.W.", "S.W.2d", "S.W.3d", "So.", "So. 2d", "So. 3d", "Cal. Rptr.", "Cal. Rptr. 2d", "Cal. Rptr. 3d", "N.Y.S.2d", "N.Y.S.3d",
+	}
+	sort.Slice(abbreviations, func(i, j int) bool {
+		return len(abbreviations[i]) > len(abbreviations[j])
+	})
+	return abbreviations
+}()
+
+// ====== Functions ======
+
+// WithoutCitations removes the footnote markers and the citations from the text before counting (see `RemoveCitations`),
+// so they don't add words and their points ("U.S.", "et al.") don't end sentences.
+func WithoutCitations() Option {
+	return func(c *Config) {
+		c.RemoveCitations = true
+	}
+}
+
+// RemoveCitations accepts a string and returns it without its footnote markers and citations:
+//   - the footnote markers in brackets ("[1]", "[2, 5]", "[3–7]", "[a]", "[citation needed]") and in superscript digits after a word or punctuation ("word¹");
+//   - the author-year citations in parentheses ("(Smith et al., 2019)", "(Smith & Lee 2019a; Chen, n.d.)", "(see Smith, 2019, p. 12)", "(2019)");
+//   - the legal citations of reporters and codes ("410 U.S. 113, 116 (1973)", "123 F.3d 456 (9th Cir. 1997)", "42 U.S.C. § 1983") and of sections ("§ 1983", "§§ 12–14").
+//
+// The spaces and commas left around a removed citation are tidied up, so "held, 410 U.S. 113 (1973), that" becomes "held, that".
+// A superscript after a word of one or two letters is kept, as it is more likely an exponent than a marker ("m²").
+func RemoveCitations(s string) string {
+	if !strings.ContainsAny(s, "[(§0123456789"+superscriptDigits) {
+		return s
+	}
+	out := make([]byte, 0, len(s))
+	for i := 0; i < len(s); {
+		end := citationEnd(s, i)
+		if end < 0 {
+			out = append(out, s[i])
+			i++
+			continue
+		}
+
+		// The spaces before the citation are dropped, and one is kept if a word follows it.
+		out = []byte(strings.TrimRight(string(out), " \t"))
+		rest := strings.TrimLeft(s[end:], " \t")
+		next, _ := utf8.DecodeRuneInString(rest)
+		switch {
+		case strings.ContainsRune(",.;:", next) && len(out) > 0 && out[len(out)-1] == ',':
+			// "held, [citation], that" keeps one comma.
+			out = out[:len(out)-1]
+		case rest != "" && len(rest) < len(s)-end && len(out) > 0 && out[len(out)-1] != '(' && !strings.ContainsRune(".,;:!?)]}\"'”’\n", next):
+			out = append(out, ' ')
+		}
+		i = len(s) - len(rest)
+	}
+	return string(out)
+}
+
+// citationEnd returns the index after the footnote marker or the citation that starts at the index, or -1 if there is none.
+func citationEnd(s string, i int) int {
+	char, size := utf8.DecodeRuneInString(s[i:])
+	switch {
+	case char == '[':
+		return footnoteMarkerEnd(s, i)
+	case strings.ContainsRune(superscriptDigits, char):
+		if !endsWithWordOrPunctuation(s[:i]) {
+			return -1
+		}
+		end := i + size
+		for end < len(s) {
+			next, nextSize := utf8.DecodeRuneInString(s[end:])
+			if !strings.ContainsRune(superscriptDigits, next) {
+				break
+			}
+			end += nextSize
+		}
+		return end
+	case char == '(':
+		closing := strings.IndexAny(s[i+1:], "()\n")
+		if closing < 0 || closing > 200 || s[i+1+closing] != ')' || !isAuthorYearCitation(s[i+1:i+1+closing]) {
+			return -1
+		}
+		return i + 1 + closing + 1
+	case char == '§':
+		return sectionEnd(s, i)
+	case char >= '0' && char <= '9':
+		if previous, _ := utf8.DecodeLastRuneInString(s[:i]); i > 0 && !isNotLetterOrNumber(previous) {
+			return -1
+		}
+		return reporterCitationEnd(s, i)
+	}
+	return -1
+}
+
+// footnoteMarkerEnd returns the index after the footnote marker in brackets that starts at the index ("[1]", "[1, 2]", "[3–7]", "[a]", "[note 2]"), or -1 if there is none.
+func footnoteMarkerEnd(s string, i int) int {
+	closing := strings.IndexByte(s[i+1:], ']')
+	if closing <= 0 || closing > 40 {
+		return -1
+	}
+	inner := strings.TrimSpace(s[i+1 : i+1+closing])
+	lower := strings.ToLower(inner)
+	switch {
+	case lower == "citation needed", lower == "clarification needed":
+	case len(inner) == 1 && inner[0] >= 'a' && inner[0] <= 'z':
+	case strings.HasPrefix(lower, "note ") && isCitationNumber(inner[len("note "):]):
+	case isCitationNumber(strings.NewReplacer(",", "", "–", "", "-", "", " ", "").Replace(inner)):
+	default:
+		return -1
+	}
+	return i + 1 + closing + 1
+}
+
+// isAuthorYearCitation reports whether the text in parentheses is an author-year citation: citations separated by semicolons,
+// each with a year ("2019", "2019a", "n.d.", "in press") after the authors, which start with a capital letter, or without authors ("(2019)").
+func isAuthorYearCitation(inner string) bool {
+	for _, part := range strings.Split(inner, ";") {
+		part = strings.TrimSpace(part)
+		for _, prefix := range []string{"see also ", "see ", "e.g., ", "e.g. ", "cf. "} {
+			part = strings.TrimPrefix(part, prefix)
+		}
+		year := citationYearIndex(part)
+		if year < 0 {
+			return false
+		}
+		if authors := strings.TrimSpace(part[:year]); authors != "" {
+			first, _ := utf8.DecodeRuneInString(authors)
+			if !unicode.IsUpper(first) || strings.ContainsAny(authors, "0123456789") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// citationYearIndex returns the index of the first year of the citation, or -1 if it has none. The year is "n.d.", "in press",
+// or a separate number from 1500 to 2099, which may have a letter ("2019a").
+func citationYearIndex(part string) int {
+	for _, marker := range []string{"n.d.", "in press"} {
+		if index := strings.Index(part, marker); index >= 0 {
+			return index
+		}
+	}
+	for i := 0; i+4 <= len(part); i++ {
+		year := part[i : i+4]
+		if !isCitationNumber(year) || year < "1500" || year > "2099" {
+			continue
+		}
+		before, _ := utf8.DecodeLastRuneInString(part[:i])
+		after := strings.TrimLeft(part[i+4:], "abcdefghijklmnopqrstuvwxyz")
+		next, _ := utf8.DecodeRuneInString(after)
+		if (i > 0 && !isNotLetterOrNumber(before)) || len(part)-i-4-len(after) > 1 || (after != "" && !isNotLetterOrNumber(next)) {
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+// sectionEnd returns the index after the section citation that starts at the section sign at the index ("§ 1983", "§§ 12–14", "§ 2(a)(1)"), or -1 if there is none.
+// A point after the section ends the sentence, so it is not a part of the citation.
+func sectionEnd(s string, i int) int {
+	j := i
+	for strings.HasPrefix(s[j:], "§") {
+		j += len("§")
+	}
+	j = skipCitationSpaces(s, j)
+	if j == len(s) || s[j] < '0' || s[j] > '9' {
+		return -1
+	}
+	end := j
+	for end < len(s) && (s[end] < utf8.RuneSelf && !isNotLetterOrNumber(rune(s[end])) || strings.IndexByte(".()", s[end]) >= 0) {
+		end++
+	}
+	// A range of sections ("§§ 12–14").
+	for _, dash := range []string{"–", "-"} {
+		if strings.HasPrefix(s[end:], dash) {
+			k := end + len(dash)
+			for k < len(s) && s[k] >= '0' && s[k] <= '9' {
+				k++
+			}
+			if k > end+len(dash) {
+				end = k
+			}
+		}
+	}
+	return len(strings.TrimRight(s[:end], "."))
+}
+
+// reporterCitationEnd returns the index after the legal citation of a reporter or a code that starts with the volume at the index, or -1 if there is none:
+// the volume, the abbreviation of the reporter ("U.S.", "F.3d", "S. Ct.", "U.S.C."), and the page ("410 U.S. 113") or the section ("42 U.S.C. § 1983"),
+// with the pin cites (", 116") and the court and the year in parentheses ("(9th Cir. 1997)").
+func reporterCitationEnd(s string, i int) int {
+	j := i
+	for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+		j++
+	}
+	j = skipCitationSpaces(s, j)
+	reporter := ""
+	for _, abbreviation := range reporterAbbreviations {
+		if strings.HasPrefix(s[j:], abbreviation) {
+			reporter = abbreviation
+			break
+		}
+	}
+	if reporter == "" || j == i {
+		return -1
+	}
+	j += len(reporter)
+
+	start := skipCitationSpaces(s, j)
+	end := start
+	if strings.HasPrefix(s[start:], "§") {
+		if end = sectionEnd(s, start); end < 0 {
+			return -1
+		}
+	} else {
+		for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+			end++
+		}
+		if end == start {
+			return -1
+		}
+	}
+	// The pin cites.
+	for strings.HasPrefix(s[end:], ", ") {
+		pin := end + 2
+		for pin < len(s) && (s[pin] >= '0' && s[pin] <= '9' || s[pin] == '-') {
+			pin++
+		}
+		if pin == end+2 || (pin < len(s) && s[pin] < utf8.RuneSelf && unicode.IsLetter(rune(s[pin]))) {
+			break
+		}
+		end = pin
+	}
+	// The court and the year.
+	if open := skipCitationSpaces(s, end); strings.HasPrefix(s[open:], "(") {
+		if closing := strings.IndexByte(s[open:], ')'); closing > 0 && closing < 60 && citationYearIndex(s[open+1:open+closing]) >= 0 {
+			end = open + closing + 1
+		}
+	}
+	return end
+}
+
+// isCitationNumber reports whether the string is a non-empty sequence of ASCII digits.
+func isCitationNumber(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+// skipCitationSpaces returns the index of the first character after the index that is not a space or a non-breaking space.
+func skipCitationSpaces(s string, i int) int {
+	for i < len(s) {
+		switch {
+		case s[i] == ' ':
+			i++
+		case strings.HasPrefix(s[i:], "\u00a0"):
+			i += len("\u00a0")
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// endsWithWordOrPunctuation reports whether the text ends with a word of three or more letters or with a punctuation mark, so a superscript after it is a footnote marker.
+func endsWithWordOrPunctuation(before string) bool {
+	last, _ := utf8.DecodeLastRuneInString(before)
+	if strings.ContainsRune(".,;:!?)\"'”’", last) {
+		return true
+	}
+	letters := 0
+	for before != "" {
+		char, size := utf8.DecodeLastRuneInString(before)
+		if !unicode.IsLetter(char) {
+			break
+		}
+		letters++
+		before = before[:len(before)-size]
+	}
+	return letters >= 3
+}
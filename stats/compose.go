@@ -0,0 +1,155 @@
+package stats
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ====== Types & Consts ======
+
+// Composition represents how the letters of a text are composed before counting it (see `Compose`).
+type Composition int
+
+const (
+	// ComposeMarks composes the letters and the combining marks following them into precomposed letters ("e\u0301" to "é"), so decomposed accents are counted as the composed ones.
+	ComposeMarks Composition = iota
+	// ComposeMarksAndFold is ComposeMarks that also replaces the common compatibility characters with their plain equivalents: ligatures ("ﬁ" to "fi"), full-width letters and digits ("Ａ" to "A"),
+	// Roman numeral characters ("Ⅻ" to "XII"), ellipsis characters ("…" to "..."), and special spaces. Full-width punctuation is kept, as it ends CJK sentences without a whitespace.
+	ComposeMarksAndFold
+	// NoComposition leaves the text as it is.
+	NoComposition
+)
+
+// compositions maps the combining marks to the letters they compose with, as a string of pairs: a base letter followed by the letter it composes into with the mark.
+// The pairs are the canonical compositions of Latin, Greek, and Cyrillic letters. A letter with several marks is composed pair by pair ("ệ" is "ẹ" and a circumflex).
+var compositions = map[rune]string{
+	0x0300: "AÀEÈIÌOÒUÙaàeèiìoòuùÜǛüǜNǸnǹĒḔēḕŌṐōṑWẀwẁÂẦâầĂẰăằÊỀêềÔỒôồƠỜơờƯỪưừYỲyỳἀἂἁἃἈἊἉἋἐἒἑἓἘἚἙἛἠἢἡἣἨἪἩἫἰἲἱἳἸἺἹἻὀὂὁὃὈὊὉὋὐὒὑὓὙὛὠὢὡὣὨὪὩὫαὰεὲηὴιὶοὸυὺωὼΑᾺΕῈΗῊ᾿῍ϊῒΙῚ῾῝ϋῢΥῪ¨῭ΟῸΩῺЕЀИЍеѐиѝ",                                                               // grave accent
+	0x0301: "AÁEÉIÍOÓUÚYÝaáeéiíoóuúyýCĆcćLĹlĺNŃnńRŔrŕSŚsśZŹzźÜǗüǘGǴgǵÅǺåǻÆǼæǽØǾøǿÇḈçḉĒḖēḗÏḮïḯKḰkḱMḾmḿÕṌõṍŌṒōṓPṔpṕŨṸũṹWẂwẃÂẤâấĂẮăắÊẾêếÔỐôốƠỚơớƯỨưứΑΆΕΈΗΉΙΊΟΌΥΎΩΏϊΐαάεέηήιίϋΰοόυύωώἀἄἁἅἈἌἉἍἐἔἑἕἘἜἙἝἠἤἡἥἨἬἩἭἰἴἱἵἸἼἹἽὀὄὁὅὈὌὉὍὐὔὑὕὙὝὠὤὡὥὨὬὩὭ᾿῎῾῞ГЃКЌгѓкќ", // acute accent
+	0x0302: "AÂEÊIÎOÔUÛaâeêiîoôuûCĈcĉGĜgĝHĤhĥJĴjĵSŜsŝWŴwŵYŶyŷZẐzẑẠẬạậẸỆẹệỌỘọộ",                                                                                                                                                                       // circumflex accent
+	0x0303: "AÃNÑOÕaãnñoõIĨiĩUŨuũVṼvṽÂẪâẫĂẴăẵEẼeẽÊỄêễÔỖôỗƠỠơỡƯỮưữYỸyỹ",                                                                                                                                                                               // tilde
+	0x0304: "AĀaāEĒeēIĪiīOŌoōUŪuūÜǕüǖÄǞäǟȦǠȧǡÆǢæǣǪǬǫǭÖȪöȫÕȬõȭȮȰȯȱYȲyȳGḠgḡḶḸḷḹṚṜṛṝαᾱΑᾹιῑΙῙυῡΥῩИӢиӣУӮуӯ",                                                                                                                                               // macron
+	0x0306: "AĂaăEĔeĕGĞgğIĬiĭOŎoŏUŬuŭȨḜȩḝẠẶạặαᾰΑᾸιῐΙῘυῠΥῨУЎИЙийуўЖӁжӂАӐаӑЕӖеӗ",                                                                                                                                                                       // breve
+	0x0307: "CĊcċEĖeėGĠgġIİZŻzżAȦaȧOȮoȯBḂbḃDḊdḋFḞfḟHḢhḣMṀmṁNṄnṅPṖpṗRṘrṙSṠsṡŚṤśṥŠṦšṧṢṨṣṩTṪtṫWẆwẇXẊxẋYẎyẏſẛ",                                                                                                                                           // dot above
+	0x0308: "AÄEËIÏOÖUÜaäeëiïoöuüyÿYŸHḦhḧÕṎõṏŪṺūṻWẄwẅXẌxẍtẗΙΪΥΫιϊυϋЕЁІЇеёіїАӒаӓӘӚәӛЖӜжӝЗӞзӟИӤиӥОӦоӧӨӪөӫЭӬэӭУӰуӱЧӴчӵЫӸыӹ",                                                                                                                             // diaeresis
+	0x0309: "AẢaảÂẨâẩĂẲăẳEẺeẻÊỂêểIỈiỉOỎoỏÔỔôổƠỞơởUỦuủƯỬưửYỶyỷ",                                                                                                                                                                                       // hook above
+	0x030A: "AÅaåUŮuůwẘyẙ",                                                                                                                                                                                                                           // ring above
+	0x030B: "OŐoőUŰuűУӲуӳ",                                                                                                                                                                                                                           // double acute accent
+	0x030C: "CČcčDĎdďEĚeěLĽlľNŇnňRŘrřSŠsšTŤtťZŽzžAǍaǎIǏiǐOǑoǒUǓuǔÜǙüǚGǦgǧKǨkǩƷǮʒǯjǰHȞhȟ",                                                                                                                                                             // caron
+	0x030F: "AȀaȁEȄeȅIȈiȉOȌoȍRȐrȑUȔuȕѴѶѵѷ",                                                                                                                                                                                                           // double grave accent
+	0x0311: "AȂaȃEȆeȇIȊiȋOȎoȏRȒrȓUȖuȗ",                                                                                                                                                                                                               // inverted breve
+	0x0313: "αἀΑἈεἐΕἘηἠΗἨιἰΙἸοὀΟὈυὐωὠΩὨρῤ",                                                                                                                                                                                                           // comma above
+	0x0314: "αἁΑἉεἑΕἙηἡΗἩιἱΙἹοὁΟὉυὑΥὙωὡΩὩρῥΡῬ",                                                                                                                                                                                                       // reversed comma above
+	0x031B: "OƠoơUƯuư",                                                                                                                                                                                                                               // horn
+	0x0323: "BḄbḅDḌdḍHḤhḥKḲkḳLḶlḷMṂmṃNṆnṇRṚrṛSṢsṣTṬtṭVṾvṿWẈwẉZẒzẓAẠaạEẸeẹIỊiịOỌoọƠỢơợUỤuụƯỰưựYỴyỵ",                                                                                                                                                   // dot below
+	0x0324: "UṲuṳ",                                                                                                                                                                                                                                   // diaeresis below
+	0x0325: "AḀaḁ",                                                                                                                                                                                                                                   // ring below
+	0x0326: "SȘsșTȚtț",                                                                                                                                                                                                                               // comma below
+	0x0327: "CÇcçGĢgģKĶkķLĻlļNŅnņRŖrŗSŞsşTŢtţEȨeȩDḐdḑHḨhḩ",                                                                                                                                                                                           // cedilla
+	0x0328: "AĄaąEĘeęIĮiįUŲuųOǪoǫ",                                                                                                                                                                                                                   // ogonek
+	0x032D: "DḒdḓEḘeḙLḼlḽNṊnṋTṰtṱUṶuṷ",                                                                                                                                                                                                               // circumflex accent below
+	0x032E: "HḪhḫ",                                                                                                                                                                                                                                   // breve below
+	0x0330: "EḚeḛIḬiḭUṴuṵ",                                                                                                                                                                                                                           // tilde below
+	0x0331: "BḆbḇDḎdḏKḴkḵLḺlḻNṈnṉRṞrṟTṮtṯZẔzẕhẖ",                                                                                                                                                                                                     // macron below
+	0x0342: "ἀἆἁἇἈἎἉἏἠἦἡἧἨἮἩἯἰἶἱἷἸἾἹἿὐὖὑὗὙὟὠὦὡὧὨὮὩὯαᾶ¨῁ηῆ᾿῏ιῖϊῗ῾῟υῦϋῧωῶ",                                                                                                                                                                             // greek perispomeni
+	0x0345: "ἀᾀἁᾁἂᾂἃᾃἄᾄἅᾅἆᾆἇᾇἈᾈἉᾉἊᾊἋᾋἌᾌἍᾍἎᾎἏᾏἠᾐἡᾑἢᾒἣᾓἤᾔἥᾕἦᾖἧᾗἨᾘἩᾙἪᾚἫᾛἬᾜἭᾝἮᾞἯᾟὠᾠὡᾡὢᾢὣᾣὤᾤὥᾥὦᾦὧᾧὨᾨὩᾩὪᾪὫᾫὬᾬὭᾭὮᾮὯᾯὰᾲαᾳάᾴᾶᾷΑᾼὴῂηῃήῄῆῇΗῌὼῲωῳώῴῶῷΩῼ",                                                                                                         // greek ypogegrammeni
+}
+
+// compatibilityMappings maps the compatibility characters that ComposeMarksAndFold replaces to their replacements.
+var compatibilityMappings = map[rune]string{
+	'ﬀ': "ff", 'ﬁ': "fi", 'ﬂ': "fl", 'ﬃ': "ffi", 'ﬄ': "ffl", 'ﬅ': "st", 'ﬆ': "st", '０': "0", '１': "1", '２': "2",
+	'３': "3", '４': "4", '５': "5", '６': "6", '７': "7", '８': "8", '９': "9", 'Ａ': "A", 'Ｂ': "B", 'Ｃ': "C",
+	'Ｄ': "D", 'Ｅ': "E", 'Ｆ': "F", 'Ｇ': "G", 'Ｈ': "H", 'Ｉ': "I", 'Ｊ': "J", 'Ｋ': "K", 'Ｌ': "L", 'Ｍ': "M",
+	'Ｎ': "N", 'Ｏ': "O", 'Ｐ': "P", 'Ｑ': "Q", 'Ｒ': "R", 'Ｓ': "S", 'Ｔ': "T", 'Ｕ': "U", 'Ｖ': "V", 'Ｗ': "W",
+	'Ｘ': "X", 'Ｙ': "Y", 'Ｚ': "Z", 'ａ': "a", 'ｂ': "b", 'ｃ': "c", 'ｄ': "d", 'ｅ': "e", 'ｆ': "f", 'ｇ': "g",
+	'ｈ': "h", 'ｉ': "i", 'ｊ': "j", 'ｋ': "k", 'ｌ': "l", 'ｍ': "m", 'ｎ': "n", 'ｏ': "o", 'ｐ': "p", 'ｑ': "q",
+	'ｒ': "r", 'ｓ': "s", 'ｔ': "t", 'ｕ': "u", 'ｖ': "v", 'ｗ': "w", 'ｘ': "x", 'ｙ': "y", 'ｚ': "z", 'Ⅰ': "I",
+	'Ⅱ': "II", 'Ⅲ': "III", 'Ⅳ': "IV", 'Ⅴ': "V", 'Ⅵ': "VI", 'Ⅶ': "VII", 'Ⅷ': "VIII", 'Ⅸ': "IX", 'Ⅹ': "X", 'Ⅺ': "XI",
+	'Ⅻ': "XII", 'Ⅼ': "L", 'Ⅽ': "C", 'Ⅾ': "D", 'Ⅿ': "M", 'ⅰ': "i", 'ⅱ': "ii", 'ⅲ': "iii", 'ⅳ': "iv", 'ⅴ': "v",
+	'ⅵ': "vi", 'ⅶ': "vii", 'ⅷ': "viii", 'ⅸ': "ix", 'ⅹ': "x", 'ⅺ': "xi", 'ⅻ': "xii", 'ⅼ': "l", 'ⅽ': "c", 'ⅾ': "d",
+	'ⅿ': "m", '\u00a0': " ", '․': ".", '‥': "..", '…': "...", '\u2000': " ", '\u2001': " ", '\u2002': " ", '\u2003': " ", '\u2004': " ",
+	'\u2005': " ", '\u2006': " ", '\u2007': " ", '\u2008': " ", '\u2009': " ", '\u200a': " ", '\u202f': " ", '\u205f': " ",
+}
+
+const (
+	hangulBase      = 0xAC00
+	hangulLeadBase  = 0x1100
+	hangulVowelBase = 0x1161
+	hangulTailBase  = 0x11A7
+	hangulVowels    = 21
+	hangulTails     = 28
+	hangulSyllables = 11172
+)
+
+// ====== Functions ======
+
+// WithComposition sets how the letters of the text are composed before counting (ComposeMarks by default), see `Composition`.
+func WithComposition(composition Composition) Option {
+	return func(c *Config) {
+		c.Composition = composition
+	}
+}
+
+// Compose accepts a string and a composition and returns the string with the letters composed.
+// It is not Unicode normalization (NFC or NFKC), which needs the Unicode character database, but the part of it that changes the counts of common texts.
+// Only the canonical compositions of Latin, Greek, Cyrillic, and Hangul letters are made. The combining marks are composed in the order they follow the letter
+// and are not reordered, so "e\u0302\u0323" is "ê\u0323" and not "ệ". Nothing is decomposed first, so the singletons ("\u212b", the Angstrom sign) are kept.
+// Only the compatibility characters in `compatibilityMappings` are replaced, so "①", "㎏", and the superscripts are kept.
+func Compose(text string, composition Composition) string {
+	if composition == NoComposition || strings.IndexFunc(text, func(c rune) bool { return needsComposition(c, composition) }) < 0 {
+		return text
+	}
+	result := make([]rune, 0, utf8.RuneCountInString(text))
+	for _, char := range text {
+		if replacement, ok := compatibilityMappings[char]; ok && composition == ComposeMarksAndFold {
+			result = append(result, []rune(replacement)...)
+			continue
+		}
+		if last := len(result) - 1; last >= 0 {
+			if composed, ok := compose(result[last], char); ok {
+				result[last] = composed
+				continue
+			}
+		}
+		result = append(result, char)
+	}
+	return string(result)
+}
+
+// needsComposition reports whether the rune can be changed by the composition.
+func needsComposition(char rune, composition Composition) bool {
+	if char < 0xA0 {
+		return false
+	}
+	if _, ok := compositions[char]; ok {
+		return true
+	}
+	if _, ok := compatibilityMappings[char]; ok && composition == ComposeMarksAndFold {
+		return true
+	}
+	return char >= hangulVowelBase && char < hangulTailBase+hangulTails
+}
+
+// compose returns the letter the base and the mark compose into, and false if they don't compose.
+func compose(base, mark rune) (rune, bool) {
+	// Hangul jamo compose algorithmically: a leading consonant and a vowel, then a trailing consonant.
+	if lead, vowel := base-hangulLeadBase, mark-hangulVowelBase; lead >= 0 && lead < 19 && vowel >= 0 && vowel < hangulVowels {
+		return hangulBase + (lead*hangulVowels+vowel)*hangulTails, true
+	}
+	if syllable, tail := base-hangulBase, mark-hangulTailBase; syllable >= 0 && syllable < hangulSyllables && syllable%hangulTails == 0 && tail > 0 && tail < hangulTails {
+		return base + tail, true
+	}
+
+	pairs, ok := compositions[mark]
+	if !ok {
+		return 0, false
+	}
+	for len(pairs) > 0 {
+		letter, size := utf8.DecodeRuneInString(pairs)
+		composed, composedSize := utf8.DecodeRuneInString(pairs[size:])
+		if letter == base {
+			return composed, true
+		}
+		pairs = pairs[size+composedSize:]
+	}
+	return 0, false
+}
@@ -0,0 +1,73 @@
+package stats
+
+import "strings"
+
+// ====== Types & Consts ======
+
+// contractions maps the English contractions that cannot be expanded by their suffix alone to their full forms.
+var contractions = map[string][]string{
+	"can't":   {"can", "not"},
+	"won't":   {"will", "not"},
+	"shan't":  {"shall", "not"},
+	"ain't":   {"am", "not"},
+	"let's":   {"let", "us"},
+	"it's":    {"it", "is"},
+	"he's":    {"he", "is"},
+	"she's":   {"she", "is"},
+	"that's":  {"that", "is"},
+	"there's": {"there", "is"},
+	"here's":  {"here", "is"},
+	"what's":  {"what", "is"},
+	"who's":   {"who", "is"},
+	"where's": {"where", "is"},
+	"how's":   {"how", "is"},
+}
+
+// contractionSuffixes maps the suffixes of the English contractions to the words they stand for. "'s" is not expanded by its suffix, as it is also a possessive.
+var contractionSuffixes = []struct {
+	suffix string
+	word   string
+}{
+	{"n't", "not"},
+	{"'ll", "will"},
+	{"'re", "are"},
+	{"'ve", "have"},
+	{"'m", "am"},
+	{"'d", "would"},
+}
+
+// ====== Functions ======
+
+// WithExpandedContractions expands the English contractions before counting words and syllables, so "don't" counts as two words ("do not") and "I'll" as "I will".
+// Possessives ("John's") are not expanded. Only the counts change: `Words` and `Sentences` still return the words and sentences as they are written.
+func WithExpandedContractions() Option {
+	return func(c *Config) {
+		c.ExpandContractions = true
+	}
+}
+
+// expandContraction returns the words a contraction stands for in lower case, or the word itself if it is not a contraction.
+// The leading and trailing punctuation is trimmed off a contraction.
+func expandContraction(word string) []string {
+	trimmed := strings.ToLower(normalizeApostrophes(strings.TrimFunc(word, isNotLetterOrNumber)))
+	if !strings.Contains(trimmed, "'") {
+		return []string{word}
+	}
+	if words, ok := contractions[trimmed]; ok {
+		return words
+	}
+	for _, c := range contractionSuffixes {
+		if stem := strings.TrimSuffix(trimmed, c.suffix); len(stem) < len(trimmed) && len(stem) > 0 && !strings.Contains(stem, "'") {
+			return []string{stem, c.word}
+		}
+	}
+	return []string{word}
+}
+
+// normalizeApostrophes replaces the typographic apostrophes ("’", "ʼ") in the word with the straight one, so "don’t" is counted as "don't".
+func normalizeApostrophes(word string) string {
+	if !strings.ContainsAny(word, "’ʼ") {
+		return word
+	}
+	return strings.NewReplacer("’", "'", "ʼ", "'").Replace(word)
+}
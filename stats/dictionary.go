@@ -0,0 +1,100 @@
+package stats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// ====== Types ======
+
+// PronouncingDictionary represents a pronouncing dictionary that maps words to their numbers of syllables, such as the CMU Pronouncing Dictionary.
+// It is a SyllableCounter (see `WithSyllableCounter`).
+type PronouncingDictionary struct {
+	syllables map[string]uint
+	// Fallback counts syllables of the words missing in the dictionary. If nil, `CountSyllables` is used.
+	Fallback SyllableCounter
+}
+
+// ====== Methods ======
+
+// CountSyllables accepts a word and returns the number of syllables in it according to the dictionary,
+// or counted by the Fallback if the word is missing in the dictionary. The word is matched case-insensitively and without the leading and trailing punctuation.
+func (d *PronouncingDictionary) CountSyllables(word string) uint {
+	if syllables, ok := d.Lookup(word); ok {
+		return syllables
+	}
+	if d.Fallback != nil {
+		return d.Fallback.CountSyllables(word)
+	}
+	return CountSyllables(word)
+}
+
+// Lookup accepts a word and returns the number of syllables in it and true if the word is in the dictionary.
+func (d *PronouncingDictionary) Lookup(word string) (uint, bool) {
+	syllables, ok := d.syllables[dictionaryKey(word)]
+	return syllables, ok
+}
+
+// Len returns the number of words in the dictionary.
+func (d *PronouncingDictionary) Len() int {
+	return len(d.syllables)
+}
+
+// ====== Functions ======
+
+// LoadPronouncingDictionary accepts a reader of a dictionary in the CMU Pronouncing Dictionary format and returns the dictionary.
+// Every line is a word followed by its phonemes, with the vowels marked by a stress digit ("READABILITY  R IY2 D AH0 B IH1 L IH0 T IY0");
+// the number of syllables is the number of vowels. Alternative pronunciations ("READ(2)") are skipped, as the first one is the most common.
+// The lines starting with ";;;" are comments. Returns an error if a line has no phonemes or the reader fails.
+func LoadPronouncingDictionary(r io.Reader) (*PronouncingDictionary, error) {
+	d := &PronouncingDictionary{syllables: map[string]uint{}}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, ";;;") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("Line %d: no phonemes for %q. Cannot load the dictionary.", line, fields[0])
+		}
+		if strings.HasSuffix(fields[0], ")") && strings.Contains(fields[0], "(") {
+			continue
+		}
+
+		var syllables uint
+		for _, phoneme := range fields[1:] {
+			if strings.IndexFunc(phoneme, unicode.IsDigit) >= 0 {
+				syllables++
+			}
+		}
+		key := dictionaryKey(fields[0])
+		if _, ok := d.syllables[key]; !ok {
+			d.syllables[key] = syllables
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// LoadPronouncingDictionaryFile accepts a path to a dictionary file in the CMU Pronouncing Dictionary format and returns the dictionary.
+// See `LoadPronouncingDictionary`.
+func LoadPronouncingDictionaryFile(path string) (*PronouncingDictionary, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadPronouncingDictionary(file)
+}
+
+// dictionaryKey returns the word as it is stored in a dictionary: in lower case, without the leading and trailing punctuation, and with straight apostrophes.
+func dictionaryKey(word string) string {
+	return strings.ToLower(normalizeApostrophes(strings.TrimFunc(word, isNotLetterOrNumber)))
+}
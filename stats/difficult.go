@@ -0,0 +1,95 @@
+package stats
+
+import "unicode"
+
+// ====== Types ======
+
+// DifficultWord represents a word that is polysyllabic or not familiar, with its position in the text.
+type DifficultWord struct {
+	Token
+	Syllables    uint `json:"syllables"`
+	Polysyllabic bool `json:"polysyllabic"`
+	Unfamiliar   bool `json:"unfamiliar"`
+}
+
+// ====== Functions ======
+
+// DifficultWords accepts a string and options and returns its difficult words in the order of their positions.
+// A word is difficult if it has `PolysyllableThreshold` or more syllables, or if it is not familiar according to the configured familiar words (see `WithFamiliarWords`).
+// The words are split by the configured Tokenizer, and the leading and trailing punctuation is trimmed off them. Typographic apostrophes ("don’t") are matched as straight ones.
+// The syllables of numbers are counted with their currency and percent signs, as in `CountAllStats` ("50%" is "fifty percent").
+func DifficultWords(text string, opts ...Option) []DifficultWord {
+	cfg := NewConfig(opts...)
+	var words []DifficultWord
+	for _, token := range tokenize(text, cfg.Tokenizer) {
+		syllables := cfg.countSyllables(numberToken(text, token))
+		word := DifficultWord{
+			Token:        token,
+			Syllables:    syllables,
+			Polysyllabic: syllables >= PolysyllableThreshold,
+			Unfamiliar:   cfg.FamiliarWords != nil && !cfg.FamiliarWords(normalizeApostrophes(token.Text)),
+		}
+		if word.Polysyllabic || word.Unfamiliar {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// CountPolysyllabicWords accepts a string, the minimal number of syllables, and options and returns the number of words with at least that many syllables in it
+// (for example, 3 for SMOG and Gunning Fog). See `PolysyllabicWords`.
+func CountPolysyllabicWords(text string, minSyllables uint, opts ...Option) uint {
+	return uint(len(PolysyllabicWords(text, minSyllables, opts...)))
+}
+
+// PolysyllabicWords accepts a string, the minimal number of syllables, and options and returns the words with at least that many syllables in the order of their positions.
+// The words are split by the configured Tokenizer, and the leading and trailing punctuation is trimmed off them.
+func PolysyllabicWords(text string, minSyllables uint, opts ...Option) []Token {
+	return wordsBySyllables(text, NewConfig(opts...), func(syllables uint) bool { return syllables >= minSyllables })
+}
+
+// CountMonosyllabicWords accepts a string and options and returns the number of words with one syllable in it (for example, for FORCAST). See `MonosyllabicWords`.
+func CountMonosyllabicWords(text string, opts ...Option) uint {
+	return uint(len(MonosyllabicWords(text, opts...)))
+}
+
+// MonosyllabicWords accepts a string and options and returns the words with one syllable in the order of their positions.
+// The words are split by the configured Tokenizer, and the leading and trailing punctuation is trimmed off them.
+func MonosyllabicWords(text string, opts ...Option) []Token {
+	return wordsBySyllables(text, NewConfig(opts...), func(syllables uint) bool { return syllables == 1 })
+}
+
+// wordsBySyllables returns the words of the text whose numbers of syllables satisfy the function.
+func wordsBySyllables(text string, cfg Config, match func(syllables uint) bool) []Token {
+	var words []Token
+	for _, token := range tokenize(text, cfg.Tokenizer) {
+		if match(cfg.countSyllables(token.Text)) {
+			words = append(words, token)
+		}
+	}
+	return words
+}
+
+// CountLongWords accepts a string, the minimal number of letters, and options and returns the number of words with at least that many letters in it
+// (for example, 7 for LIX and RIX, which count the words of more than six letters). See `LongWords`.
+func CountLongWords(text string, minLetters uint, opts ...Option) uint {
+	return uint(len(LongWords(text, minLetters, opts...)))
+}
+
+// LongWords accepts a string, the minimal number of letters, and options and returns the words with at least that many letters in the order of their positions.
+// Only letters are counted, so punctuation, digits, hyphens, and apostrophes do not make a word longer ("don't" has four letters).
+func LongWords(text string, minLetters uint, opts ...Option) []Token {
+	var words []Token
+	for _, token := range tokenize(text, NewConfig(opts...).Tokenizer) {
+		var letters uint
+		for _, char := range token.Text {
+			if unicode.IsLetter(char) {
+				letters++
+			}
+		}
+		if letters >= minLetters {
+			words = append(words, token)
+		}
+	}
+	return words
+}
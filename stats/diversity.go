@@ -0,0 +1,143 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+// ====== Types & Consts ======
+
+// MATTR_WINDOW is the default number of words in the window of the moving-average type-token ratio.
+const MATTR_WINDOW = 50
+
+// LexicalDiversity represents the lexical diversity statistics of a text: the number of words (tokens), of unique words (types),
+// their ratio (TTR), and the moving-average type-token ratio (MATTR).
+type LexicalDiversity struct {
+	Words       uint    `json:"words"`
+	UniqueWords uint    `json:"unique_words"`
+	TTR         float64 `json:"ttr"`
+	MATTR       float64 `json:"mattr"`
+}
+
+// ====== Functions ======
+
+// WithLemmatizer sets the function that returns the lemma (or the stem) of a word in lower case, so the forms of a word ("cat", "cats") count as one unique word.
+func WithLemmatizer(lemmatizer func(word string) string) Option {
+	return func(c *Config) {
+		c.Lemmatizer = lemmatizer
+	}
+}
+
+// CountUniqueWords accepts a string and options and returns the number of unique words in it. The words are compared case-insensitively,
+// with typographic apostrophes made straight and, if a lemmatizer is configured (see `WithLemmatizer`), by their lemmas.
+// The stop words are not counted if they are configured (see `WithStopWords`), and neither are they in the other lexical diversity statistics.
+func CountUniqueWords(s string, opts ...Option) uint {
+	return uint(len(uniqueWords(wordKeys(s, NewConfig(opts...)))))
+}
+
+// CalcTTR accepts a non-empty string and options and returns its type-token ratio: the number of unique words divided by the number of words (see `CountUniqueWords`).
+// The ratio depends on the text length, as longer texts repeat more words, so use `CalcMATTR` to compare texts of different lengths.
+// The calculated ratio is rounded to the second decimal point.
+func CalcTTR(s string, opts ...Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	keys := wordKeys(s, NewConfig(opts...))
+	if len(keys) == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate type-token ratio.")
+	}
+	return math.Round(typeTokenRatio(keys)*100) / 100, nil
+}
+
+// CalcMATTR accepts a non-empty string, the window size, and options and returns its moving-average type-token ratio:
+// the mean of the type-token ratios of all windows of `window` consecutive words (`MATTR_WINDOW` if the window is 0).
+// Unlike TTR, it doesn't depend on the text length. A text shorter than the window has one window of all its words.
+// The calculated ratio is rounded to the second decimal point.
+func CalcMATTR(s string, window uint, opts ...Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	keys := wordKeys(s, NewConfig(opts...))
+	if len(keys) == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate moving-average type-token ratio.")
+	}
+	return math.Round(movingAverageTTR(keys, window)*100) / 100, nil
+}
+
+// CalcLexicalDiversity accepts a non-empty string, the window size of MATTR, and options and returns all lexical diversity statistics of it at once.
+// See `CountUniqueWords`, `CalcTTR`, and `CalcMATTR`.
+func CalcLexicalDiversity(s string, window uint, opts ...Option) (LexicalDiversity, error) {
+	if len(s) == 0 {
+		return LexicalDiversity{}, errors.New("Empty string.")
+	}
+	keys := wordKeys(s, NewConfig(opts...))
+	if len(keys) == 0 {
+		return LexicalDiversity{}, errors.New("No words were parsed. Cannot calculate lexical diversity.")
+	}
+	return LexicalDiversity{
+		Words:       uint(len(keys)),
+		UniqueWords: uint(len(uniqueWords(keys))),
+		TTR:         math.Round(typeTokenRatio(keys)*100) / 100,
+		MATTR:       math.Round(movingAverageTTR(keys, window)*100) / 100,
+	}, nil
+}
+
+// wordKeys returns the words of the text as they are compared: in lower case, with straight apostrophes, and lemmatized if a lemmatizer is configured.
+// The stop words are skipped if they are configured.
+func wordKeys(text string, cfg Config) []string {
+	tokens := tokenize(cfg.prepare(text), cfg.Tokenizer)
+	keys := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		key := strings.ToLower(normalizeApostrophes(token.Text))
+		if cfg.StopWords != nil && cfg.StopWords(key) {
+			continue
+		}
+		if cfg.Lemmatizer != nil {
+			key = cfg.Lemmatizer(key)
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// uniqueWords returns the set of the words.
+func uniqueWords(keys []string) map[string]bool {
+	unique := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		unique[key] = true
+	}
+	return unique
+}
+
+// typeTokenRatio returns the number of unique words divided by the number of words.
+func typeTokenRatio(keys []string) float64 {
+	return float64(len(uniqueWords(keys))) / float64(len(keys))
+}
+
+// movingAverageTTR returns the mean type-token ratio of the windows of consecutive words, moving the window one word at a time.
+func movingAverageTTR(keys []string, window uint) float64 {
+	size := int(window)
+	if size == 0 {
+		size = MATTR_WINDOW
+	}
+	if len(keys) <= size {
+		return typeTokenRatio(keys)
+	}
+
+	counts := map[string]int{}
+	for _, key := range keys[:size] {
+		counts[key]++
+	}
+	sum := float64(len(counts))
+	for i := size; i < len(keys); i++ {
+		out := keys[i-size]
+		if counts[out]--; counts[out] == 0 {
+			delete(counts, out)
+		}
+		counts[keys[i]]++
+		sum += float64(len(counts))
+	}
+	windows := len(keys) - size + 1
+	return sum / float64(windows) / float64(size)
+}
@@ -0,0 +1,108 @@
+package stats
+
+// Document represents a text with its statistics. The statistics are counted once, on the first request, and then cached.
+// A Document is not safe for concurrent use before its statistics are counted.
+type Document struct {
+	text    string
+	config  Config
+	stats   TotalStats
+	counted bool
+}
+
+// NewDocument accepts a string and options and returns a Document for it. The options are applied to all the counts of the document.
+func NewDocument(text string, opts ...Option) *Document {
+	cfg := NewConfig(opts...)
+	return &Document{text: cfg.prepare(text), config: cfg}
+}
+
+// Text returns the text of the document as it is counted: composed by the configured Composition (see `WithComposition`) and rewritten by the configured options
+// (see `WithPlaceholders`, `WithoutCitations`, `WithLegalProfile`, `WithAcademicProfile`).
+func (d *Document) Text() string {
+	return d.text
+}
+
+// Config returns the settings the document is counted with.
+func (d *Document) Config() Config {
+	return d.config
+}
+
+// CountSyllables accepts a word and returns the number of syllables in it, counted with the SyllableCounter of the document,
+// or with the counter of its language if it has none (see `RegisterSyllableCounter`).
+func (d *Document) CountSyllables(word string) uint {
+	return d.config.countSyllables(word)
+}
+
+// Stats returns all statistics of the document. See `CountAllStats`.
+func (d *Document) Stats() TotalStats {
+	if !d.counted {
+		d.stats = countPreparedStats(d.text, d.config)
+		d.counted = true
+	}
+	return d.stats
+}
+
+// Symbols returns the number of symbols in the document. See `CountSymbols`.
+func (d *Document) Symbols() uint {
+	return d.Stats().Symbols
+}
+
+// Characters returns the number of characters in the document. See `CountCharacters`.
+func (d *Document) Characters() uint {
+	return d.Stats().Characters
+}
+
+// Letters returns the number of letters in the document. See `CountLetters`.
+func (d *Document) Letters() uint {
+	return d.Stats().Letters
+}
+
+// Words returns the number of words in the document. See `CountWords`.
+func (d *Document) Words() uint {
+	return d.Stats().Words
+}
+
+// Sentences returns the number of sentences in the document. See `CountSentences`.
+func (d *Document) Sentences() uint {
+	return d.Stats().Sentences
+}
+
+// Syllables returns the number of syllables in the document. See `CountAllStats`.
+func (d *Document) Syllables() uint {
+	return d.Stats().Syllables
+}
+
+// CountedWords returns the words of the document as they are counted by `Words` and `Syllables`: split by the Tokenizer, expanded by the configured options
+// (see `WithSpokenNumbers`, `WithExpandedContractions`), and with the leading and trailing punctuation trimmed off them ("т.е." is "т.е", "l'homme," is "l'homme").
+// The words without letters or numbers (a dash between spaces) are counted by `Words`, but are not returned.
+// The syllables of the words can be counted with `CountSyllables`, so a formula that counts its own kinds of words agrees with the word and syllable counts.
+func (d *Document) CountedWords() []string {
+	var words []string
+	for _, token := range d.config.Tokenizer(d.text) {
+		for _, word := range d.config.countedWords(token) {
+			if len(word) > 0 {
+				words = append(words, word)
+			}
+		}
+	}
+	return words
+}
+
+// Polysyllables returns the number of words with `PolysyllableThreshold` or more syllables in the document.
+func (d *Document) Polysyllables() uint {
+	return d.Stats().Polysyllables
+}
+
+// AvgWordsPerSentence returns the average number of words per sentence in the document. See `TotalStats.AvgWordsPerSentence`.
+func (d *Document) AvgWordsPerSentence() float64 {
+	return d.Stats().AvgWordsPerSentence()
+}
+
+// AvgSyllablesPerWord returns the average number of syllables per word in the document. See `TotalStats.AvgSyllablesPerWord`.
+func (d *Document) AvgSyllablesPerWord() float64 {
+	return d.Stats().AvgSyllablesPerWord()
+}
+
+// AvgCharsPerWord returns the average number of characters per word in the document. See `TotalStats.AvgCharsPerWord`.
+func (d *Document) AvgCharsPerWord() float64 {
+	return d.Stats().AvgCharsPerWord()
+}
@@ -0,0 +1,55 @@
+package stats
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// speechVerbs are the English verbs of the dialogue attributions ("Tom said", "asked Ann"), in lower case.
+var speechVerbs = toStopWords(`
+	said says asked asks replied replies answered answers added continued repeated explained agreed
+	shouted yelled cried called screamed exclaimed roared bellowed snapped growled hissed gasped
+	whispered muttered murmured mumbled breathed sighed sobbed groaned laughed stammered
+	insisted begged pleaded demanded protested admitted warned suggested declared announced remarked observed interrupted wondered
+`)
+
+// ====== Functions ======
+
+// WithFictionProfile configures the counting for the fiction: a quoted sentence followed by its attribution doesn't end the sentence it is in,
+// even if the attribution starts with a name or a pronoun ("'Stop!' Tom said.", "\"Why?\" asked Ann.", "'Run!' She cried."), as the quoted sentences followed by
+// a lower case word already don't (see `Sentences`). The attribution is a speech verb ("said", "asked", "whispered") as the first or the second word.
+func WithFictionProfile() Option {
+	return func(c *Config) {
+		c.Dialogue = true
+	}
+}
+
+// startsWithAttribution reports whether the text after a quoted sentence starts with its attribution: a speech verb ("asked Ann")
+// or a capitalized word followed by a speech verb ("Tom said", "She whispered").
+func startsWithAttribution(s string) bool {
+	// Only the first two words are scanned, as the rest of the text can be long.
+	first, rest := cutField(s)
+	if first == "" {
+		return false
+	}
+	if speechVerbs[strings.ToLower(strings.TrimRightFunc(first, isNotLetterOrNumber))] {
+		return true
+	}
+	second, _ := cutField(rest)
+	char, _ := utf8.DecodeRuneInString(first)
+	return second != "" && unicode.IsUpper(char) && !strings.ContainsAny(first, ".!?\"“”") &&
+		speechVerbs[strings.ToLower(strings.TrimRightFunc(second, isNotLetterOrNumber))]
+}
+
+// cutField returns the first field of the string, separated by white space as in `strings.Fields`, and the text after it.
+func cutField(s string) (field, rest string) {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	end := strings.IndexFunc(s, unicode.IsSpace)
+	if end < 0 {
+		return s, ""
+	}
+	return s[:end], s[end:]
+}
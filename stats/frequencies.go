@@ -0,0 +1,53 @@
+package stats
+
+import "sort"
+
+// ====== Types ======
+
+// WordFrequency represents a word and the number of its occurrences in a text.
+type WordFrequency struct {
+	Word  string `json:"word"`
+	Count uint   `json:"count"`
+}
+
+// ====== Functions ======
+
+// WithStopWords sets the function that reports whether a word (in lower case) is a stop word ("the", "and", "of"), so it is excluded from the word frequencies
+// and the unique words. The stop words of the supported languages are shipped (see `DefaultStopWords`, `WithDefaultStopWords`).
+func WithStopWords(isStopWord func(word string) bool) Option {
+	return func(c *Config) {
+		c.StopWords = isStopWord
+	}
+}
+
+// WordFrequencies accepts a string and options and returns its words mapped to the numbers of their occurrences.
+// The words are case-folded (in lower case) with straight apostrophes and lemmatized if a lemmatizer is configured (see `WithLemmatizer`).
+// The stop words are excluded if they are configured (see `WithStopWords`).
+func WordFrequencies(text string, opts ...Option) map[string]uint {
+	cfg := NewConfig(opts...)
+	frequencies := map[string]uint{}
+	for _, key := range wordKeys(text, cfg) {
+		frequencies[key]++
+	}
+	return frequencies
+}
+
+// TopWords accepts a string, the number of words, and options and returns the n most frequent words of the string (all of them if n is 0),
+// sorted by the number of occurrences in descending order, and alphabetically if the numbers are equal. See `WordFrequencies`.
+func TopWords(text string, n uint, opts ...Option) []WordFrequency {
+	frequencies := WordFrequencies(text, opts...)
+	top := make([]WordFrequency, 0, len(frequencies))
+	for word, count := range frequencies {
+		top = append(top, WordFrequency{word, count})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Word < top[j].Word
+	})
+	if n > 0 && int(n) < len(top) {
+		top = top[:n]
+	}
+	return top
+}
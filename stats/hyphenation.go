@@ -0,0 +1,160 @@
+package stats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// ====== Types ======
+
+// Hyphenator represents a hyphenation engine based on Knuth–Liang patterns, as used by TeX. The patterns are available for many languages
+// (for example, in the hyph-utf8 package), so as a SyllableCounter it can estimate syllables of the languages without a pronouncing dictionary.
+type Hyphenator struct {
+	// patterns maps the letters of the patterns to their inter-letter values.
+	patterns   map[string][]int
+	maxPattern int
+	// exceptions maps the words to their parts.
+	exceptions map[string][]string
+	// LeftMin and RightMin are the minimal numbers of letters before the first and after the last hyphen. Both default to 1,
+	// as every syllable counts for syllable estimation (TeX uses 2 and 3 for English typesetting).
+	LeftMin  int
+	RightMin int
+}
+
+// ====== Methods ======
+
+// Hyphenate accepts a word and returns its parts between the hyphenation points, in lower case. The exceptions are used as they are.
+// A word without hyphenation points is returned as one part.
+func (h *Hyphenator) Hyphenate(word string) []string {
+	letters := []rune(strings.ToLower(word))
+	if parts, ok := h.exceptions[string(letters)]; ok {
+		return parts
+	}
+
+	// The word is matched with the points marking its start and its end: ".word.".
+	padded := append(append([]rune{'.'}, letters...), '.')
+	values := make([]int, len(padded)+1)
+	for i := range padded {
+		for j := i + 1; j <= len(padded) && j-i <= h.maxPattern; j++ {
+			pattern, ok := h.patterns[string(padded[i:j])]
+			if !ok {
+				continue
+			}
+			for k, value := range pattern {
+				values[i+k] = max(values[i+k], value)
+			}
+		}
+	}
+
+	var parts []string
+	start := 0
+	for i := max(h.LeftMin, 1); i <= len(letters)-max(h.RightMin, 1); i++ {
+		// An odd value between the letters is a hyphenation point. values[i+1] is the value before letters[i].
+		if values[i+1]%2 == 1 {
+			parts = append(parts, string(letters[start:i]))
+			start = i
+		}
+	}
+	return append(parts, string(letters[start:]))
+}
+
+// CountSyllables accepts a word and returns the number of its parts between the hyphenation points as the number of syllables.
+// The leading and trailing punctuation is trimmed off the word. A word without letters counts as one syllable, and an empty string as zero.
+func (h *Hyphenator) CountSyllables(word string) uint {
+	if len(word) == 0 {
+		return 0
+	}
+	trimmed := strings.TrimFunc(word, isNotLetterOrNumber)
+	if strings.IndexFunc(trimmed, unicode.IsLetter) < 0 {
+		return 1
+	}
+	return uint(len(h.Hyphenate(trimmed)))
+}
+
+// ====== Functions ======
+
+// NewHyphenator accepts Knuth–Liang patterns ("hy3ph", ".ach4") and hyphenation exceptions ("ta-ble") and returns a Hyphenator.
+// Returns an error if a pattern has no letters.
+func NewHyphenator(patterns []string, exceptions []string) (*Hyphenator, error) {
+	h := &Hyphenator{patterns: map[string][]int{}, exceptions: map[string][]string{}, LeftMin: 1, RightMin: 1}
+	for _, pattern := range patterns {
+		if err := h.addPattern(pattern); err != nil {
+			return nil, err
+		}
+	}
+	for _, exception := range exceptions {
+		h.exceptions[strings.ToLower(strings.ReplaceAll(exception, "-", ""))] = strings.Split(strings.ToLower(exception), "-")
+	}
+	return h, nil
+}
+
+// LoadHyphenationPatterns accepts a reader of a TeX hyphenation file and returns a Hyphenator with its patterns.
+// Both the plain pattern files (patterns separated by whitespaces) and the TeX files with `\patterns{...}` and `\hyphenation{...}` are supported.
+// The text after "%" on a line is a comment. Returns an error if a pattern is invalid or the reader fails.
+func LoadHyphenationPatterns(r io.Reader) (*Hyphenator, error) {
+	var patterns, exceptions []string
+	inExceptions := false
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if comment := strings.IndexByte(line, '%'); comment >= 0 {
+			line = line[:comment]
+		}
+		for _, token := range strings.Fields(line) {
+			switch {
+			case strings.HasPrefix(token, `\patterns{`):
+				inExceptions = false
+				token = strings.TrimPrefix(token, `\patterns{`)
+			case strings.HasPrefix(token, `\hyphenation{`):
+				inExceptions = true
+				token = strings.TrimPrefix(token, `\hyphenation{`)
+			}
+			token = strings.TrimSuffix(token, "}")
+			switch {
+			case token == "":
+			case inExceptions:
+				exceptions = append(exceptions, token)
+			default:
+				patterns = append(patterns, token)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewHyphenator(patterns, exceptions)
+}
+
+// LoadHyphenationPatternsFile accepts a path to a TeX hyphenation file and returns a Hyphenator with its patterns. See `LoadHyphenationPatterns`.
+func LoadHyphenationPatternsFile(path string) (*Hyphenator, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadHyphenationPatterns(file)
+}
+
+// addPattern splits the pattern into its letters and inter-letter values and adds it to the patterns.
+func (h *Hyphenator) addPattern(pattern string) error {
+	var letters []rune
+	values := []int{0}
+	for _, char := range pattern {
+		if char >= '0' && char <= '9' {
+			values[len(values)-1] = int(char - '0')
+			continue
+		}
+		letters = append(letters, unicode.ToLower(char))
+		values = append(values, 0)
+	}
+	if len(letters) == 0 {
+		return fmt.Errorf("Pattern %q has no letters. Cannot load the patterns.", pattern)
+	}
+	h.patterns[string(letters)] = values
+	h.maxPattern = max(h.maxPattern, len(letters))
+	return nil
+}
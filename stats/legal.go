@@ -0,0 +1,214 @@
+package stats
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// legalAbbreviations are the abbreviations of the legal texts (see `WithLegalProfile`): case names and numbers, the parts of acts and contracts,
+// reporters, codes, and courts, the companies, and the US states whose abbreviations are not common words ("Ill.", "Mass.", and "Wash." are left out).
+var legalAbbreviations = []string{
+	"v.", "vs.", "no.", "nos.", "al.", "seq.", "id.", "ibid.", "cf.", "esq.", "hon.",
+	"art.", "arts.", "sec.", "secs.", "ss.", "subsec.", "para.", "paras.", "cl.", "ch.", "pt.", "sch.", "reg.", "regs.", "amend.", "const.", "ex.", "exh.",
+	"u.s.c.", "c.f.r.", "u.c.c.", "stat.", "pub.", "l.", "cong.", "sess.", "ann.", "supp.", "f.", "s.", "ct.", "cir.", "app.", "dist.", "div.", "super.", "cert.", "op.",
+	"n.d.", "s.d.", "e.d.", "w.d.", "m.d.", "d.c.",
+	"corp.", "inc.", "ltd.", "co.", "bros.", "assn.", "dept.",
+	"ala.", "ariz.", "ark.", "cal.", "colo.", "conn.", "fla.", "ga.", "kan.", "ky.", "md.", "mich.", "minn.", "mont.", "neb.", "nev.",
+	"n.c.", "n.j.", "n.y.", "okla.", "or.", "pa.", "s.c.", "tenn.", "tex.", "va.", "vt.", "wis.", "wyo.",
+}
+
+// clauseKeywords are the words that start the labels of the clauses ("Section 4.", "Article V:").
+var clauseKeywords = []string{"section", "sec.", "article", "art.", "clause", "§"}
+
+// Clause represents a numbered clause of a legal text, with its label ("1.", "2.3", "(a)", "iv)", "Section 4.") and its byte offsets in the text.
+// Text is `text[Start:End]`, without the label. The text before the first label is a clause without a label.
+type Clause struct {
+	Label string `json:"label,omitempty"`
+	Text  string `json:"text"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+// ====== Functions ======
+
+// WithLegalProfile configures the counting for the legal texts: the abbreviations of the case names, the reporters, and the codes ("v.", "No.", "Cal.", "U.S.C.")
+// are added (see `WithExtraAbbreviations`), a section or a paragraph sign is one word with its number ("§ 1983", "§§ 12–14", "¶ 4"),
+// and the labels of the numbered clauses ("1.", "2.3", "(a)", "Section 4.") are dropped, so they are neither words nor sentences.
+func WithLegalProfile() Option {
+	return func(c *Config) {
+		c.extraAbbreviations = append(c.extraAbbreviations, legalAbbreviations...)
+		c.Legal = true
+	}
+}
+
+// Clauses accepts a string and returns its numbered clauses. A clause starts with a label at the start of a line:
+// a number with a point or a bracket ("1.", "2)"), a multilevel number ("2.3", "2.3.1."), a letter, a Roman, or an Arabic numeral in brackets ("(a)", "(iv)", "(12)", "b)"),
+// or a keyword with a number ("Section 4.", "Article V:", "§ 12."). A clause ends where the next one starts, so the nested clauses are separate.
+// The whitespaces around the texts are not included in them, and the clauses without text (a label on a line of its own) are skipped.
+func Clauses(text string) []Clause {
+	var clauses []Clause
+	label, start := "", 0
+	flush := func(end int) {
+		body := text[start:end]
+		trimmedStart := start + len(body) - len(strings.TrimLeftFunc(body, unicode.IsSpace))
+		trimmedEnd := start + len(strings.TrimRightFunc(body, unicode.IsSpace))
+		if trimmedStart < trimmedEnd {
+			clauses = append(clauses, Clause{label, text[trimmedStart:trimmedEnd], trimmedStart, trimmedEnd})
+		}
+	}
+	offset := 0
+	for _, line := range strings.SplitAfter(text, "\n") {
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if end := clauseLabelEnd(line[indent:]); end > 0 {
+			flush(offset)
+			label = strings.TrimSpace(line[indent : indent+end])
+			start = offset + indent + end
+		}
+		offset += len(line)
+	}
+	flush(len(text))
+	return clauses
+}
+
+// rewriteLegal returns the text as it is counted with `WithLegalProfile`: without the labels of the clauses at the starts of the lines
+// and the enumerators in brackets inside them ("shall: (a) pay; and (b) deliver"), and with the section and the paragraph signs joined to their numbers.
+// An enumerator at the end of a line is a reference ("Art. 5 (1)") and is kept. The rewrite is a fixed point: the rewritten text is rewritten to itself.
+func rewriteLegal(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, line := range strings.SplitAfter(s, "\n") {
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		b.WriteString(line[:indent])
+		line = line[indent:]
+		// The nested labels on one line ("1. (a) The Seller") are all dropped.
+		for end := clauseLabelEnd(line); end > 0; end = clauseLabelEnd(line) {
+			line = line[end:]
+		}
+		for i := 0; i < len(line); {
+			switch {
+			case strings.HasPrefix(line[i:], "§") || strings.HasPrefix(line[i:], "¶"):
+				sign := line[i : i+len("§")]
+				for strings.HasPrefix(line[i:], sign) {
+					b.WriteString(sign)
+					i += len(sign)
+				}
+				if next := skipCitationSpaces(line, i); next < len(line) && line[next] >= '0' && line[next] <= '9' {
+					i = next
+				}
+			case line[i] == '(' && (i == 0 || line[i-1] == ' ') && isEnumerator(line[i:], false) && !isLineEnd(line[i+strings.IndexByte(line[i:], ')')+1:]):
+				i = skipCitationSpaces(line, i+strings.IndexByte(line[i:], ')')+1)
+			default:
+				b.WriteByte(line[i])
+				i++
+			}
+		}
+	}
+	return b.String()
+}
+
+// clauseLabelEnd returns the index after the label of a clause and the spaces after it at the start of the line, or 0 if the line doesn't start with a label.
+func clauseLabelEnd(line string) int {
+	end := 0
+	switch {
+	case line == "":
+		return 0
+	case line[0] == '(' && isEnumerator(line, true):
+		end = strings.IndexByte(line, ')') + 1
+	case line[0] >= '0' && line[0] <= '9':
+		end = strings.IndexFunc(line, func(c rune) bool {
+			return (c < '0' || c > '9') && c != '.'
+		})
+		if end < 0 {
+			end = len(line)
+		}
+		number := line[:end]
+		switch {
+		case end < len(line) && line[end] == ')' && !strings.Contains(number, "."):
+			end++
+		case strings.HasSuffix(number, "."):
+		case strings.Contains(number, "."):
+			// A multilevel number without the last point ("2.3 The") is told from a decimal ("3.5 million") by the capital letter after it.
+			if next, _ := utf8.DecodeRuneInString(strings.TrimLeft(line[end:], " \t")); !unicode.IsUpper(next) {
+				return 0
+			}
+		default:
+			return 0
+		}
+	default:
+		if closing := strings.IndexByte(line, ')'); closing > 0 && closing <= 4 && isRomanOrLetter(line[:closing]) {
+			end = closing + 1
+			break
+		}
+		end = keywordLabelEnd(line)
+	}
+	if end == 0 || (end < len(line) && !unicode.IsSpace(rune(line[end]))) {
+		return 0
+	}
+	return len(line) - len(strings.TrimLeft(line[end:], " \t"))
+}
+
+// keywordLabelEnd returns the index after the label of a clause that starts with a keyword ("Section 4.", "Article V:", "ARTICLE 5"), or 0 if there is none.
+// The number must be followed by a point, a colon, a dash, or the end of the line, so "Section 4 applies" is not a label.
+func keywordLabelEnd(line string) int {
+	lower := strings.ToLower(line)
+	for _, keyword := range clauseKeywords {
+		if !strings.HasPrefix(lower, keyword) {
+			continue
+		}
+		start := skipCitationSpaces(line, len(keyword))
+		if start == len(keyword) && keyword != "§" {
+			return 0
+		}
+		end := start
+		for end < len(line) && (line[end] >= '0' && line[end] <= '9' || line[end] == '.' && end+1 < len(line) && line[end+1] >= '0' && line[end+1] <= '9' || strings.IndexByte("IVXLC", line[end]) >= 0) {
+			end++
+		}
+		if end == start {
+			return 0
+		}
+		rest := strings.TrimRight(line[end:], " \t\r\n")
+		switch {
+		case rest == "":
+			return end
+		case rest[0] == '.' || rest[0] == ':':
+			return end + 1
+		case strings.HasPrefix(strings.TrimLeft(rest, " \t"), "-"), strings.HasPrefix(strings.TrimLeft(rest, " \t"), "–"), strings.HasPrefix(strings.TrimLeft(rest, " \t"), "—"):
+			return end
+		}
+		return 0
+	}
+	return 0
+}
+
+// isLineEnd reports whether the rest of the line is blank.
+func isLineEnd(rest string) bool {
+	return strings.TrimSpace(rest) == ""
+}
+
+// isEnumerator reports whether the string starts with an enumerator in brackets: a letter, a Roman numeral of up to four letters, or a number of up to two digits ("(a)", "(iv)", "(12)").
+// The capital letters are allowed only if upper is true, as "(I)" inside a sentence is more likely the pronoun.
+func isEnumerator(s string, upper bool) bool {
+	closing := strings.IndexByte(s, ')')
+	if closing < 2 || closing > 5 {
+		return false
+	}
+	inner := s[1:closing]
+	if isCitationNumber(inner) {
+		return len(inner) <= 2
+	}
+	if !upper && strings.ToLower(inner) != inner {
+		return false
+	}
+	return isRomanOrLetter(inner)
+}
+
+// isRomanOrLetter reports whether the string is a single ASCII letter or a Roman numeral of up to four letters, in either case.
+func isRomanOrLetter(s string) bool {
+	if len(s) == 1 {
+		return s[0] >= 'a' && s[0] <= 'z' || s[0] >= 'A' && s[0] <= 'Z'
+	}
+	return len(s) <= 4 && (strings.Trim(s, "ivxlc") == "" || strings.Trim(s, "IVXLC") == "")
+}
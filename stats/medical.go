@@ -0,0 +1,25 @@
+package stats
+
+// ====== Types ======
+
+// medicalAbbreviations are the abbreviations of the health texts (see `WithMedicalProfile`): the units, the Latin dosing instructions,
+// the dosage forms, and the shorthand of the clinical notes.
+var medicalAbbreviations = []string{
+	"mg.", "mcg.", "kg.", "ml.", "cc.", "mmol.", "meq.", "iu.", "gtt.", "tsp.", "tbsp.",
+	"q.d.", "b.i.d.", "t.i.d.", "q.i.d.", "q.h.", "q.o.d.", "q.a.m.", "q.p.m.", "q.h.s.", "h.s.", "a.c.", "p.c.", "p.r.n.", "p.o.", "n.p.o.",
+	"i.v.", "i.m.", "s.c.", "s.q.", "s.l.", "p.r.", "o.d.", "o.s.", "o.u.", "a.d.", "a.s.", "a.u.",
+	"tab.", "tabs.", "cap.", "caps.", "inj.", "sol.", "susp.", "oint.", "supp.", "amp.",
+	"pt.", "pts.", "dx.", "rx.", "hx.", "tx.", "sx.", "fx.", "approx.", "temp.", "hr.", "hrs.", "wk.", "wks.", "yr.", "yrs.", "wt.", "ht.", "vs.",
+	"md.", "r.n.", "n.p.", "p.a.", "d.o.", "ph.d.", "pharm.d.",
+}
+
+// ====== Functions ======
+
+// WithMedicalProfile configures the counting for the health texts: the abbreviations of the units, the dosing instructions, and the clinical notes
+// ("mg.", "q.d.", "b.i.d.", "p.r.n.", "Pt.") are added to the abbreviations (see `WithExtraAbbreviations`), so "Take 5 mg. b.i.d. with food." is one sentence.
+// A unit at the end of a sentence doesn't end it either, so "The dose is 5 mg. Take it daily." is one sentence too.
+func WithMedicalProfile() Option {
+	return func(c *Config) {
+		c.extraAbbreviations = append(c.extraAbbreviations, medicalAbbreviations...)
+	}
+}
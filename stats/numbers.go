@@ -0,0 +1,254 @@
+package stats
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types & Consts ======
+
+// NumberPolicy represents how the syllables of the words with digits ("42", "3rd", "COVID-19", "$5") are counted.
+type NumberPolicy int
+
+const (
+	// SpokenNumbers counts the syllables of the spoken English form of the numbers: "42" as "forty-two" (3 syllables), "$5" as "five dollars" (3 syllables).
+	SpokenNumbers NumberPolicy = iota
+	// NumbersAsOneSyllable counts a word with digits as one syllable, whatever its length.
+	NumbersAsOneSyllable
+)
+
+var smallNumbers = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+}
+
+var tens = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+var numberScales = []struct {
+	value uint64
+	name  string
+}{
+	{1_000_000_000_000, "trillion"},
+	{1_000_000_000, "billion"},
+	{1_000_000, "million"},
+	{1_000, "thousand"},
+	{100, "hundred"},
+}
+
+// irregularOrdinals maps the numbers whose ordinals are not formed with "-th" to their ordinals.
+var irregularOrdinals = map[string]string{
+	"one": "first", "two": "second", "three": "third", "five": "fifth", "eight": "eighth", "nine": "ninth", "twelve": "twelfth",
+}
+
+// numberSuffixes maps the suffixes of the shortened numbers ("5K", "$1.5M", "2bn") to the words they stand for.
+var numberSuffixes = map[string]string{
+	"k": "thousand", "m": "million", "mn": "million", "b": "billion", "bn": "billion", "t": "trillion", "tn": "trillion",
+}
+
+// currencies maps the currency signs to the names of the currencies, in plural.
+var currencies = map[rune]string{
+	'$': "dollars", '€': "euros", '£': "pounds", '¥': "yen", '₽': "rubles", '₹': "rupees",
+}
+
+// numberSymbols maps the symbols that are read as words in numbers and formulas to the words.
+var numberSymbols = map[rune]string{
+	'%': "percent", '&': "and", '+': "plus", '=': "equals", '@': "at", '°': "degrees",
+}
+
+// ====== Functions ======
+
+// WithNumberPolicy sets how the syllables of the words with digits are counted (`SpokenNumbers` by default).
+func WithNumberPolicy(policy NumberPolicy) Option {
+	return func(c *Config) {
+		c.Numbers = policy
+	}
+}
+
+// WithSpokenNumbers expands the numbers, currencies, and percentages into English words before counting words and syllables,
+// as the readability formulas were calibrated on texts with the numbers spelled out: "$1.5M" counts as five words, "one point five million dollars".
+// Only the counts change: `Words` still returns the words as they are written.
+func WithSpokenNumbers() Option {
+	return func(c *Config) {
+		c.ExpandNumbers = true
+	}
+}
+
+// spokenWords accepts a word with digits and returns the words it is read as in English: "42" is "forty two", "3rd" is "third",
+// "COVID-19" is "COVID nineteen", "$1.5M" is "one point five million dollars", "50%" is "fifty percent", and "1845" (a year) is "eighteen forty five".
+// The letters are kept as they are, and the symbols that are not read (hyphens, slashes) are dropped.
+func spokenWords(word string) []string {
+	var words []string
+	currency := ""
+	for i := 0; i < len(word); {
+		char, size := utf8.DecodeRuneInString(word[i:])
+		switch {
+		case unicode.IsDigit(char):
+			end := numberEnd(word, i)
+			number := word[i:end]
+			words = append(words, numberWords(number)...)
+			i = end
+
+			letters := word[i : i+strings.IndexFunc(word[i:]+" ", func(c rune) bool { return !unicode.IsLetter(c) })]
+			suffix := strings.ToLower(letters)
+			if scale, ok := numberSuffixes[suffix]; ok {
+				words = append(words, scale)
+				i += len(letters)
+			} else if (suffix == "st" || suffix == "nd" || suffix == "rd" || suffix == "th") && !strings.Contains(number, ".") {
+				words[len(words)-1] = ordinal(words[len(words)-1])
+				i += len(letters)
+			}
+			if currency != "" {
+				if number == "1" && suffix == "" {
+					currency = strings.TrimSuffix(currency, "s")
+				}
+				words = append(words, currency)
+				currency = ""
+			}
+		case unicode.IsLetter(char):
+			end := i + strings.IndexFunc(word[i:]+" ", func(c rune) bool { return !unicode.IsLetter(c) && c != '\'' })
+			words = append(words, word[i:end])
+			i = end
+		default:
+			if name, ok := currencies[char]; ok {
+				currency = name
+			} else if name, ok := numberSymbols[char]; ok {
+				words = append(words, name)
+			}
+			i += size
+		}
+	}
+	return words
+}
+
+// numberEnd returns the index after the number that starts at the index, with its thousands separators ("1,000") and decimal point ("3.14").
+func numberEnd(word string, start int) int {
+	i := start
+	for i < len(word) {
+		if word[i] >= '0' && word[i] <= '9' {
+			i++
+			continue
+		}
+		if (word[i] == ',' || word[i] == '.') && i+1 < len(word) && word[i+1] >= '0' && word[i+1] <= '9' {
+			i++
+			continue
+		}
+		break
+	}
+	return i
+}
+
+// numberWords returns the English words of the number written with digits, thousands separators, and a decimal point.
+// The digits after the point, and the numbers with leading zeros ("007") or too long to be read, are read digit by digit.
+func numberWords(number string) []string {
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+	words := integerWords(integer)
+	if hasFraction {
+		words = append(words, "point")
+		words = append(words, digitWords(fraction)...)
+	}
+	return words
+}
+
+// integerWords returns the English words of the integer written with digits and, possibly, thousands separators.
+func integerWords(integer string) []string {
+	digits := strings.ReplaceAll(integer, ",", "")
+	n, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || len(digits) > 15 || (len(digits) > 1 && digits[0] == '0') {
+		return digitWords(digits)
+	}
+	// The years from 1100 to 1999 are read by pairs of digits: "eighteen forty five", "nineteen hundred", "nineteen oh five".
+	if len(integer) == 4 && n >= 1100 && n < 2000 {
+		words := cardinalWords(n / 100)
+		switch rest := n % 100; {
+		case rest == 0:
+			return append(words, "hundred")
+		case rest < 10:
+			return append(words, "oh", smallNumbers[rest])
+		default:
+			return append(words, cardinalWords(rest)...)
+		}
+	}
+	return cardinalWords(n)
+}
+
+// cardinalWords returns the English words of the number.
+func cardinalWords(n uint64) []string {
+	if n < 20 {
+		return []string{smallNumbers[n]}
+	}
+	var words []string
+	for _, scale := range numberScales {
+		if n >= scale.value {
+			words = append(words, cardinalWords(n/scale.value)...)
+			words = append(words, scale.name)
+			n %= scale.value
+		}
+	}
+	switch {
+	case n >= 20:
+		words = append(words, tens[n/10])
+		if n%10 > 0 {
+			words = append(words, smallNumbers[n%10])
+		}
+	case n > 0:
+		words = append(words, smallNumbers[n])
+	}
+	return words
+}
+
+// digitWords returns the English words of the digits, one per digit.
+func digitWords(digits string) []string {
+	words := make([]string, 0, len(digits))
+	for _, digit := range digits {
+		if digit >= '0' && digit <= '9' {
+			words = append(words, smallNumbers[digit-'0'])
+		}
+	}
+	return words
+}
+
+// ordinal returns the ordinal of the English number word: "first", "third", "twentieth", "hundredth".
+func ordinal(word string) string {
+	if irregular, ok := irregularOrdinals[word]; ok {
+		return irregular
+	}
+	if strings.HasSuffix(word, "y") {
+		return strings.TrimSuffix(word, "y") + "ieth"
+	}
+	return word + "th"
+}
+
+// trimNumberWord returns the word with the leading and trailing punctuation trimmed off, keeping a currency sign before a number ("$5")
+// and a percent sign after it ("50%"), as they are read.
+func trimNumberWord(word string) string {
+	trimmed := strings.TrimLeftFunc(word, isNotLetterOrNumber)
+	if sign, size := utf8.DecodeLastRuneInString(word[:len(word)-len(trimmed)]); size > 0 && currencies[sign] != "" && startsWithDigit(trimmed) {
+		trimmed = word[len(word)-len(trimmed)-size:]
+	}
+	rest := trimWordEnd(trimmed)
+	if strings.HasPrefix(trimmed[len(rest):], "%") && len(rest) > 0 && rest[len(rest)-1] >= '0' && rest[len(rest)-1] <= '9' {
+		return rest + "%"
+	}
+	return rest
+}
+
+// numberToken returns the text of the token in the text with the currency sign before a number and the percent sign after it, as `trimNumberWord` keeps them,
+// so "$5" is counted as "five dollars" and not as "five".
+func numberToken(text string, token Token) string {
+	start, end := token.Start, token.End
+	if sign, size := utf8.DecodeLastRuneInString(text[:start]); size > 0 && currencies[sign] != "" && startsWithDigit(token.Text) {
+		start -= size
+	}
+	if last := token.Text[len(token.Text)-1]; last >= '0' && last <= '9' && strings.HasPrefix(text[end:], "%") {
+		end++
+	}
+	return text[start:end]
+}
+
+// startsWithDigit reports whether the string starts with a digit.
+func startsWithDigit(s string) bool {
+	char, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsDigit(char)
+}
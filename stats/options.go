@@ -0,0 +1,312 @@
+package stats
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ====== Types ======
+
+// Tokenizer splits a text into words.
+type Tokenizer func(text string) []string
+
+// SyllableCounter counts syllables of words. The heuristic (`SyllableCounterFunc(CountSyllables)`), `PronouncingDictionary`, `Hyphenator`,
+// and the counters of the language packages (see `RegisterSyllableCounter`) implement it.
+type SyllableCounter interface {
+	CountSyllables(word string) uint
+}
+
+// SyllableCounterFunc is a function that returns the number of syllables in a word, used as a SyllableCounter.
+type SyllableCounterFunc func(word string) uint
+
+// SentenceSegmenter splits a text into sentences.
+type SentenceSegmenter func(text string) []Sentence
+
+// Config represents the settings of the counting functions. The zero value of a field means the default behavior.
+type Config struct {
+	// Language is the ISO 639-1 code of the text language. Defaults to "en".
+	Language string
+	// Tokenizer splits the text into words. Defaults to splitting by whitespaces.
+	Tokenizer Tokenizer
+	// SyllableCounter counts syllables of a word. If nil, the counter registered for the Language is used (see `RegisterSyllableCounter`),
+	// or `CountSyllables` (English heuristic) if there is none.
+	SyllableCounter SyllableCounter
+	// Abbreviations maps the abbreviations to the number of points in them. Points in abbreviations do not end sentences.
+	// Defaults to the abbreviations of the language (see `DefaultAbbreviations`), or English ones for the languages without their own.
+	Abbreviations map[string]int
+	// SmartAbbreviations enables the heuristic for the abbreviations that are not in Abbreviations (see `WithSmartAbbreviations`).
+	SmartAbbreviations bool
+	// SentenceSegmenter splits the text into sentences. If nil, the built-in segmenter is used (see `Sentences`).
+	SentenceSegmenter SentenceSegmenter
+	// FamiliarWords reports whether a word is familiar to the readers. If nil, the Dale–Chall formula and its difficult words use the Dale–Chall list
+	// (see `en.CountDifficultWords`), and `DifficultWords` considers no word unfamiliar.
+	FamiliarWords func(word string) bool
+	// SplitHyphens, SplitEnDashes, and SplitEmDashes split the words at hyphens ("well-known"), en dashes ("1845–1851"), and em dashes ("clause—clause").
+	// By default, such words count as one word. See `WithSplitHyphens`, `WithSplitEnDashes`, `WithSplitEmDashes`.
+	SplitHyphens  bool
+	SplitEnDashes bool
+	SplitEmDashes bool
+	// ExpandContractions expands the English contractions before counting words and syllables (see `WithExpandedContractions`).
+	ExpandContractions bool
+	// ExpandNumbers expands the numbers, currencies, and percentages into English words before counting words and syllables (see `WithSpokenNumbers`).
+	ExpandNumbers bool
+	// Numbers is how the syllables of the words with digits are counted (see `WithNumberPolicy`). Defaults to `SpokenNumbers`.
+	Numbers NumberPolicy
+	// FrequencyList ranks the words by their frequency for the estimates of the vocabulary by frequency bands (see `WithFrequencyList`).
+	// If nil, the estimates that need it use other measures of the vocabulary.
+	FrequencyList *FrequencyList
+	// Lemmatizer returns the lemma of a word in lower case, for comparing words (see `WithLemmatizer`). If nil, the words are compared as they are.
+	Lemmatizer func(word string) string
+	// StopWords reports whether a word in lower case is a stop word, which is excluded from the word frequencies, the unique words, and the lexical diversity
+	// (see `WithStopWords`, `WithDefaultStopWords`). If nil, no word is excluded.
+	StopWords func(word string) bool
+	// LineParagraphs makes every line break a paragraph boundary (see `WithLineParagraphs`).
+	LineParagraphs bool
+	// DigitsAsLetters counts the digits as letters (see `WithDigitsAsLetters`).
+	DigitsAsLetters bool
+	// Composition is how the letters of the text are composed before counting (see `WithComposition`). Defaults to ComposeMarks.
+	Composition Composition
+	// Placeholders counts the interpolation placeholders ("{name}", "%s", "{{.Var}}") as one-syllable words (see `WithPlaceholders`).
+	Placeholders bool
+	// RemoveCitations removes the footnote markers and the citations ("[1]", "(Smith et al., 2019)", "410 U.S. 113") before counting (see `WithoutCitations`).
+	RemoveCitations bool
+	// Legal joins the section signs to their numbers and drops the labels of the numbered clauses before counting (see `WithLegalProfile`).
+	Legal bool
+	// Equations counts every equation ("$x^2$", "E = mc^2") as one word of one syllable (see `WithAcademicProfile`, `ReplaceEquations`).
+	Equations bool
+	// Dialogue makes the quoted sentences followed by their attributions ("'Stop!' Tom said.") not end the sentences they are in (see `WithFictionProfile`).
+	Dialogue bool
+
+	// extraAbbreviations and removedAbbreviations change the Abbreviations (see `WithExtraAbbreviations`, `WithoutAbbreviations`).
+	extraAbbreviations   []string
+	removedAbbreviations []string
+	// defaultStopWords makes the stop words of the Language the StopWords, unless they are set (see `WithDefaultStopWords`).
+	defaultStopWords bool
+	// defaultTokenizer is true if the Tokenizer is the default one, so the words can be counted while scanning the text.
+	defaultTokenizer bool
+}
+
+// Option changes a Config.
+type Option func(*Config)
+
+// ====== Methods ======
+
+// CountSyllables calls f(word).
+func (f SyllableCounterFunc) CountSyllables(word string) uint {
+	return f(word)
+}
+
+// ====== Functions ======
+
+// WithLanguage sets the language of the text by its ISO 639-1 code ("en", "it", "es").
+func WithLanguage(language string) Option {
+	return func(c *Config) {
+		c.Language = strings.ToLower(language)
+	}
+}
+
+// WithTokenizer sets the function that splits the text into words.
+func WithTokenizer(tokenizer Tokenizer) Option {
+	return func(c *Config) {
+		c.Tokenizer = tokenizer
+	}
+}
+
+// WithSyllableCounter sets the SyllableCounter that counts syllables of a word, replacing the counter of the language.
+func WithSyllableCounter(counter SyllableCounter) Option {
+	return func(c *Config) {
+		c.SyllableCounter = counter
+	}
+}
+
+// WithAbbreviations sets the abbreviations (with the points) and the number of points in each of them, replacing the defaults of the language.
+// The abbreviations are matched case-insensitively, so "Inc." matches "inc." and "INC.".
+func WithAbbreviations(abbreviations map[string]int) Option {
+	lowered := make(map[string]int, len(abbreviations))
+	for abbreviation, points := range abbreviations {
+		lowered[strings.ToLower(abbreviation)] = points
+	}
+	return func(c *Config) {
+		c.Abbreviations = lowered
+	}
+}
+
+// WithSmartAbbreviations enables a heuristic for the abbreviations missing in the abbreviations list: a point does not end a sentence
+// if the next word starts with a lower case letter ("approx. ten") or if the word before it is a single capital letter ("J. R. R. Tolkien").
+func WithSmartAbbreviations() Option {
+	return func(c *Config) {
+		c.SmartAbbreviations = true
+	}
+}
+
+// WithSentenceSegmenter sets the function that splits the text into sentences, replacing the built-in segmenter (for example, `UAX29Sentences`).
+func WithSentenceSegmenter(segmenter SentenceSegmenter) Option {
+	return func(c *Config) {
+		c.SentenceSegmenter = segmenter
+	}
+}
+
+// WithFamiliarWords sets the function that reports whether a word is familiar to the readers (for example, `en.IsFamiliarWord`).
+func WithFamiliarWords(isFamiliar func(word string) bool) Option {
+	return func(c *Config) {
+		c.FamiliarWords = isFamiliar
+	}
+}
+
+// WithSplitHyphens makes hyphenated compounds count as separate words, so "well-known" is two words.
+func WithSplitHyphens() Option {
+	return func(c *Config) {
+		c.SplitHyphens = true
+	}
+}
+
+// WithSplitEnDashes makes ranges joined by an en dash count as separate words, so "1845–1851" is two words.
+func WithSplitEnDashes() Option {
+	return func(c *Config) {
+		c.SplitEnDashes = true
+	}
+}
+
+// WithSplitEmDashes makes clauses joined by an em dash without spaces count as separate words, so "waited—nothing" is two words.
+func WithSplitEmDashes() Option {
+	return func(c *Config) {
+		c.SplitEmDashes = true
+	}
+}
+
+// WithDigitsAsLetters counts the digits as letters (see `CountLetters`), so the letters are the same as the characters.
+// For example, the Coleman–Liau index is defined on letters, while some implementations count letters and digits.
+func WithDigitsAsLetters() Option {
+	return func(c *Config) {
+		c.DigitsAsLetters = true
+	}
+}
+
+// NewConfig accepts options and returns a Config with the options applied and the defaults set for the rest of the fields (except SyllableCounter).
+func NewConfig(opts ...Option) Config {
+	c := applyOptions(opts)
+
+	if c.Language == "" {
+		c.Language = "en"
+	}
+	if c.Tokenizer == nil {
+		c.Tokenizer = strings.Fields
+		c.defaultTokenizer = true
+	}
+	if dashes := c.splitDashes(); dashes != "" {
+		c.Tokenizer = splitAtDashes(c.Tokenizer, dashes)
+		c.defaultTokenizer = false
+	}
+	if c.Abbreviations == nil {
+		c.Abbreviations = languageAbbreviations[c.Language]
+		if c.Abbreviations == nil {
+			c.Abbreviations = abbreviations
+		}
+	}
+	c.Abbreviations = applyAbbreviationChanges(c.Abbreviations, c.extraAbbreviations, c.removedAbbreviations)
+	if stopWords, ok := languageStopWords[c.Language]; ok && c.defaultStopWords && c.StopWords == nil {
+		c.StopWords = func(word string) bool {
+			return stopWords[word]
+		}
+	}
+	return c
+}
+
+// applyOptions returns a Config with the options applied and no defaults set.
+func applyOptions(opts []Option) Config {
+	var c Config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// prepare returns the text as it is counted: composed by the configured Composition and rewritten (see `rewrite`).
+func (c Config) prepare(text string) string {
+	return c.rewrite(Compose(text, c.Composition))
+}
+
+// rewrite returns the text without the citations, with the legal references and clauses rewritten, and with the equations and the placeholders
+// replaced with a one-syllable word, if they are configured.
+func (c Config) rewrite(text string) string {
+	if c.RemoveCitations {
+		text = RemoveCitations(text)
+	}
+	if c.Legal {
+		text = rewriteLegal(text)
+	}
+	if c.Equations {
+		text = ReplaceEquations(text, placeholderWord)
+	}
+	if c.Placeholders {
+		text = ReplacePlaceholders(text, placeholderWord)
+	}
+	return text
+}
+
+// countWords returns the number of words the tokenized words count as, with the contractions and the numbers expanded if it is configured.
+func (c Config) countWords(words []string) uint {
+	if !c.ExpandContractions && !c.ExpandNumbers {
+		return uint(len(words))
+	}
+	var count uint
+	for _, word := range words {
+		count += uint(len(c.expandWord(word)))
+	}
+	return count
+}
+
+// expandWord returns the words the word is counted as: the spoken words of a number if ExpandNumbers is set,
+// the full form of a contraction if ExpandContractions is set, or the word itself.
+func (c Config) expandWord(word string) []string {
+	if c.ExpandNumbers && strings.IndexFunc(word, unicode.IsDigit) >= 0 {
+		if words := spokenWords(trimNumberWord(word)); len(words) > 0 {
+			return words
+		}
+	}
+	if c.ExpandContractions {
+		return expandContraction(word)
+	}
+	return []string{word}
+}
+
+// countedWords returns the words a token of the text is counted as (see `expandWord`) with the leading and trailing punctuation trimmed off them.
+// A word without letters or numbers (a dash between spaces) is counted, but it is left empty and has no syllables.
+func (c Config) countedWords(token string) []string {
+	var words []string
+	for _, word := range c.expandWord(token) {
+		words = append(words, trimNumberWord(word))
+	}
+	return words
+}
+
+// splitDashes returns the dashes the words are split at.
+func (c Config) splitDashes() string {
+	var dashes string
+	if c.SplitHyphens {
+		dashes += "-‐"
+	}
+	if c.SplitEnDashes {
+		dashes += "–"
+	}
+	if c.SplitEmDashes {
+		dashes += "—"
+	}
+	return dashes
+}
+
+// countSyllables counts syllables of a word with the configured SyllableCounter, with the counter of the language if none is set, or with `CountSyllables`.
+// Typographic apostrophes are replaced with straight ones first.
+func (c Config) countSyllables(word string) uint {
+	word = normalizeApostrophes(word)
+	if c.Numbers == NumbersAsOneSyllable && strings.IndexFunc(word, unicode.IsDigit) >= 0 {
+		return 1
+	}
+	if c.SyllableCounter != nil {
+		return c.SyllableCounter.CountSyllables(word)
+	}
+	if counter, ok := languageSyllableCounters[c.Language]; ok {
+		return counter.CountSyllables(word)
+	}
+	return CountSyllables(word)
+}
@@ -0,0 +1,97 @@
+package stats
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ====== Types ======
+
+// Paragraph represents a paragraph of a text with its byte offsets in the text. Text is `text[Start:End]`.
+type Paragraph struct {
+	Text  string `json:"text"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+// ParagraphStats represents a paragraph of a text with the numbers of its words and sentences (see `CountWords`, `CountSentences`).
+type ParagraphStats struct {
+	Paragraph
+	Words     uint `json:"words"`
+	Sentences uint `json:"sentences"`
+}
+
+// ====== Functions ======
+
+// WithLineParagraphs makes every line break a paragraph boundary, for the texts whose paragraphs are not separated by blank lines.
+func WithLineParagraphs() Option {
+	return func(c *Config) {
+		c.LineParagraphs = true
+	}
+}
+
+// CountParagraphs accepts a string and options and returns the number of paragraphs in it. See `Paragraphs`.
+func CountParagraphs(text string, opts ...Option) uint {
+	return uint(len(Paragraphs(text, opts...)))
+}
+
+// AnalyzeParagraphs accepts a string and options and returns its paragraphs with the numbers of their words and sentences. See `Paragraphs`.
+// Only the sentences that end with a terminator are counted, so a heading has no sentences.
+func AnalyzeParagraphs(text string, opts ...Option) []ParagraphStats {
+	cfg := NewConfig(opts...)
+	paragraphs := splitParagraphs(text, cfg)
+	results := make([]ParagraphStats, 0, len(paragraphs))
+	for _, paragraph := range paragraphs {
+		results = append(results, ParagraphStats{
+			Paragraph: paragraph,
+			Words:     cfg.countWords(cfg.Tokenizer(cfg.prepare(paragraph.Text))),
+			Sentences: countSentences(paragraph.Text, cfg),
+		})
+	}
+	return results
+}
+
+// Paragraphs accepts a string and options and returns its paragraphs with their byte offsets. The paragraphs are separated by blank lines (lines of whitespaces only),
+// so "One.\n\nTwo." has two paragraphs, while "One.\nTwo." has one, unless every line is a paragraph (see `WithLineParagraphs`).
+// The whitespaces around the paragraphs are not included in them.
+func Paragraphs(text string, opts ...Option) []Paragraph {
+	return splitParagraphs(text, applyOptions(opts))
+}
+
+// splitParagraphs accepts a string and a config and returns the paragraphs of the string.
+func splitParagraphs(text string, cfg Config) []Paragraph {
+	var paragraphs []Paragraph
+	offset, start, end := 0, -1, 0
+	for _, line := range strings.SplitAfter(text, "\n") {
+		blank := isBlank(line)
+		if (blank || cfg.LineParagraphs) && start >= 0 {
+			paragraphs = append(paragraphs, trimParagraph(text, start, end))
+			start = -1
+		}
+		if !blank {
+			if start < 0 {
+				start = offset
+			}
+			end = offset + len(line)
+		}
+		offset += len(line)
+	}
+	if start >= 0 {
+		paragraphs = append(paragraphs, trimParagraph(text, start, end))
+	}
+	return paragraphs
+}
+
+// trimParagraph returns the Paragraph of text[start:end] with the surrounding whitespaces trimmed off.
+func trimParagraph(text string, start, end int) Paragraph {
+	part := text[start:end]
+	trimmed := strings.TrimLeftFunc(part, unicode.IsSpace)
+	start += len(part) - len(trimmed)
+	trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+	return Paragraph{trimmed, start, start + len(trimmed)}
+}
+
+// isBlank reports whether the string consists of whitespaces only.
+func isBlank(s string) bool {
+	return strings.TrimLeftFunc(s, unicode.IsSpace) == ""
+}
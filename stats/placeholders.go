@@ -0,0 +1,131 @@
+package stats
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// placeholderWord is the word the placeholders are counted as (see `WithPlaceholders`): one letter and one syllable in any language.
+const placeholderWord = "x"
+
+// ====== Functions ======
+
+// WithPlaceholders counts every interpolation placeholder (see `ReplacePlaceholders`) as one opaque word of one letter and one syllable,
+// so a templated message ("Delete {count} files from {{.Folder}}?") is scored without the names and the syntax of its variables.
+// A placeholder spaced inside ("{{ .Name }}") is one word too.
+func WithPlaceholders() Option {
+	return func(c *Config) {
+		c.Placeholders = true
+	}
+}
+
+// ReplacePlaceholders accepts a string and a replacement and returns the string with its interpolation placeholders replaced with the replacement,
+// so a templated message can be scored as the text it becomes: `ReplacePlaceholders("Hello, {name}!", "Ann")` returns "Hello, Ann!".
+// The placeholders are the printf verbs ("%s", "%1$d", "%.2f", "%@", "%(name)s"), the braced names ("{name}", "{0}", "${name}"),
+// and the template actions ("{{.Name}}", "{{ user }}"). A doubled percent sign ("%%") is a literal "%".
+// The ICU messages with branches ("{count, plural, one {# file} other {# files}}") are not placeholders, so their texts are kept.
+func ReplacePlaceholders(s, replacement string) string {
+	if !strings.ContainsAny(s, "%{") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if strings.HasPrefix(s[i:], "%%") {
+			b.WriteByte('%')
+			i += 2
+			continue
+		}
+		if end := placeholderEnd(s, i); end > i {
+			b.WriteString(replacement)
+			i = end
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
+
+// placeholderEnd returns the index after the placeholder that starts at the index, or -1 if there is none.
+func placeholderEnd(s string, i int) int {
+	switch {
+	case strings.HasPrefix(s[i:], "{{"):
+		end := strings.Index(s[i+2:], "}}")
+		if end < 0 || strings.ContainsAny(s[i+2:i+2+end], "\n{") {
+			return -1
+		}
+		return i + 2 + end + 2
+	case strings.HasPrefix(s[i:], "${"):
+		return bracedNameEnd(s, i+1)
+	case s[i] == '{':
+		return bracedNameEnd(s, i)
+	case s[i] == '%':
+		return printfVerbEnd(s, i)
+	}
+	return -1
+}
+
+// bracedNameEnd returns the index after the name in braces ("{name}", "{user.id}", "{0}") that starts at the index, or -1 if there is none.
+func bracedNameEnd(s string, i int) int {
+	end := strings.IndexByte(s[i+1:], '}')
+	if end <= 0 {
+		return -1
+	}
+	name := s[i+1 : i+1+end]
+	if strings.IndexFunc(name, func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c) && !strings.ContainsRune("_.:-", c)
+	}) >= 0 {
+		return -1
+	}
+	return i + 1 + end + 1
+}
+
+// printfVerbEnd returns the index after the printf verb ("%s", "%1$d", "%-5.2f", "%lld", "%@", "%(name)s") that starts at the index, or -1 if there is none.
+// The space flag is not supported, so a percent sign before a word ("50% of") is not a verb.
+func printfVerbEnd(s string, i int) int {
+	j := i + 1
+	if strings.HasPrefix(s[j:], "(") {
+		// A named Python verb.
+		end := strings.IndexByte(s[j:], ')')
+		if end <= 1 || strings.ContainsAny(s[j:j+end], " \n") {
+			return -1
+		}
+		j += end + 1
+	} else {
+		// An explicit argument index ("%1$s").
+		k := j
+		for k < len(s) && s[k] >= '0' && s[k] <= '9' {
+			k++
+		}
+		if k > j && k < len(s) && s[k] == '$' {
+			j = k + 1
+		}
+	}
+	for j < len(s) && strings.IndexByte("-+#0'", s[j]) >= 0 {
+		j++
+	}
+	for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '*') {
+		j++
+	}
+	if j < len(s) && s[j] == '.' {
+		j++
+		for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '*') {
+			j++
+		}
+	}
+	for _, modifier := range []string{"hh", "ll", "h", "l", "L", "q", "j", "z", "t"} {
+		if strings.HasPrefix(s[j:], modifier) {
+			j += len(modifier)
+			break
+		}
+	}
+	if j == len(s) || strings.IndexByte("diouxXeEfFgGaAcspn@", s[j]) < 0 {
+		return -1
+	}
+	// A verb is not followed by a letter: "%s" in "%says" is not a verb.
+	if next, _ := utf8.DecodeRuneInString(s[j+1:]); unicode.IsLetter(next) {
+		return -1
+	}
+	return j + 1
+}
@@ -0,0 +1,103 @@
+package stats
+
+import (
+	"io"
+	"strings"
+	"unicode"
+)
+
+// readerChunkSize is the number of bytes `CountAllStatsReader` reads at once.
+const readerChunkSize = 64 * 1024
+
+// ====== Types ======
+
+// Accumulator counts the statistics of a text written to it in chunks, for example a file read in parts or several documents of a corpus.
+// Only the complete sentences of the written text are counted, so the words and sentences split across chunk boundaries are counted once.
+// The zero value is not usable, create an Accumulator with `NewAccumulator`.
+type Accumulator struct {
+	cfg     Config
+	total   TotalStats
+	pending []byte
+}
+
+// ====== Methods ======
+
+// Add returns the sum of the statistics, so the statistics of several texts can be combined.
+func (stats TotalStats) Add(other TotalStats) TotalStats {
+	return TotalStats{
+		Symbols:       stats.Symbols + other.Symbols,
+		Characters:    stats.Characters + other.Characters,
+		Letters:       stats.Letters + other.Letters,
+		Words:         stats.Words + other.Words,
+		Sentences:     stats.Sentences + other.Sentences,
+		Syllables:     stats.Syllables + other.Syllables,
+		Polysyllables: stats.Polysyllables + other.Polysyllables,
+	}
+}
+
+// Write adds the next chunk of the text. The complete sentences of the text written so far are counted, and the rest is kept until the next chunk.
+// Write implements io.Writer and never returns an error.
+func (a *Accumulator) Write(p []byte) (int, error) {
+	a.pending = append(a.pending, p...)
+	if cut := safeCut(string(a.pending), a.cfg); cut > 0 {
+		a.total = a.total.Add(countAllStats(string(a.pending[:cut]), a.cfg))
+		a.pending = append(a.pending[:0], a.pending[cut:]...)
+	}
+	return len(p), nil
+}
+
+// WriteString adds the next chunk of the text. See `Write`.
+func (a *Accumulator) WriteString(s string) (int, error) {
+	return a.Write([]byte(s))
+}
+
+// AddStats adds the statistics counted separately, for example of another document.
+func (a *Accumulator) AddStats(st TotalStats) {
+	a.total = a.total.Add(st)
+}
+
+// Stats returns the statistics of the whole text written so far, including the incomplete last sentence.
+func (a *Accumulator) Stats() TotalStats {
+	return a.total.Add(countAllStats(string(a.pending), a.cfg))
+}
+
+// Flush counts the text kept after the last complete sentence, so the next text written starts a new document.
+func (a *Accumulator) Flush() {
+	a.total = a.Stats()
+	a.pending = a.pending[:0]
+}
+
+// ====== Functions ======
+
+// NewAccumulator accepts options and returns an empty Accumulator that counts with them.
+func NewAccumulator(opts ...Option) *Accumulator {
+	return &Accumulator{cfg: NewConfig(opts...)}
+}
+
+// CountAllStatsReader accepts a reader and options and returns all statistics of the text read from it, without loading the whole text into memory. See `CountAllStats`.
+// The text is read in chunks into an Accumulator, so the words and sentences split across chunk boundaries are counted once.
+// A sentence that exceeds several chunks is counted in parts split at whitespaces.
+// Returns the error of the reader, if any (except io.EOF).
+func CountAllStatsReader(r io.Reader, opts ...Option) (TotalStats, error) {
+	acc := NewAccumulator(opts...)
+	if _, err := io.CopyBuffer(acc, r, make([]byte, readerChunkSize)); err != nil {
+		return TotalStats{}, err
+	}
+	return acc.Stats(), nil
+}
+
+// safeCut returns the index the text can be split at without splitting a sentence or a word: the start of its last sentence,
+// which may continue in the next chunk. If the text has only one sentence and exceeds several chunks, returns the index after its last whitespace.
+// The whitespaces before the index are kept with the rest of the text, as they are symbols if a symbol follows them on the same line (see `CountSymbols`).
+// Returns 0 if the text cannot be split yet.
+func safeCut(text string, cfg Config) int {
+	cut := 0
+	sentences := splitSentences(text, cfg)
+	switch {
+	case len(sentences) > 1:
+		cut = sentences[len(sentences)-1].Start
+	case len(text) > 4*readerChunkSize:
+		cut = strings.LastIndexAny(text, " \t\r\n") + 1
+	}
+	return len(strings.TrimRightFunc(text[:cut], unicode.IsSpace))
+}
@@ -0,0 +1,289 @@
+package stats
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// Sentence represents a sentence of a text with its byte offsets in the text. Text is `text[Start:End]`.
+// Terminated is false for the text after the last sentence terminator.
+type Sentence struct {
+	Text       string `json:"text"`
+	Start      int    `json:"start"`
+	End        int    `json:"end"`
+	Terminated bool   `json:"terminated"`
+}
+
+// segmenterState represents the state of the sentence segmenter.
+type segmenterState int
+
+const (
+	// betweenSentences is the state before the first sentence and after the end of a sentence.
+	betweenSentences segmenterState = iota
+	// inSentence is the state inside a sentence.
+	inSentence
+	// inTerminator is the state inside a run of terminators and closing punctuation.
+	inTerminator
+)
+
+// segmenter splits a text into sentences.
+type segmenter struct {
+	text      string
+	cfg       Config
+	state     segmenterState
+	start     int
+	termStart int
+	// fullWidth is true if the run of terminators has a full-width terminator ("。"), which ends a sentence without a following whitespace.
+	fullWidth bool
+	// yield receives the sentences. The segmenter stops when it returns false.
+	yield   func(Sentence) bool
+	stopped bool
+}
+
+// ====== Methods ======
+
+// run runs the segmenter over the text and passes the sentences to yield.
+func (s *segmenter) run() {
+	for i, char := range s.text {
+		if s.stopped {
+			return
+		}
+		s.step(char, i)
+	}
+	s.finish()
+}
+
+// step handles the rune at the index of the text.
+func (s *segmenter) step(char rune, i int) {
+	switch s.state {
+	case betweenSentences:
+		if unicode.IsSpace(char) {
+			return
+		}
+		s.start = i
+		s.state = inSentence
+		s.inSentence(char, i)
+	case inSentence:
+		if char == '\n' && s.endsParagraph(i) {
+			// The terminator before the blank line didn't end the sentence by itself, as in an abbreviation ("Mr.\n\nSmith"), but the paragraph ends it.
+			end := len(strings.TrimRightFunc(s.text[:i], unicode.IsSpace))
+			last, _ := utf8.DecodeLastRuneInString(strings.TrimRightFunc(s.text[:end], isClosingPunctuation))
+			s.emit(end, isSentenceTerminator(last))
+			return
+		}
+		s.inSentence(char, i)
+	case inTerminator:
+		switch {
+		case isSentenceTerminator(char) || isClosingPunctuation(char):
+			s.fullWidth = s.fullWidth || isFullWidthTerminator(char)
+		case unicode.IsSpace(char):
+			if s.isBoundary(i) {
+				s.emit(i, true)
+			} else {
+				s.state = inSentence
+			}
+		case s.fullWidth:
+			s.emit(i, true)
+			s.step(char, i)
+		default:
+			// A terminator inside a word ("3.14", "U.S") doesn't end the sentence.
+			s.state = inSentence
+		}
+	}
+}
+
+// endsParagraph reports whether the line break at the index ends a blank line, which separates paragraphs.
+func (s *segmenter) endsParagraph(i int) bool {
+	lineStart := strings.LastIndexByte(s.text[s.start:i], '\n')
+	return lineStart >= 0 && isBlank(s.text[s.start+lineStart+1:i])
+}
+
+// finish yields the last sentence of the text.
+func (s *segmenter) finish() {
+	if s.stopped {
+		return
+	}
+	switch s.state {
+	case inTerminator:
+		s.emit(len(s.text), true)
+	case inSentence:
+		s.emit(len(strings.TrimRightFunc(s.text, unicode.IsSpace)), false)
+	}
+}
+
+// inSentence handles a rune inside a sentence.
+func (s *segmenter) inSentence(char rune, i int) {
+	if isSentenceTerminator(char) {
+		s.state = inTerminator
+		s.termStart = i
+		s.fullWidth = isFullWidthTerminator(char)
+	}
+}
+
+// isBoundary reports whether the run of terminators that ends at the index ends the sentence.
+func (s *segmenter) isBoundary(end int) bool {
+	run := s.text[s.termStart:end]
+	terminators := strings.TrimRightFunc(run, isClosingPunctuation)
+	if len(terminators) < len(run) && (startsWithLower(s.text[end:]) || s.cfg.Dialogue && startsWithAttribution(s.text[end:])) {
+		// Quoted speech followed by its attribution: "Stop!" he said.
+		return false
+	}
+	if isEllipsis(terminators) {
+		return !isOmission(s.text[:s.termStart], s.text[s.termStart:end]) && !startsWithLower(s.text[end:])
+	}
+	if lastField(s.text[s.start:end]) == ".." {
+		// The parent directory in a command: "Run cd .. and list the files."
+		return false
+	}
+	if terminators != "." {
+		return true
+	}
+	if endsWithAbbreviation(s.text[s.start:end], s.cfg.Abbreviations) {
+		return false
+	}
+	if word := strings.TrimLeftFunc(lastField(s.text[s.start:s.termStart+1]), isNotLetterOrNumber); isInitialism(word) {
+		// An unknown initialism ends the sentence only if the next word doesn't continue it: "N.A.S.A. launched", but "It was N.A.S.A. Then".
+		return !startsWithLower(s.text[end:])
+	}
+	return !s.cfg.SmartAbbreviations || !looksLikeAbbreviation(s.text[s.start:s.termStart], s.text[end:])
+}
+
+// emit yields the sentence from the start to the end and moves the segmenter between sentences.
+func (s *segmenter) emit(end int, terminated bool) {
+	s.stopped = !s.yield(Sentence{s.text[s.start:end], s.start, end, terminated})
+	s.state = betweenSentences
+}
+
+// ====== Functions ======
+
+// Sentences accepts a string and options and returns its sentences with their byte offsets.
+// A sentence ends with a run of terminators (".", "!", "?", "…", "‽") and closing quotes or brackets followed by a whitespace or the end of the string,
+// so "?!", "!!!", "?!..", and "..." end one sentence, and the points inside numbers ("3.14") do not end sentences.
+// The points of unknown initialisms ("N.A.S.A.") end sentences only before a capitalized word. Roman numerals ("World War II.", "Henry V.") end sentences.
+// A quoted sentence does not end the sentence it is quoted in if the next word starts with a lower case letter ("He said, 'Stop!' and left.", "\"Yes!\" she said.").
+// CJK terminators ("。", "！", "？") end sentences without a following whitespace. The inverted marks ("¿", "¡") need no handling, as they start sentences.
+// An ellipsis does not end a sentence if the next word starts with a lower case letter ("fled the scene ... and headed west") or if it is an omission in brackets ("[...]").
+// Points in the configured abbreviations (see `WithAbbreviations`, `WithLanguage`) do not end sentences, unless they are at the end of the string.
+// With `WithSmartAbbreviations`, the points that look like abbreviation points do not end sentences either.
+// A blank line ends a sentence as well, so a heading without a terminator ("Introduction\n\nText.") is a sentence of its own, with `Terminated` set to false.
+// The text after the last terminator is a sentence too, with `Terminated` set to false. Whitespaces between sentences are not included in them.
+// If a SentenceSegmenter is configured (see `WithSentenceSegmenter`), the sentences are split by it instead.
+// As in `Words`, the offsets are in the given text and the options that rewrite the text before counting are not applied.
+func Sentences(text string, opts ...Option) []Sentence {
+	return splitSentences(text, NewConfig(opts...))
+}
+
+// splitSentences accepts a string and a config and returns the sentences of the string.
+func splitSentences(text string, cfg Config) []Sentence {
+	if cfg.SentenceSegmenter != nil {
+		return cfg.SentenceSegmenter(text)
+	}
+	var sentences []Sentence
+	s := segmenter{text: text, cfg: cfg, yield: func(sentence Sentence) bool {
+		sentences = append(sentences, sentence)
+		return true
+	}}
+	s.run()
+	return sentences
+}
+
+// endsWithAbbreviation reports whether the last word of the string is one of the abbreviations (in lower case).
+// The whole word is matched, case-insensitively and without the leading punctuation and the trailing quotes and brackets, so "(Mr." and "MR." match "mr.", and "first." doesn't match "st.".
+func endsWithAbbreviation(s string, abbreviations map[string]int) bool {
+	word := strings.TrimLeftFunc(lastField(s), isNotLetterOrNumber)
+	word = strings.TrimRightFunc(word, isClosingPunctuation)
+	_, ok := abbreviations[strings.ToLower(word)]
+	return ok
+}
+
+// lastField returns the last whitespace-separated field of the string.
+func lastField(s string) string {
+	s = strings.TrimRightFunc(s, unicode.IsSpace)
+	if i := strings.LastIndexFunc(s, unicode.IsSpace); i >= 0 {
+		_, size := utf8.DecodeRuneInString(s[i:])
+		s = s[i+size:]
+	}
+	return s
+}
+
+// looksLikeAbbreviation reports whether a point between the strings is an abbreviation point by the heuristic of `WithSmartAbbreviations`:
+// the next word starts with a lower case letter, or the word before the point is a single capital letter.
+func looksLikeAbbreviation(before, after string) bool {
+	if startsWithLower(after) {
+		return true
+	}
+
+	word := before[strings.LastIndexFunc(before, isNotLetterOrNumber)+1:]
+	initial, size := utf8.DecodeRuneInString(word)
+	if size == 0 || size != len(word) || !unicode.IsUpper(initial) {
+		return false
+	}
+	// A Roman numeral after a name is a regnal number, not an initial: "Henry V.", "Malcolm X.".
+	return !isRomanNumeral(word) || !followsName(before[:len(before)-len(word)])
+}
+
+// isInitialism reports whether the word is an initialism with points after each letter ("N.A.S.A.", "U.S.").
+func isInitialism(word string) bool {
+	letters := 0
+	for len(word) > 0 {
+		char, size := utf8.DecodeRuneInString(word)
+		if !unicode.IsLetter(char) || !strings.HasPrefix(word[size:], ".") {
+			return false
+		}
+		word = word[size+1:]
+		letters++
+	}
+	return letters >= 2
+}
+
+// isRomanNumeral reports whether the word is a Roman numeral in upper case ("II", "XIV").
+func isRomanNumeral(word string) bool {
+	return len(word) > 0 && strings.Trim(word, "IVXLCDM") == ""
+}
+
+// followsName reports whether the string ends with a capitalized word.
+func followsName(s string) bool {
+	name, _ := utf8.DecodeRuneInString(lastField(s))
+	return unicode.IsUpper(name)
+}
+
+// startsWithLower reports whether the first word of the string, after the whitespaces and opening quotes or brackets, starts with a lower case letter.
+func startsWithLower(s string) bool {
+	s = strings.TrimLeftFunc(s, func(c rune) bool {
+		return unicode.IsSpace(c) || unicode.In(c, unicode.Ps, unicode.Pi) || c == '"' || c == '\''
+	})
+	next, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLower(next)
+}
+
+// isEllipsis reports whether the run of terminators is an ellipsis ("...", "…", "....").
+func isEllipsis(terminators string) bool {
+	return strings.Trim(terminators, ".…") == "" && (strings.Contains(terminators, "...") || strings.Contains(terminators, "…"))
+}
+
+// isOmission reports whether the ellipsis at the end of the text before it and the start of the run is an omission in brackets ("[...]", "(…)").
+func isOmission(before, run string) bool {
+	opening, _ := utf8.DecodeLastRuneInString(before)
+	closing := strings.TrimLeft(run, ".…")
+	return (opening == '[' && strings.HasPrefix(closing, "]")) || (opening == '(' && strings.HasPrefix(closing, ")"))
+}
+
+// isSentenceTerminator reports whether the rune ends a sentence.
+// The combined marks ("‼", "⁇", "⁈", "⁉", "‽") are terminators too, so they can be a part of a run as "?!" is,
+// as well as the terminators of other scripts: CJK full stops and marks ("。", "！", "？"), Devanagari danda ("।", "॥"), Arabic and Urdu ("؟", "۔"), Armenian ("։"), and Ethiopic ("።", "፧").
+func isSentenceTerminator(char rune) bool {
+	return strings.ContainsRune(".!?…‼⁇⁈⁉‽。！？｡।॥؟۔։።፧", char)
+}
+
+// isFullWidthTerminator reports whether the rune is a CJK terminator, which is not followed by a whitespace.
+func isFullWidthTerminator(char rune) bool {
+	return strings.ContainsRune("。！？｡", char)
+}
+
+// isClosingPunctuation reports whether the rune is a closing quote or bracket that can follow a sentence terminator.
+func isClosingPunctuation(char rune) bool {
+	return strings.ContainsRune("\"')]}”’»」』）】〉》", char)
+}
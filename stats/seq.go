@@ -0,0 +1,68 @@
+package stats
+
+import (
+	"iter"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Functions ======
+
+// WordsSeq accepts a string and options and returns an iterator over its words with their byte offsets. See `Words`.
+// With the default Tokenizer, the words are found while iterating, so no slice of words is allocated.
+// A configured Tokenizer (see `WithTokenizer`, `WithSplitHyphens`) returns a slice, so the words are split before the first one is yielded.
+func WordsSeq(text string, opts ...Option) iter.Seq[Token] {
+	cfg := NewConfig(opts...)
+	if !cfg.defaultTokenizer {
+		return func(yield func(Token) bool) {
+			for _, token := range tokenize(text, cfg.Tokenizer) {
+				if !yield(token) {
+					return
+				}
+			}
+		}
+	}
+
+	return func(yield func(Token) bool) {
+		start := -1
+		for i := 0; i <= len(text); {
+			char, size := utf8.DecodeRuneInString(text[i:])
+			if i < len(text) && !unicode.IsSpace(char) {
+				if start < 0 {
+					start = i
+				}
+				i += size
+				continue
+			}
+			if start >= 0 {
+				if token, ok := trimToken(text[start:i], start); ok && !yield(token) {
+					return
+				}
+				start = -1
+			}
+			if i == len(text) {
+				return
+			}
+			i += size
+		}
+	}
+}
+
+// SentencesSeq accepts a string and options and returns an iterator over its sentences with their byte offsets. See `Sentences`.
+// With the built-in segmenter, the sentences are found while iterating, so no slice of sentences is allocated.
+// A configured SentenceSegmenter (see `WithSentenceSegmenter`) returns a slice, so the sentences are split before the first one is yielded.
+func SentencesSeq(text string, opts ...Option) iter.Seq[Sentence] {
+	cfg := NewConfig(opts...)
+	return func(yield func(Sentence) bool) {
+		if cfg.SentenceSegmenter != nil {
+			for _, sentence := range cfg.SentenceSegmenter(text) {
+				if !yield(sentence) {
+					return
+				}
+			}
+			return
+		}
+		s := segmenter{text: text, cfg: cfg, yield: yield}
+		s.run()
+	}
+}
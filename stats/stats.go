@@ -3,21 +3,24 @@ package stats
 
 import (
 	"fmt"
-	"strings"
 	"unicode"
-	"unicode/utf8"
 )
 
 // ====== Types & Consts ======
 
 type TotalStats struct {
-	Symbols    uint
-	Characters uint
-	Words      uint
-	Sentences  uint
-	Syllables  uint
+	Symbols       uint `json:"symbols"`
+	Characters    uint `json:"characters"`
+	Letters       uint `json:"letters"`
+	Words         uint `json:"words"`
+	Sentences     uint `json:"sentences"`
+	Syllables     uint `json:"syllables"`
+	Polysyllables uint `json:"polysyllables"`
 }
 
+// PolysyllableThreshold is the minimal number of syllables for a word to be counted as a polysyllable (complex word).
+const PolysyllableThreshold = 3
+
 var abbreviations = map[string]int{
 	"u.s.": 2,
 
@@ -59,40 +62,204 @@ var abbreviations = map[string]int{
 func (stats TotalStats) Print() {
 	fmt.Println("Symbols:\t", stats.Symbols)
 	fmt.Println("Characters:\t", stats.Characters)
+	fmt.Println("Letters:\t", stats.Letters)
 	fmt.Println("Words:\t\t", stats.Words)
 	fmt.Println("Sentences:\t", stats.Sentences)
 	fmt.Println("Syllables:\t", stats.Syllables)
+	fmt.Println("Polysyllables:\t", stats.Polysyllables)
+}
+
+// AvgWordsPerSentence returns the average number of words per sentence, or 0 if there are no sentences. The average is not rounded.
+func (stats TotalStats) AvgWordsPerSentence() float64 {
+	return ratio(stats.Words, stats.Sentences)
+}
+
+// AvgSyllablesPerWord returns the average number of syllables per word, or 0 if there are no words. The average is not rounded.
+func (stats TotalStats) AvgSyllablesPerWord() float64 {
+	return ratio(stats.Syllables, stats.Words)
+}
+
+// AvgCharsPerWord returns the average number of characters (letters and digits) per word, or 0 if there are no words. The average is not rounded.
+func (stats TotalStats) AvgCharsPerWord() float64 {
+	return ratio(stats.Characters, stats.Words)
 }
 
 // ====== Functions ======
 
-// For debugging and testing purposes
-func CountAllStats(text string) TotalStats {
+// CountAllStats accepts a string and options and returns all its statistics at once, so the readability indices can share the same counts.
+// The letters of the string are composed first (see `WithComposition`), so decomposed accents are counted as the composed ones.
+// Syllables are counted per word, with the leading and trailing punctuation trimmed off the word.
+// With the default Tokenizer and sentence segmenter, all statistics are counted in a single pass over the string.
+func CountAllStats(text string, opts ...Option) TotalStats {
+	return countAllStats(text, NewConfig(opts...))
+}
+
+// countAllStats accepts a string and a config and returns all statistics of the string.
+// The symbols, characters, words, and sentences are counted in one pass over the runes of the string, unless a Tokenizer or a SentenceSegmenter is configured,
+// then their words or sentences are counted separately. The results are the same as of `CountSymbols`, `CountCharacters`, `CountLetters`, `CountWords`, and `CountSentences`.
+func countAllStats(text string, cfg Config) TotalStats {
+	return countPreparedStats(cfg.prepare(text), cfg)
+}
+
+// countPreparedStats accepts a prepared string (see `Config.prepare`) and a config and returns all statistics of the string, without preparing it again,
+// as the citations and the legal clauses are removed differently from a text they were already removed from.
+func countPreparedStats(text string, cfg Config) TotalStats {
 	var result TotalStats
-	result.Symbols = CountSymbols(text)
-	result.Characters = CountCharacters(text)
-	result.Words = CountWords(text)
-	result.Sentences = CountSentences(text)
-	words := strings.Fields(text)
-	result.Syllables = 0
-	for _, word := range words {
-		result.Syllables += CountSyllables(word)
+	customTokenizer := cfg.Tokenizer != nil && !cfg.defaultTokenizer
+	countWord := func(word string) {
+		words := cfg.countedWords(word)
+		result.Words += uint(len(words))
+		for _, word := range words {
+			if len(word) == 0 {
+				continue
+			}
+			syllables := cfg.countSyllables(word)
+			result.Syllables += syllables
+			if syllables >= PolysyllableThreshold {
+				result.Polysyllables++
+			}
+		}
+	}
+
+	seg := segmenter{text: text, cfg: cfg, yield: func(sentence Sentence) bool {
+		if sentence.Terminated {
+			result.Sentences++
+		}
+		return true
+	}}
+	var symbols symbolCounter
+	wordStart := -1
+	for i, char := range text {
+		symbols.step(char)
+		if unicode.IsLetter(char) || (cfg.DigitsAsLetters && unicode.IsDigit(char)) {
+			result.Letters++
+		}
+		if unicode.IsDigit(char) || unicode.IsLetter(char) {
+			result.Characters++
+		}
+
+		if !customTokenizer {
+			if unicode.IsSpace(char) {
+				if wordStart >= 0 {
+					countWord(text[wordStart:i])
+					wordStart = -1
+				}
+			} else if wordStart < 0 {
+				wordStart = i
+			}
+		}
+		if cfg.SentenceSegmenter == nil {
+			seg.step(char, i)
+		}
+	}
+	result.Symbols = symbols.total()
+
+	if customTokenizer {
+		for _, word := range cfg.Tokenizer(text) {
+			countWord(word)
+		}
+	} else if wordStart >= 0 {
+		countWord(text[wordStart:])
+	}
+	if cfg.SentenceSegmenter == nil {
+		seg.finish()
+	} else {
+		result.Sentences = countTerminated(splitSentences(text, cfg))
 	}
 	return result
 }
 
 // CountSymbols accepts a string and returns the number of symbols in it.
-// The string should not have trailing spaces before new lines.
-// Only new lines do not count as symbols.
-// An ellipsis ... counts as one symbol, an ellipsis in brackets [...] counts as three symbols. (?)
+// A symbol is a user-perceived character (an approximation of a grapheme cluster of Unicode Text Segmentation, UAX #29),
+// so a letter with combining accents ("e\u0301"), an emoji with a skin tone modifier ("👍🏽"), a ZWJ sequence ("👨‍👩‍👧"), and a flag ("🇮🇹") count as one symbol each.
+// Line breaks ("\n", "\r\n", "\r") do not count as symbols, and neither do the whitespaces at the end of a line or of the string,
+// so the text doesn't need to be cleaned of trailing spaces. Other whitespaces (spaces, tabs, non-breaking spaces) count as one symbol each.
+// An ellipsis counts as one symbol, whether it is written as three points "..." or as one character "…",
+// so an omission in brackets "[...]" counts as three symbols, the same as "[…]".
 func CountSymbols(s string) uint {
-	if len(s) == 0 {
-		return 0
+	var symbols symbolCounter
+	for _, char := range s {
+		symbols.step(char)
+	}
+	return symbols.total()
+}
+
+// symbolCounter counts the symbols of a text rune by rune (see `CountSymbols`).
+type symbolCounter struct {
+	symbols  uint
+	ellipses uint
+	// spaces is the number of whitespaces since the last symbol, which are counted only if a symbol follows them on the same line.
+	spaces uint
+	// points is the number of consecutive points, every three of which are an ellipsis.
+	points uint
+	// prev is the previous rune, or 0 if the previous rune doesn't start or extend a symbol (a whitespace, a line break).
+	prev rune
+	// flag is true if the last symbol is a single regional indicator, so the next one completes the flag.
+	flag bool
+}
+
+// step counts the rune.
+func (c *symbolCounter) step(char rune) {
+	if char == '.' {
+		c.points++
+	} else {
+		c.ellipses += c.points / 3
+		c.points = 0
+	}
+	switch {
+	case isLineBreak(char):
+		c.spaces = 0
+		c.prev = 0
+		return
+	case unicode.IsSpace(char):
+		c.spaces++
+		c.prev = 0
+		return
+	case c.prev != 0 && c.extendsSymbol(char):
+		// A part of the previous symbol.
+	default:
+		c.symbols += c.spaces + 1
+		c.spaces = 0
+		c.flag = isRegionalIndicator(char)
 	}
-	ellipsis := strings.Count(s, "...")
-	newLines := strings.Count(s, "\n")
-	total := utf8.RuneCountInString(s) - newLines - 2*ellipsis
-	return uint(total)
+	c.prev = char
+}
+
+// extendsSymbol reports whether the rune is a part of the symbol the previous rune belongs to (GB9, GB9a, GB11, GB12, GB13, and Hangul syllables, approximately).
+func (c *symbolCounter) extendsSymbol(char rune) bool {
+	switch {
+	case unicode.In(char, unicode.Mn, unicode.Me, unicode.Mc), char == '\u200d', isEmojiModifier(char), char >= 0xE0020 && char <= 0xE007F:
+		// Combining marks, variation selectors, the zero width joiner, skin tone modifiers, and the tags of subdivision flags.
+		return true
+	case c.prev == '\u200d':
+		// An emoji joined by the zero width joiner ("👨‍👩‍👧").
+		return unicode.Is(unicode.So, char) || (char >= 0x1F000 && char <= 0x1FAFF)
+	case isRegionalIndicator(char) && c.flag:
+		c.flag = false
+		return true
+	}
+	// Conjoining Hangul vowels and final consonants.
+	return char >= 0x1160 && char <= 0x11FF
+}
+
+// total returns the number of symbols counted so far.
+func (c *symbolCounter) total() uint {
+	return c.symbols - 2*(c.ellipses+c.points/3)
+}
+
+// isRegionalIndicator reports whether the rune is a regional indicator symbol, two of which make a flag ("🇮🇹").
+func isRegionalIndicator(char rune) bool {
+	return char >= 0x1F1E6 && char <= 0x1F1FF
+}
+
+// isEmojiModifier reports whether the rune is a skin tone modifier of an emoji ("🏽").
+func isEmojiModifier(char rune) bool {
+	return char >= 0x1F3FB && char <= 0x1F3FF
+}
+
+// isLineBreak reports whether the rune breaks a line ("\n", "\r", the next line, line, and paragraph separators).
+func isLineBreak(char rune) bool {
+	return char == '\n' || char == '\r' || char == '\u0085' || char == '\u2028' || char == '\u2029'
 }
 
 // CountCharacters accepts a string and returns the number of characters.
@@ -110,98 +277,61 @@ func CountCharacters(s string) uint {
 	return uint(chars)
 }
 
-// CountWords accepts a string and returns the number of words in it.
-// The string should not have trailing spaces before new lines (e.g. "Word. \nAnother word." isn't counted correctly), nor double newlines (e.g. "Word.\n\nAnother word.")
-// Numbers count as a word (for example, "44." returns `1`, and "12 and 43." returns `3`).
-// Contractions ("I'm", "you'll", "don't") and possessives ("John's") are counted as one word.
-// TODO: case with multiple sequential new lines. ("One.\n\nTwo." => must return `2`).
-// TODO: En Dash in dates ("1845-1851" should be 2 words(?))
-func CountWords(s string) uint {
-	if len(s) == 0 {
-		return 0
-	}
-	if strings.Count(s, "\n") > 0 {
-		s = strings.ReplaceAll(s, "\n", " ")
+// CountLetters accepts a string and options and returns the number of letters in it.
+// Unlike `CountCharacters`, the digits are not counted, unless they are counted as letters (see `WithDigitsAsLetters`).
+func CountLetters(s string, opts ...Option) uint {
+	cfg := NewConfig(opts...)
+	var letters uint
+	for _, char := range cfg.prepare(s) {
+		if unicode.IsLetter(char) || (cfg.DigitsAsLetters && unicode.IsDigit(char)) {
+			letters++
+		}
 	}
-	words := len(strings.Fields(s))
-	return uint(words)
+	return letters
 }
 
-// CountSentences accepts a string and returns the number of sentences in it.
-// TODO: cases "?!", "???", "!!!", "...", "!?" must count as one sentence.
-// TODO: case when point is used in abbreviation ("U.S.", "Mr.", "Jr.", "Dec. 9, 1991", see abbreviations above).
-// TODO: ellipsis as an omission ("The witnesses reported that the suspect fled the scene ... and headed west toward the highway.")
-// TODO: cases with dots in fractions ("10.5 pbs." should return `1`.)
-// TODO: general case when there is no space after the finishing point. Should not count as a sentence.
-func CountSentences(s string) uint {
+// CountWords accepts a string and options and returns the number of words in it. The words are split by the configured Tokenizer (see `WithTokenizer`), by whitespaces by default.
+// With the default Tokenizer, any whitespaces separate words: spaces, tabs, non-breaking spaces, and line breaks ("\r\n", "\n"), including several of them in a row.
+// Numbers count as a word (for example, "44." returns `1`, and "12 and 43." returns `3`).
+// Contractions ("I'm", "you'll", "don't", "don’t") and possessives ("John's") are counted as one word, unless the contractions are expanded (see `WithExpandedContractions`).
+// Hyphenated compounds ("well-known"), ranges ("1845–1851"), and clauses joined by an em dash ("waited—nothing") count as one word,
+// unless the words are split at them (see `WithSplitHyphens`, `WithSplitEnDashes`, `WithSplitEmDashes`).
+func CountWords(s string, opts ...Option) uint {
 	if len(s) == 0 {
 		return 0
 	}
-
-	points := strings.Count(s, ".")
-	exclamations := strings.Count(s, "!")
-	questions := strings.Count(s, "?")
-	ellipsis := strings.Count(s, "...")
-	pointsInAbbreviations := 0
-	for abbreviation, points := range abbreviations {
-		if count := strings.Count(s, abbreviation); count > 0 {
-			pointsInAbbreviations += count * points
-		}
-	}
-
-	return uint(points + exclamations + questions - pointsInAbbreviations - 2*ellipsis)
+	cfg := NewConfig(opts...)
+	return cfg.countWords(cfg.Tokenizer(cfg.prepare(s)))
 }
 
-// CountSyllables accepts a string that represents an English word and returns the number of syllables in it.
-// The string must contain letters only (can contain digits).
-func CountSyllables(s string) uint {
-	if len(s) < 4 {
-		return 1
-	}
-	syllables := 0
-	prev_is_vowel := false
-
-	lower_case := strings.ToLower(s)
-
-	for _, char := range lower_case {
-		if isVowel(char) {
-			if prev_is_vowel == false {
-				syllables++
-			}
-			prev_is_vowel = true
-		} else {
-			prev_is_vowel = false
-		}
-	}
+// CountSentences accepts a string and options and returns the number of sentences in it. The sentences are split by a segmenter (see `Sentences`),
+// so clustered punctuation ("?!", "!!!"), ellipses, points in numbers ("10.5 lbs."), and points in the configured abbreviations (see `WithAbbreviations`, `WithLanguage`) are handled.
+// Only the sentences that end with a terminator are counted, so the text after the last terminator is not a sentence (for example, "18" returns `0`, and "18." returns `1`).
+func CountSentences(s string, opts ...Option) uint {
+	return countSentences(s, NewConfig(opts...))
+}
 
-	if s[len(lower_case)-1] == 'e' {
-		syllables--
-	}
+// countSentences accepts a string and a config and returns the number of terminated sentences in the string.
+func countSentences(s string, cfg Config) uint {
+	// The points of the citations ("U.S.") and the placeholders ("{{.Name}}", "%.2f") do not end sentences.
+	return countTerminated(splitSentences(cfg.rewrite(s), cfg))
+}
 
-	if s[len(lower_case)-2:] == "le" || s[len(lower_case)-3:] == "les" {
-		if !isVowel(rune(s[len(lower_case)-3])) {
-			syllables++
-		}
-	} else if s[len(lower_case)-3:] == "ed" {
-		if s[len(lower_case)-3] == 't' {
-			syllables++
-		} else if isVowel(rune(s[len(lower_case)-3])) {
-			syllables--
-		}
-	} else if s[len(lower_case)-2:] == "es" {
-		if !isVowel(rune(s[len(lower_case)-3])) && (s[len(lower_case)-3] != 'w' || s[len(lower_case)-3] != 'x' || s[len(lower_case)-3] != 'y') {
-			syllables++
+// countTerminated returns the number of the sentences that end with a terminator.
+func countTerminated(sentences []Sentence) uint {
+	var count uint
+	for _, sentence := range sentences {
+		if sentence.Terminated {
+			count++
 		}
 	}
-
-	if syllables == 0 {
-		syllables++
-	}
-
-	return uint(syllables)
+	return count
 }
 
-func isVowel(char rune) bool {
-	vowels := "aeiouy"
-	return strings.ContainsRune(vowels, char)
+// ratio returns the numerator divided by the denominator, or 0 if the denominator is 0.
+func ratio(numerator, denominator uint) float64 {
+	if denominator == 0 {
+		return 0
+	}
+	return float64(numerator) / float64(denominator)
 }
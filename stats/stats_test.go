@@ -1 +1,1351 @@
 package stats_test
+
+import (
+	"goreadability/stats"
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+func TestCountSentencesNumbers(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"It weighs 10.5 lbs. It is heavy.", 2},
+		{"Pi is 3.14 or so.", 1},
+		{"Install version 1.2.3 first. Then run it.", 2},
+		{"See section 3.2.1.2.", 1},
+		{"The server is at 192.168.0.1 now.", 1},
+		{"It costs .5 dollars.", 1},
+		{"It was 1999. 2000 was next.", 2},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSentences(tt.text); got != tt.want {
+			t.Errorf("CountSentences(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCountSentencesEllipsis(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"The suspect fled the scene ... and headed west.", 1},
+		{"The suspect fled the scene … and headed west.", 1},
+		{"He said [...] that it was over.", 1},
+		{"He said (…) that it was over.", 1},
+		{"I wonder... Maybe not.", 2},
+		{"And then…", 1},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSentences(tt.text); got != tt.want {
+			t.Errorf("CountSentences(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCountSymbolsEllipsis(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"Wait...", 5},
+		{"Wait…", 5},
+		{"[...]", 3},
+		{"[…]", 3},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSymbols(tt.text); got != tt.want {
+			t.Errorf("CountSymbols(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCountSentencesClusteredPunctuation(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"Really?! Yes!!! What??? No!?", 4},
+		{"You did what?!.. I see.", 2},
+		{"Stop!\"! Now.", 2},
+		{"What‽ No‼", 2},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSentences(tt.text); got != tt.want {
+			t.Errorf("CountSentences(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCountSentencesUnicodeTerminators(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"¿Qué tal? ¡Muy bien!", 2},
+		{"今日は晴れです。明日は雨ですか？はい！", 3},
+		{"नमस्ते। आप कैसे हैं?", 2},
+		{"كيف حالك؟ أنا بخير.", 2},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSentences(tt.text); got != tt.want {
+			t.Errorf("CountSentences(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCountSentencesQuotes(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"He said, 'Stop!' and left.", 1},
+		{"\"Stop!\" he shouted.", 1},
+		{"“Are you coming?” she asked. “No.”", 2},
+		{"He said \"Stop!\" Then he left.", 2},
+		{"It was over (or so it seemed.) and we left.", 1},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSentences(tt.text); got != tt.want {
+			t.Errorf("CountSentences(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCountSentencesURLs(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"See https://example.com/docs. It has examples.", 2},
+		{"Open https://example.com/search?q=go!x for results.", 1},
+		{"Write to user@example.com. We answer fast.", 2},
+		{"Edit ~/.bashrc and ./config.yaml first.", 1},
+		{"Run cd .. and list the files.", 1},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSentences(tt.text); got != tt.want {
+			t.Errorf("CountSentences(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestUAX29WordsURLs(t *testing.T) {
+	got := stats.UAX29Words("See https://example.com/docs, user@example.com, and (stats/stats.go).")
+	want := []string{"See", "https://example.com/docs", "user@example.com", "and", "stats/stats.go"}
+	if len(got) != len(want) {
+		t.Fatalf("UAX29Words() = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("UAX29Words()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestUAX29Words(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"state-of-the-art design", []string{"state", "of", "the", "art", "design"}},
+		{"don't stop, can’t stop", []string{"don't", "stop", "can’t", "stop"}},
+		{"3.14 and 1,000", []string{"3.14", "and", "1,000"}},
+		{"a\u00a0b", []string{"a", "b"}},
+		{"日本語テキスト", []string{"日", "本", "語", "テキスト"}},
+		{"e.g. U.S.A.", []string{"e.g", "U.S.A"}},
+		{"👍🏽 ok — fine!", []string{"ok", "fine"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		got := stats.UAX29Words(tt.text)
+		if len(got) != len(tt.want) {
+			t.Errorf("UAX29Words(%q) = %q, want %q", tt.text, got, tt.want)
+			continue
+		}
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Errorf("UAX29Words(%q) = %q, want %q", tt.text, got, tt.want)
+				break
+			}
+		}
+	}
+
+	counts := map[string]uint{
+		"state-of-the-art design": 5,
+		"a\u00a0b c":              3,
+		"日本語テキスト":                 4,
+	}
+	for text, want := range counts {
+		if got := stats.CountWords(text, stats.WithUAX29Words()); got != want {
+			t.Errorf("CountWords(%q, WithUAX29Words()) = %d, want %d", text, got, want)
+		}
+	}
+}
+
+func TestUAX29Sentences(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"¿Qué? ¡Sí! Bien.", []string{"¿Qué?", "¡Sí!", "Bien."}},
+		{"你好。再见！", []string{"你好。", "再见！"}},
+		{"Mr. Smith left.", []string{"Mr.", "Smith left."}},
+		{"It was etc. and more. Next.", []string{"It was etc. and more.", "Next."}},
+		{"He said \"Hi.\" Then left.", []string{"He said \"Hi.\"", "Then left."}},
+		{"3.14 is pi. Yes", []string{"3.14 is pi.", "Yes"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		got := stats.UAX29Sentences(tt.text)
+		if len(got) != len(tt.want) {
+			t.Errorf("UAX29Sentences(%q) = %+v, want %q", tt.text, got, tt.want)
+			continue
+		}
+		var terminated uint
+		for i, sentence := range got {
+			if sentence.Text != tt.want[i] || tt.text[sentence.Start:sentence.End] != sentence.Text {
+				t.Errorf("UAX29Sentences(%q)[%d] = %+v, want %q", tt.text, i, sentence, tt.want[i])
+			}
+			if sentence.Terminated {
+				terminated++
+			}
+		}
+		// The text after the last terminator is a sentence, but it is not counted.
+		if count := stats.CountSentences(tt.text, stats.WithUAX29Sentences()); count != terminated {
+			t.Errorf("CountSentences(%q, WithUAX29Sentences()) = %d, want %d", tt.text, count, terminated)
+		}
+	}
+}
+
+func TestCountSentencesRomanNumeralsAndInitialisms(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+		opts []stats.Option
+	}{
+		{"It ended after World War II. Then peace came.", 2, nil},
+		{"The king was Henry V. He ruled England.", 2, []stats.Option{stats.WithSmartAbbreviations()}},
+		{"J. R. R. Tolkien wrote it.", 1, []stats.Option{stats.WithSmartAbbreviations()}},
+		{"The N.A.S.A. launched a rocket.", 1, nil},
+		{"It was built by N.A.S.A. Then it flew.", 2, nil},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSentences(tt.text, tt.opts...); got != tt.want {
+			t.Errorf("CountSentences(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCountWordsDashes(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+		opts []stats.Option
+	}{
+		{"A well-known fact.", 3, nil},
+		{"A well-known fact.", 4, []stats.Option{stats.WithSplitHyphens()}},
+		{"He lived 1845–1851.", 3, nil},
+		{"He lived 1845–1851.", 4, []stats.Option{stats.WithSplitEnDashes()}},
+		{"He lived 1845–1851.", 3, []stats.Option{stats.WithSplitHyphens()}},
+		{"She waited—nothing happened.", 3, nil},
+		{"She waited—nothing happened.", 4, []stats.Option{stats.WithSplitEmDashes()}},
+		{"She waited — nothing happened.", 5, []stats.Option{stats.WithSplitEmDashes()}},
+		{"See https://my-site.com now.", 3, []stats.Option{stats.WithSplitHyphens()}},
+	}
+	for _, tt := range tests {
+		if got := stats.CountWords(tt.text, tt.opts...); got != tt.want {
+			t.Errorf("CountWords(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+		if got := stats.CountAllStats(tt.text, tt.opts...).Words; got != tt.want {
+			t.Errorf("CountAllStats(%q).Words = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestContractions(t *testing.T) {
+	tests := []struct {
+		text      string
+		words     uint
+		syllables uint
+		opts      []stats.Option
+	}{
+		{"I don't know.", 3, 3, nil},
+		{"I don’t know.", 3, 3, nil},
+		{"I don’t know.", 4, 4, []stats.Option{stats.WithExpandedContractions()}},
+		{"We won't go, it's late.", 7, 7, []stats.Option{stats.WithExpandedContractions()}},
+		{"John's car is here.", 4, 4, []stats.Option{stats.WithExpandedContractions()}},
+	}
+	for _, tt := range tests {
+		if got := stats.CountWords(tt.text, tt.opts...); got != tt.words {
+			t.Errorf("CountWords(%q) = %d, want %d", tt.text, got, tt.words)
+		}
+		got := stats.CountAllStats(tt.text, tt.opts...)
+		if got.Words != tt.words || got.Syllables != tt.syllables {
+			t.Errorf("CountAllStats(%q) = %d words, %d syllables, want %d, %d", tt.text, got.Words, got.Syllables, tt.words, tt.syllables)
+		}
+	}
+}
+
+func TestCountSymbolsWhitespace(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"One two.\nThree.", 14},
+		{"One two.\r\nThree.", 14},
+		{"One two. \nThree.  ", 14},
+		{"One\ttwo.\nThree.", 14},
+		{"One two.\nThree.", 14},
+		{"   \n", 0},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSymbols(tt.text); got != tt.want {
+			t.Errorf("CountSymbols(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+		if got := stats.CountAllStats(tt.text).Symbols; got != tt.want {
+			t.Errorf("CountAllStats(%q).Symbols = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCountWordsWhitespace(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"Word. \nAnother word.", 3},
+		{"Word.\r\nAnother\tword.", 3},
+		{"Word. Another word.", 3},
+	}
+	for _, tt := range tests {
+		if got := stats.CountWords(tt.text); got != tt.want {
+			t.Errorf("CountWords(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParagraphBreaks(t *testing.T) {
+	tests := []struct {
+		text       string
+		words      uint
+		sentences  uint
+		paragraphs int
+	}{
+		{"One.\n\nTwo.", 2, 2, 2},
+		{"One.\r\n\r\nTwo.", 2, 2, 2},
+		{"One.\n \n\n\nTwo.", 2, 2, 2},
+		{"One.\nTwo.", 2, 2, 1},
+		{"Introduction\n\nThe text starts here.", 5, 1, 2},
+		{"It was Mr.\n\nSmith left.", 5, 2, 2},
+	}
+	for _, tt := range tests {
+		if got := stats.CountWords(tt.text); got != tt.words {
+			t.Errorf("CountWords(%q) = %d, want %d", tt.text, got, tt.words)
+		}
+		if got := stats.CountSentences(tt.text); got != tt.sentences {
+			t.Errorf("CountSentences(%q) = %d, want %d", tt.text, got, tt.sentences)
+		}
+		if got := stats.CountAllStats(tt.text).Sentences; got != tt.sentences {
+			t.Errorf("CountAllStats(%q).Sentences = %d, want %d", tt.text, got, tt.sentences)
+		}
+		if got := len(stats.Paragraphs(tt.text)); got != tt.paragraphs {
+			t.Errorf("len(Paragraphs(%q)) = %d, want %d", tt.text, got, tt.paragraphs)
+		}
+	}
+}
+
+func TestCountSymbolsGraphemeClusters(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+	}{
+		{"café", 4},
+		{"café", 4},
+		{"👍🏽", 1},
+		{"👨‍👩‍👧", 1},
+		{"🇮🇹🇫🇷", 2},
+		{"❤️ ok", 4},
+		{"각", 1},
+		{"नमस्ते", 4},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSymbols(tt.text); got != tt.want {
+			t.Errorf("CountSymbols(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+		if got := stats.CountAllStats(tt.text).Symbols; got != tt.want {
+			t.Errorf("CountAllStats(%q).Symbols = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	tests := []struct {
+		text        string
+		composition stats.Composition
+		want        string
+	}{
+		{"cafe\u0301", stats.ComposeMarks, "café"},
+		{"e\u0323\u0302", stats.ComposeMarks, "ệ"},
+		{"\u1100\u1161\u11a8", stats.ComposeMarks, "각"},
+		{"ﬁnd", stats.ComposeMarks, "ﬁnd"},
+		{"ﬁnd", stats.ComposeMarksAndFold, "find"},
+		{"ＡＢＣ１２３", stats.ComposeMarksAndFold, "ABC123"},
+		{"ありがとう？", stats.ComposeMarksAndFold, "ありがとう？"},
+		{"cafe\u0301", stats.NoComposition, "cafe\u0301"},
+		// The limits of Compose, where NFC and NFKC differ.
+		{"e\u0302\u0323", stats.ComposeMarks, "ê\u0323"},
+		{"\u212b", stats.ComposeMarks, "\u212b"},
+		{"\u0915\u093c", stats.ComposeMarks, "\u0915\u093c"},
+		{"①㎏x²", stats.ComposeMarksAndFold, "①㎏x²"},
+	}
+	for _, tt := range tests {
+		if got := stats.Compose(tt.text, tt.composition); got != tt.want {
+			t.Errorf("Compose(%q, %d) = %q, want %q", tt.text, tt.composition, got, tt.want)
+		}
+	}
+}
+
+func FuzzCompose(f *testing.F) {
+	f.Add("cafe\u0301 e\u0323\u0302 \u1100\u1161\u11a8")
+	f.Add("ﬁnd ＡＢＣ１２３ Ⅻ… ありがとう？")
+	f.Add("\u11a8\u1161e\u0302\u0323")
+	f.Fuzz(func(t *testing.T, text string) {
+		if got := stats.Compose(text, stats.NoComposition); got != text {
+			t.Errorf("Compose(%q, NoComposition) = %q", text, got)
+		}
+		for _, composition := range []stats.Composition{stats.ComposeMarks, stats.ComposeMarksAndFold} {
+			composed := stats.Compose(text, composition)
+			if utf8.ValidString(text) && !utf8.ValidString(composed) {
+				t.Errorf("Compose(%q, %d) = %q, which is not valid UTF-8", text, composition, composed)
+			}
+			if again := stats.Compose(composed, composition); again != composed {
+				t.Errorf("Compose(%q, %d) = %q, but composing it again is %q", text, composition, composed, again)
+			}
+		}
+	})
+}
+
+func TestCountAllStatsComposition(t *testing.T) {
+	composed := stats.CountAllStats("Le café est fermé.")
+	decomposed := stats.CountAllStats("Le cafe\u0301 est ferme\u0301.")
+	if composed != decomposed {
+		t.Errorf("CountAllStats of a decomposed text = %+v, want %+v", decomposed, composed)
+	}
+	if got := stats.CountAllStats("ﬁnd it.", stats.WithComposition(stats.ComposeMarksAndFold)).Characters; got != 6 {
+		t.Errorf("CountAllStats(%q).Characters = %d, want %d", "ﬁnd it.", got, 6)
+	}
+}
+
+func TestCountSyllables(t *testing.T) {
+	tests := []struct {
+		word string
+		want uint
+	}{
+		{"", 0},
+		{"a", 1},
+		{"the", 1},
+		{"cat", 1},
+		{"hmm", 1},
+		{"make", 1},
+		{"free", 1},
+		{"agree", 2},
+		{"table", 2},
+		{"whole", 1},
+		{"tables", 2},
+		{"makes", 1},
+		{"boxes", 2},
+		{"wishes", 2},
+		{"places", 2},
+		{"jumped", 1},
+		{"wanted", 2},
+		{"played", 1},
+		{"yellow", 2},
+		{"happy", 2},
+		{"media", 3},
+		{"radio", 3},
+		{"nation", 2},
+		{"special", 2},
+		{"actual", 3},
+		{"equal", 2},
+		{"criticism", 4},
+		{"people", 2},
+		{"beautiful", 3},
+		{"readability", 5},
+		{"café", 2},
+		{"naïve", 2},
+		{"don't", 1},
+		{"Education", 4},
+		{"ÉCOLE", 2},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSyllables(tt.word); got != tt.want {
+			t.Errorf("CountSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestPronouncingDictionary(t *testing.T) {
+	dictionary := `;;; A part of the CMU Pronouncing Dictionary.
+DON'T  D OW1 N T
+FIRE  F AY1 ER0
+FIRE(2)  F AY1 R
+READABILITY  R IY2 D AH0 B IH1 L IH0 T IY0
+`
+	d, err := stats.LoadPronouncingDictionary(strings.NewReader(dictionary))
+	if err != nil {
+		t.Fatalf("LoadPronouncingDictionary() error = %v", err)
+	}
+	tests := []struct {
+		word string
+		want uint
+	}{
+		{"readability", 5},
+		{"Fire.", 2},
+		{"don’t", 1},
+		{"table", 2},
+	}
+	for _, tt := range tests {
+		if got := d.CountSyllables(tt.word); got != tt.want {
+			t.Errorf("CountSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+	if got := stats.CountAllStats("Fire!", stats.WithSyllableCounter(d)).Syllables; got != 2 {
+		t.Errorf("CountAllStats(%q).Syllables = %d, want %d", "Fire!", got, 2)
+	}
+
+	if _, err := stats.LoadPronouncingDictionary(strings.NewReader("FIRE\n")); err == nil {
+		t.Errorf("LoadPronouncingDictionary(%q) error = nil, want an error", "FIRE\n")
+	}
+}
+
+func TestHyphenator(t *testing.T) {
+	// The patterns of the "hyphenation" example of Liang's thesis.
+	patterns := `% Example patterns.
+\patterns{
+hy3ph he2n hena4 hen5at 1na n2at 1tio 2io o2n
+}
+\hyphenation{ta-ble}
+`
+	h, err := stats.LoadHyphenationPatterns(strings.NewReader(patterns))
+	if err != nil {
+		t.Fatalf("LoadHyphenationPatterns() error = %v", err)
+	}
+	if got := strings.Join(h.Hyphenate("Hyphenation"), "-"); got != "hy-phen-ation" {
+		t.Errorf("Hyphenate(%q) = %q, want %q", "Hyphenation", got, "hy-phen-ation")
+	}
+	tests := []struct {
+		word string
+		want uint
+	}{
+		{"hyphenation.", 3},
+		{"table", 2},
+		{"cat", 1},
+		{"42", 1},
+	}
+	for _, tt := range tests {
+		if got := h.CountSyllables(tt.word); got != tt.want {
+			t.Errorf("CountSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+
+	if _, err := stats.NewHyphenator([]string{"12"}, nil); err == nil {
+		t.Errorf("NewHyphenator(%q) error = nil, want an error", "12")
+	}
+}
+
+func TestRegisterSyllableCounter(t *testing.T) {
+	stats.RegisterSyllableCounter("xx", stats.SyllableCounterFunc(func(word string) uint { return 7 }))
+	if got := stats.CountAllStats("Word.", stats.WithLanguage("xx")).Syllables; got != 7 {
+		t.Errorf("CountAllStats(%q).Syllables = %d, want %d", "Word.", got, 7)
+	}
+	if got := stats.CountAllStats("Word.", stats.WithLanguage("xx"), stats.WithSyllableCounter(stats.SyllableCounterFunc(stats.CountSyllables))).Syllables; got != 1 {
+		t.Errorf("CountAllStats(%q).Syllables = %d, want %d", "Word.", got, 1)
+	}
+}
+
+func TestCountSyllablesNumbers(t *testing.T) {
+	tests := []struct {
+		word string
+		want uint
+	}{
+		{"42", 3},
+		{"7", 2},
+		{"100", 3},
+		{"3rd", 1},
+		{"21st", 3},
+		{"COVID-19", 4},
+		{"$5", 3},
+		{"$1", 3},
+		{"50%", 4},
+		{"3.14", 4},
+		{"1,000", 3},
+		{"1845", 5},
+		{"2024", 6},
+		{"$1.5M", 7},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSyllables(tt.word); got != tt.want {
+			t.Errorf("CountSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+
+	if got := stats.CountAllStats("It costs $5.").Syllables; got != 5 {
+		t.Errorf("CountAllStats(%q).Syllables = %d, want %d", "It costs $5.", got, 5)
+	}
+	if got := stats.CountAllStats("It costs $5.", stats.WithNumberPolicy(stats.NumbersAsOneSyllable)).Syllables; got != 3 {
+		t.Errorf("CountAllStats(%q).Syllables = %d, want %d", "It costs $5.", got, 3)
+	}
+}
+
+func TestSpokenNumbers(t *testing.T) {
+	tests := []struct {
+		text      string
+		words     uint
+		syllables uint
+	}{
+		{"It raised $1.5M.", 7, 9},
+		{"Prices rose 50% in 2024.", 9, 14},
+		{"She came 3rd.", 3, 3},
+	}
+	for _, tt := range tests {
+		if got := stats.CountWords(tt.text, stats.WithSpokenNumbers()); got != tt.words {
+			t.Errorf("CountWords(%q) = %d, want %d", tt.text, got, tt.words)
+		}
+		got := stats.CountAllStats(tt.text, stats.WithSpokenNumbers())
+		if got.Words != tt.words || got.Syllables != tt.syllables {
+			t.Errorf("CountAllStats(%q) = %d words, %d syllables, want %d, %d", tt.text, got.Words, got.Syllables, tt.words, tt.syllables)
+		}
+	}
+}
+
+func TestSyllableWordCounters(t *testing.T) {
+	text := "The readability of a document is important."
+	if got := stats.CountPolysyllabicWords(text, 3); got != 3 {
+		t.Errorf("CountPolysyllabicWords(%q, 3) = %d, want %d", text, got, 3)
+	}
+	if got := stats.PolysyllabicWords(text, 4); len(got) != 1 || got[0].Text != "readability" {
+		t.Errorf("PolysyllabicWords(%q, 4) = %v, want [readability]", text, got)
+	}
+	if got := stats.CountMonosyllabicWords(text); got != 4 {
+		t.Errorf("CountMonosyllabicWords(%q) = %d, want %d", text, got, 4)
+	}
+}
+
+func TestCountLongWords(t *testing.T) {
+	text := "Readability (formulas) don't measure comprehension, 1234567."
+	if got := stats.CountLongWords(text, 7); got != 4 {
+		t.Errorf("CountLongWords(%q, 7) = %d, want %d", text, got, 4)
+	}
+	if got := stats.CountLongWords(text, 4); got != 5 {
+		t.Errorf("CountLongWords(%q, 4) = %d, want %d", text, got, 5)
+	}
+}
+
+func TestCountLetters(t *testing.T) {
+	text := "In 2024, 3 cats slept."
+	if got := stats.CountLetters(text); got != 11 {
+		t.Errorf("CountLetters(%q) = %d, want %d", text, got, 11)
+	}
+	if got := stats.CountLetters(text, stats.WithDigitsAsLetters()); got != 16 {
+		t.Errorf("CountLetters(%q) with digits = %d, want %d", text, got, 16)
+	}
+	if got := stats.CountAllStats(text); got.Letters != 11 || got.Characters != 16 {
+		t.Errorf("CountAllStats(%q) = %d letters, %d characters, want %d, %d", text, got.Letters, got.Characters, 11, 16)
+	}
+}
+
+func TestLexicalDiversity(t *testing.T) {
+	text := "The cat saw the cats. The Cat ran."
+	if got := stats.CountUniqueWords(text); got != 5 {
+		t.Errorf("CountUniqueWords(%q) = %d, want %d", text, got, 5)
+	}
+	lemmatizer := stats.WithLemmatizer(func(word string) string { return strings.TrimSuffix(word, "s") })
+	if got := stats.CountUniqueWords(text, lemmatizer); got != 4 {
+		t.Errorf("CountUniqueWords(%q) with a lemmatizer = %d, want %d", text, got, 4)
+	}
+	if got, err := stats.CalcTTR(text); err != nil || got != 0.63 {
+		t.Errorf("CalcTTR(%q) = %v, %v, want %v", text, got, err, 0.63)
+	}
+	// The windows of 4 words: "the cat saw the" (3), "cat saw the cats" (4), "saw the cats the" (3), "the cats the cat" (3), "cats the cat ran" (4).
+	if got, err := stats.CalcMATTR(text, 4); err != nil || got != 0.85 {
+		t.Errorf("CalcMATTR(%q, 4) = %v, %v, want %v", text, got, err, 0.85)
+	}
+	if _, err := stats.CalcTTR("..."); err == nil {
+		t.Errorf("CalcTTR(%q) error = nil, want an error", "...")
+	}
+}
+
+func TestWordFrequencies(t *testing.T) {
+	text := "The cat and the dog. The Cat ran!"
+	if got := stats.WordFrequencies(text); got["the"] != 3 || got["cat"] != 2 || got["dog"] != 1 {
+		t.Errorf("WordFrequencies(%q) = %v", text, got)
+	}
+
+	isStopWord := func(word string) bool { return word == "the" || word == "and" }
+	got := stats.TopWords(text, 2, stats.WithStopWords(isStopWord))
+	want := []stats.WordFrequency{{"cat", 2}, {"dog", 1}}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("TopWords(%q, 2) = %v, want %v", text, got, want)
+	}
+}
+
+func TestAverages(t *testing.T) {
+	doc := stats.NewDocument("The cat sat. It was a happy cat.")
+	if got := doc.AvgWordsPerSentence(); got != 4 {
+		t.Errorf("AvgWordsPerSentence() = %v, want %v", got, 4)
+	}
+	if got := doc.AvgSyllablesPerWord(); got != 9.0/8 {
+		t.Errorf("AvgSyllablesPerWord() = %v, want %v", got, 9.0/8)
+	}
+	if got := doc.AvgCharsPerWord(); got != 23.0/8 {
+		t.Errorf("AvgCharsPerWord() = %v, want %v", got, 23.0/8)
+	}
+	if got := (stats.TotalStats{}).AvgWordsPerSentence(); got != 0 {
+		t.Errorf("TotalStats{}.AvgWordsPerSentence() = %v, want 0", got)
+	}
+}
+
+func TestAnalyzeParagraphs(t *testing.T) {
+	text := "Title\n\nOne sentence. Two sentences.\nStill the same paragraph.\n\n\nLast one."
+	if got := stats.CountParagraphs(text); got != 3 {
+		t.Errorf("CountParagraphs(%q) = %d, want %d", text, got, 3)
+	}
+	if got := stats.CountParagraphs(text, stats.WithLineParagraphs()); got != 4 {
+		t.Errorf("CountParagraphs(%q) by lines = %d, want %d", text, got, 4)
+	}
+
+	want := []struct{ words, sentences uint }{{1, 0}, {8, 3}, {2, 1}}
+	got := stats.AnalyzeParagraphs(text)
+	if len(got) != len(want) {
+		t.Fatalf("len(AnalyzeParagraphs(%q)) = %d, want %d", text, len(got), len(want))
+	}
+	for i, p := range got {
+		if p.Words != want[i].words || p.Sentences != want[i].sentences {
+			t.Errorf("AnalyzeParagraphs(%q)[%d] = %d words, %d sentences, want %d, %d", text, i, p.Words, p.Sentences, want[i].words, want[i].sentences)
+		}
+	}
+}
+
+func TestProfileVocabulary(t *testing.T) {
+	list, err := stats.LoadFrequencyList(strings.NewReader("# word count\ncat 50\nthe 900\nsat 20\non 700\nmat 5\n"))
+	if err != nil {
+		t.Fatalf("LoadFrequencyList() error: %v", err)
+	}
+	if rank, ok := list.Rank("The"); !ok || rank != 1 {
+		t.Errorf("Rank(%q) = %d, %v, want %d, true", "The", rank, ok, 1)
+	}
+
+	text := "The cat sat on the zebra mat in 2024."
+	profile, err := stats.ProfileVocabulary(text, list, []uint{2, 4})
+	if err != nil {
+		t.Fatalf("ProfileVocabulary(%q) error: %v", text, err)
+	}
+	if profile.Words != 8 || profile.Bands[0].Words != 3 || profile.Bands[1].Words != 5 || profile.OffList != 2 {
+		t.Errorf("ProfileVocabulary(%q) = %+v, want 8 words, 3 and 5 in the bands, 2 off the list", text, profile)
+	}
+	if profile.Bands[0].Percentage != 37.5 {
+		t.Errorf("ProfileVocabulary(%q).Bands[0].Percentage = %v, want %v", text, profile.Bands[0].Percentage, 37.5)
+	}
+	var rare []string
+	for _, word := range profile.RareWords {
+		rare = append(rare, word.Text)
+	}
+	if got, want := strings.Join(rare, " "), "zebra mat in"; got != want {
+		t.Errorf("ProfileVocabulary(%q).RareWords = %q, want %q", text, got, want)
+	}
+}
+
+func TestStopWords(t *testing.T) {
+	text := "The cat and the dog saw the cat."
+	if got := stats.CountUniqueWords(text, stats.WithDefaultStopWords()); got != 3 {
+		t.Errorf("CountUniqueWords(%q) without stop words = %d, want %d", text, got, 3)
+	}
+	if got := stats.WordFrequencies(text, stats.WithDefaultStopWords())["the"]; got != 0 {
+		t.Errorf("WordFrequencies(%q)[%q] without stop words = %d, want 0", text, "the", got)
+	}
+	if got := stats.CountUniqueWords("Le chat et le chien.", stats.WithLanguage("fr"), stats.WithDefaultStopWords()); got != 2 {
+		t.Errorf("CountUniqueWords() of French without stop words = %d, want %d", got, 2)
+	}
+	if !stats.IsStopWord("en", "The") || stats.IsStopWord("en", "cat") {
+		t.Errorf("IsStopWord() is wrong for %q or %q", "The", "cat")
+	}
+}
+
+func TestPorterStemmer(t *testing.T) {
+	tests := map[string]string{
+		"caresses":        "caress",
+		"ponies":          "poni",
+		"agreed":          "agre",
+		"hopping":         "hop",
+		"filing":          "file",
+		"happy":           "happi",
+		"relational":      "relat",
+		"generalizations": "gener",
+		"adoption":        "adopt",
+		"controll":        "control",
+		"walked":          "walk",
+		"don't":           "don't",
+	}
+	for word, want := range tests {
+		if got := (stats.PorterStemmer{}).Stem(word); got != want {
+			t.Errorf("PorterStemmer.Stem(%q) = %q, want %q", word, got, want)
+		}
+	}
+
+	text := "She walks. He walked. They are walking."
+	if got := stats.WordFrequencies(text, stats.WithStemmer(stats.PorterStemmer{}))["walk"]; got != 3 {
+		t.Errorf("WordFrequencies(%q)[%q] with stemming = %d, want %d", text, "walk", got, 3)
+	}
+}
+
+func TestReplacePlaceholders(t *testing.T) {
+	tests := map[string]string{
+		"Hello, {name}!":                      "Hello, x!",
+		"Deleted %d files.":                   "Deleted x files.",
+		"%1$s sent %2$@ to %(user)s.":         "x sent x to x.",
+		"Total: %.2f":                         "Total: x",
+		"Hi {{.Name}} and {{ user }}, ${id}.": "Hi x and x, x.",
+		"Save 50% of the price, 100%%.":       "Save 50% of the price, 100%.",
+		"{count, plural, one {# file}}":       "{count, plural, one {# file}}",
+		"It %says":                            "It %says",
+	}
+	for text, want := range tests {
+		if got := stats.ReplacePlaceholders(text, "x"); got != want {
+			t.Errorf("ReplacePlaceholders(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestWithPlaceholders(t *testing.T) {
+	text := "Hi {{ .Name }}, delete %1$d files from {folderName}?"
+	st := stats.CountAllStats(text, stats.WithPlaceholders())
+	if st.Words != 7 || st.Syllables != 8 || st.Sentences != 1 {
+		t.Errorf("CountAllStats(%q) = %d words, %d syllables, %d sentences, want 7, 8, 1", text, st.Words, st.Syllables, st.Sentences)
+	}
+	if got := stats.CountSentences("Total: %.2f EUR. Thanks.", stats.WithPlaceholders()); got != 2 {
+		t.Errorf("CountSentences() = %d, want 2", got)
+	}
+}
+
+func TestRemoveCitations(t *testing.T) {
+	cases := map[string]string{
+		"It is known[1] and cited [2, 5].":                      "It is known and cited.",
+		"As shown before.¹ Next":                                "As shown before. Next",
+		"Sales grew (Smith et al., 2019) last year.":            "Sales grew last year.",
+		"See the review (Smith & Lee 2019a; Chen, n.d.).":       "See the review.",
+		"The Court held, 410 U.S. 113, 116 (1973), that it is.": "The Court held, that it is.",
+		"Suits under 42 U.S.C. § 1983 are common.":              "Suits under are common.",
+		"It is covered by § 2(a)(1).":                           "It is covered by.",
+		"The area is 5 m² and (in most cases) dry.":             "The area is 5 m² and (in most cases) dry.",
+		"In 1990 (about 2000 people) lived there.":              "In 1990 (about 2000 people) lived there.",
+		"We saw 3 Dogs. 5 more came.":                           "We saw 3 Dogs. 5 more came.",
+		"Route 66 Ave. 12 is long.":                             "Route 66 Ave. 12 is long.",
+		"See Fig. 2 Art. 5 for details.":                        "See Fig. 2 Art. 5 for details.",
+		"It was reversed, 123 F.3d 456 (9th Cir. 1997).":        "It was reversed.",
+	}
+	for text, want := range cases {
+		if got := stats.RemoveCitations(text); got != want {
+			t.Errorf("RemoveCitations(%q) = %q, want %q", text, got, want)
+		}
+	}
+	if got := stats.CountSentences("The Court in 410 U.S. 113 (1973) agreed.", stats.WithoutCitations()); got != 1 {
+		t.Errorf("CountSentences() = %d, want 1", got)
+	}
+}
+
+func TestRemoveCitationsForms(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"bracket markers", "Proven [3–7] and [a] and [citation needed] too.", "Proven and and too."},
+		{"named marker", "Use the [note 2] marker.", "Use the marker."},
+		{"superscript markers", "The word¹ and a², and the end.³", "The word and a², and the end."},
+		{"citation with a page", "As argued (see Smith, 2019, p. 12) here.", "As argued here."},
+		{"year only", "It was new (2019) then.", "It was new then."},
+		{"in press", "Not yet (Smith, in press) out.", "Not yet out."},
+		{"parentheses without a year", "Keep (Smith) and (the 1990s) there.", "Keep (Smith) and (the 1990s) there."},
+		{"section range", "Sections §§ 12–14 apply.", "Sections apply."},
+		{"reporter of several words", "Held in 55 F. Supp. 2d 1, 3 (D. Mass. 1999) for him.", "Held in for him."},
+		{"Supreme Court reporter", "See 120 S. Ct. 2000 now.", "See now."},
+	}
+	for _, test := range tests {
+		if got := stats.RemoveCitations(test.text); got != test.want {
+			t.Errorf("RemoveCitations() %s = %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	// The citations add no words, and their points don't end sentences.
+	text := "It is known[1] and cited (Smith et al., 2019). See 410 U.S. 113 too."
+	if words, sentences := stats.CountWords(text, stats.WithoutCitations()), stats.CountSentences(text, stats.WithoutCitations()); words != 7 || sentences != 2 {
+		t.Errorf("CountWords(), CountSentences() of %q = %d, %d, want 7, 2", text, words, sentences)
+	}
+}
+
+func TestTokensWithoutRewrite(t *testing.T) {
+	text := "See [1] here. § 5 (a) applies."
+	opts := []stats.Option{stats.WithoutCitations(), stats.WithLegalProfile(), stats.WithPlaceholders()}
+	words := stats.Words(text, opts...)
+	if len(words) != 6 {
+		t.Fatalf("Words(%q) = %v, want 6 words", text, words)
+	}
+	for _, word := range words {
+		if text[word.Start:word.End] != word.Text {
+			t.Errorf("Words(%q): %q is not at %d:%d", text, word.Text, word.Start, word.End)
+		}
+	}
+	if got := stats.CountWords(text, opts...); got == uint(len(words)) {
+		t.Errorf("CountWords(%q) = %d, want fewer than the %d unrewritten words", text, got, len(words))
+	}
+	doc := stats.NewDocument(text, opts...)
+	if got, want := uint(len(stats.Words(doc.Text(), opts...))), doc.Words(); got != want {
+		t.Errorf("len(Words(%q)) = %d, want %d", doc.Text(), got, want)
+	}
+	sentences := stats.Sentences(text, opts...)
+	if len(sentences) != 2 || text[sentences[1].Start:sentences[1].End] != "§ 5 (a) applies." {
+		t.Errorf("Sentences(%q) = %+v, want the sentences of the given text", text, sentences)
+	}
+}
+
+func TestWithLegalProfile(t *testing.T) {
+	text := "1. Smith v. Jones, No. 12, applies under § 1983.\n(a) The Seller shall: (i) deliver; and (ii) invoice."
+	st := stats.CountAllStats(text, stats.WithLegalProfile())
+	if st.Words != 14 || st.Sentences != 2 {
+		t.Errorf("CountAllStats(%q) = %d words, %d sentences, want 14, 2", text, st.Words, st.Sentences)
+	}
+}
+
+func TestWithLegalProfileFixedPoint(t *testing.T) {
+	texts := []string{
+		"Art. 5 (1)\nSee Art. 5 (1) here.",
+		"1. (a) The Seller shall: (i) deliver; and (ii) invoice.",
+		"§ 12 (a)\n§§ 12–14 apply.\nSection 4 (b) applies.",
+		"2.3 The Buyer pays.\n  (b) 1. The Seller delivers.",
+	}
+	for _, text := range texts {
+		once := stats.NewDocument(text, stats.WithLegalProfile()).Text()
+		if twice := stats.NewDocument(once, stats.WithLegalProfile()).Text(); twice != once {
+			t.Errorf("NewDocument(%q).Text() = %q, rewritten again to %q", text, once, twice)
+		}
+	}
+	text := "Art. 5 (1)"
+	if got := stats.NewDocument(text, stats.WithLegalProfile()).Text(); got != text {
+		t.Errorf("NewDocument(%q).Text() = %q, want %q", text, got, text)
+	}
+}
+
+func TestClauses(t *testing.T) {
+	text := "Recitals.\nSection 4. Term.\n2.3 The Buyer pays.\n  (b) The Seller delivers.\n3.5 million units."
+	want := []stats.Clause{
+		{"", "Recitals.", 0, 9},
+		{"Section 4.", "Term.", 21, 26},
+		{"2.3", "The Buyer pays.", 31, 46},
+		{"(b)", "The Seller delivers.\n3.5 million units.", 53, 92},
+	}
+	got := stats.Clauses(text)
+	if len(got) != len(want) {
+		t.Fatalf("Clauses(%q) = %+v, want %+v", text, got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Clauses(%q)[%d] = %+v, want %+v", text, i, got[i], want[i])
+		}
+	}
+}
+
+func TestWithMedicalProfile(t *testing.T) {
+	text := "Take 5 mg. b.i.d. with food. Call Dr. Lee."
+	if got := stats.CountSentences(text, stats.WithMedicalProfile()); got != 2 {
+		t.Errorf("CountSentences(%q) = %d, want 2", text, got)
+	}
+}
+
+func TestReplaceEquations(t *testing.T) {
+	cases := map[string]string{
+		"We get $x^2 + 1$ here.":           "We get x here.",
+		"It costs $5 or $10.":              "It costs $5 or $10.",
+		"As shown:\nE = mc^2   (3)\nThen.": "As shown:\nx\nThen.",
+		"The value x = 5 is chosen.":       "The value x = 5 is chosen.",
+	}
+	for text, want := range cases {
+		if got := stats.ReplaceEquations(text, "x"); got != want {
+			t.Errorf("ReplaceEquations(%q) = %q, want %q", text, got, want)
+		}
+	}
+	text := "As shown by Lee et al. in Fig. 2, it grows (Smith, 2019). See $f(x)$."
+	if got := stats.CountSentences(text, stats.WithAcademicProfile()); got != 2 {
+		t.Errorf("CountSentences(%q) = %d, want 2", text, got)
+	}
+}
+
+func TestWithFictionProfile(t *testing.T) {
+	cases := map[string]uint{
+		"\"Stop!\" Tom said. He ran.": 2,
+		"“Why?” asked Ann. She left.": 2,
+		"\"Run!\" The dog barked.":    2,
+		"\"Go!\"\n\tTom  whispered.":  1,
+		"\"Go!\" Tom.":                2,
+	}
+	for text, want := range cases {
+		if got := stats.CountSentences(text, stats.WithFictionProfile()); got != want {
+			t.Errorf("CountSentences(%q) = %d, want %d", text, got, want)
+		}
+	}
+
+	// The attribution is looked for in the first two words only, so a long text is counted in linear time.
+	text := strings.Repeat("\"Stop!\" Tom said. ", 50000)
+	if got := stats.CountSentences(text, stats.WithFictionProfile()); got != 50000 {
+		t.Errorf("CountSentences(<50000 attributed quotes>) = %d, want 50000", got)
+	}
+}
+
+func TestDocumentMatchesCountAllStats(t *testing.T) {
+	texts := []string{
+		"ibid. Art. 5 [1] No. 5 U.S.C. ",
+		"1. Smith v. Jones, No. 12, applies under § 1983.\nArt. 5 (1)\n(a) The Seller shall: (i) deliver; and (ii) invoice.",
+		"Hi {{ .Name }}, it's 5% off (Smith et al., 2019). We get $x^2$ here.\nE = mc^2\n\"Stop!\" Tom said. The ﬁnal well-known 1845–1851 war—ended.",
+	}
+	options := map[string]stats.Option{
+		"none":              func(*stats.Config) {},
+		"placeholders":      stats.WithPlaceholders(),
+		"citations":         stats.WithoutCitations(),
+		"legal":             stats.WithLegalProfile(),
+		"medical":           stats.WithMedicalProfile(),
+		"academic":          stats.WithAcademicProfile(),
+		"fiction":           stats.WithFictionProfile(),
+		"fold":              stats.WithComposition(stats.ComposeMarksAndFold),
+		"contractions":      stats.WithExpandedContractions(),
+		"spoken numbers":    stats.WithSpokenNumbers(),
+		"one-syllable nums": stats.WithNumberPolicy(stats.NumbersAsOneSyllable),
+		"smart":             stats.WithSmartAbbreviations(),
+		"uax29":             stats.WithUAX29Sentences(),
+		"hyphens":           stats.WithSplitHyphens(),
+		"en dashes":         stats.WithSplitEnDashes(),
+		"em dashes":         stats.WithSplitEmDashes(),
+		"digits":            stats.WithDigitsAsLetters(),
+		"tokenizer":         stats.WithTokenizer(strings.Fields),
+		"language":          stats.WithLanguage("it"),
+	}
+	for _, text := range texts {
+		for name, option := range options {
+			if got, want := stats.NewDocument(text, option).Stats(), stats.CountAllStats(text, option); got != want {
+				t.Errorf("NewDocument(%q, %s).Stats() = %+v, want %+v", text, name, got, want)
+			}
+		}
+	}
+}
+
+func FuzzDocument(f *testing.F) {
+	f.Add("ibid. Art. 5 [1] No. 5 U.S.C. ", false)
+	f.Add("Hi {{ .Name }}, it's 5% off (Smith et al., 2019). We get $x^2$ here.\n\"Stop!\" Tom said.", true)
+	f.Fuzz(func(t *testing.T, text string, profiles bool) {
+		var opts []stats.Option
+		if profiles {
+			opts = []stats.Option{stats.WithLegalProfile(), stats.WithAcademicProfile(), stats.WithFictionProfile(), stats.WithPlaceholders()}
+		}
+		doc := stats.NewDocument(text, opts...)
+		if utf8.ValidString(text) && !utf8.ValidString(doc.Text()) {
+			t.Errorf("NewDocument(%q).Text() = %q, which is not valid UTF-8", text, doc.Text())
+		}
+		got := doc.Stats()
+		if want := stats.CountAllStats(text, opts...); got != want {
+			t.Errorf("NewDocument(%q).Stats() = %+v, want %+v", text, got, want)
+		}
+		if again := doc.Stats(); again != got {
+			t.Errorf("NewDocument(%q).Stats() = %+v the second time, want %+v", text, again, got)
+		}
+	})
+}
+
+func TestAccumulatorMatchesCountAllStats(t *testing.T) {
+	texts := []string{
+		"Hello world. Bye.",
+		"One sentence.  Two spaces.\n\nA paragraph... and more!  Done?\tTab.",
+		"It weighs 10.5 lbs. He said, \"Stop!\" and left. 👍🏽 Nice… Wait...\nNext line.",
+	}
+	for _, text := range texts {
+		want := stats.CountAllStats(text)
+		for size := 1; size <= len(text); size++ {
+			acc := stats.NewAccumulator()
+			for i := 0; i < len(text); i += size {
+				acc.WriteString(text[i:min(i+size, len(text))])
+			}
+			if got := acc.Stats(); got != want {
+				t.Errorf("Accumulator(%q) in chunks of %d = %+v, want %+v", text, size, got, want)
+			}
+		}
+	}
+
+	text := strings.Repeat("The quick brown fox jumps over the lazy dog. It ran away!  Then, it slept.\n", 20000)
+	got, err := stats.CountAllStatsReader(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("CountAllStatsReader() returned error: %v", err)
+	}
+	if want := stats.CountAllStats(text); got != want {
+		t.Errorf("CountAllStatsReader() = %+v, want %+v", got, want)
+	}
+}
+
+func TestSentences(t *testing.T) {
+	type span struct {
+		text       string
+		terminated bool
+	}
+	tests := []struct {
+		text string
+		want []span
+	}{
+		{"Mr. Smith left. He ran.", []span{{"Mr. Smith left.", true}, {"He ran.", true}}},
+		{"It weighs 10.5 lbs. It is heavy.", []span{{"It weighs 10.5 lbs.", true}, {"It is heavy.", true}}},
+		{"Wait... what? Yes.", []span{{"Wait... what?", true}, {"Yes.", true}}},
+		{"He said, 'Stop!' and left.", []span{{"He said, 'Stop!' and left.", true}}},
+		{"Really?! Yes.", []span{{"Really?!", true}, {"Yes.", true}}},
+		{"Introduction\n\nText here. Tail", []span{{"Introduction", false}, {"Text here.", true}, {"Tail", false}}},
+		{"  Padded.  ", []span{{"Padded.", true}}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		got := stats.Sentences(tt.text)
+		if len(got) != len(tt.want) {
+			t.Errorf("Sentences(%q) = %+v, want %+v", tt.text, got, tt.want)
+			continue
+		}
+		for i, sentence := range got {
+			if sentence.Text != tt.want[i].text || sentence.Terminated != tt.want[i].terminated {
+				t.Errorf("Sentences(%q)[%d] = %+v, want %+v", tt.text, i, sentence, tt.want[i])
+			}
+			if tt.text[sentence.Start:sentence.End] != sentence.Text {
+				t.Errorf("Sentences(%q)[%d]: %q is not at %d:%d", tt.text, i, sentence.Text, sentence.Start, sentence.End)
+			}
+		}
+	}
+}
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		text string
+		opts []stats.Option
+		want []stats.Token
+	}{
+		{"(quickly). — well-known don't 42", nil, []stats.Token{{"quickly", 1, 8}, {"well-known", 15, 25}, {"don't", 26, 31}, {"42", 32, 34}}},
+		{"  Hello,\tworld!\n", nil, []stats.Token{{"Hello", 2, 7}, {"world", 9, 14}}},
+		{"well-known", []stats.Option{stats.WithSplitHyphens()}, []stats.Token{{"well", 0, 4}, {"known", 5, 10}}},
+		{"日本語", []stats.Option{stats.WithUAX29Words()}, []stats.Token{{"日", 0, 3}, {"本", 3, 6}, {"語", 6, 9}}},
+		{"... — !", nil, nil},
+	}
+	for _, tt := range tests {
+		got := stats.Words(tt.text, tt.opts...)
+		if len(got) != len(tt.want) {
+			t.Errorf("Words(%q) = %+v, want %+v", tt.text, got, tt.want)
+			continue
+		}
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Errorf("Words(%q)[%d] = %+v, want %+v", tt.text, i, got[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestDocumentCountedWords(t *testing.T) {
+	tests := []struct {
+		text string
+		opts []stats.Option
+		want []string
+	}{
+		{"(quickly). — well-known, т.е. l'homme 50%!", nil, []string{"quickly", "well-known", "т.е", "l'homme", "50%"}},
+		{"كِتَابٌ.", nil, []string{"كِتَابٌ"}},
+		{"It's 12.", []stats.Option{stats.WithExpandedContractions(), stats.WithSpokenNumbers()}, []string{"it", "is", "twelve"}},
+		{"well-known", []stats.Option{stats.WithSplitHyphens()}, []string{"well", "known"}},
+	}
+	for _, tt := range tests {
+		doc := stats.NewDocument(tt.text, tt.opts...)
+		if got := doc.CountedWords(); !slices.Equal(got, tt.want) {
+			t.Errorf("NewDocument(%q).CountedWords() = %q, want %q", tt.text, got, tt.want)
+		}
+		// The words have the syllables of the document.
+		var syllables uint
+		for _, word := range doc.CountedWords() {
+			syllables += doc.CountSyllables(word)
+		}
+		if syllables != doc.Syllables() {
+			t.Errorf("the words of %q have %d syllables, want %d", tt.text, syllables, doc.Syllables())
+		}
+	}
+}
+
+func TestSeqMatchesSlices(t *testing.T) {
+	texts := []string{
+		"(quickly). — well-known don't 42",
+		"Mr. Smith left. He ran.\n\nIntroduction\n\nText here. Tail",
+		"  Hello,\tworld!\n日本語。",
+		"",
+	}
+	options := [][]stats.Option{
+		nil,
+		{stats.WithSplitHyphens()},
+		{stats.WithUAX29Words(), stats.WithUAX29Sentences()},
+	}
+	for _, text := range texts {
+		for _, opts := range options {
+			var words []stats.Token
+			for word := range stats.WordsSeq(text, opts...) {
+				words = append(words, word)
+			}
+			if want := stats.Words(text, opts...); !slices.Equal(words, want) {
+				t.Errorf("WordsSeq(%q) = %+v, want %+v", text, words, want)
+			}
+			var sentences []stats.Sentence
+			for sentence := range stats.SentencesSeq(text, opts...) {
+				sentences = append(sentences, sentence)
+			}
+			if want := stats.Sentences(text, opts...); !slices.Equal(sentences, want) {
+				t.Errorf("SentencesSeq(%q) = %+v, want %+v", text, sentences, want)
+			}
+		}
+	}
+
+	// The iteration stops when the loop breaks.
+	var first []stats.Token
+	for word := range stats.WordsSeq("one two three") {
+		first = append(first, word)
+		break
+	}
+	if len(first) != 1 || first[0].Text != "one" {
+		t.Errorf("WordsSeq() with break = %+v, want the first word", first)
+	}
+	count := 0
+	for range stats.SentencesSeq("One. Two. Three.") {
+		if count++; count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("SentencesSeq() with break yielded %d sentences, want 2", count)
+	}
+}
+
+func TestCountAllStatsMatchesCounters(t *testing.T) {
+	texts := []string{
+		"The quick brown fox jumps over the lazy dog. It ran away!",
+		"Mr. Smith paid $10.5 million... Really?! Yes.\n\nIntroduction\n\nA beautiful, unbelievable day.",
+		"  Hello,\tworld!  👍🏽 Café — naïve résumé.",
+		"18",
+		"",
+	}
+	for _, text := range texts {
+		// The words without letters and numbers ("—", "👍🏽") have no syllables.
+		var syllables uint
+		for _, word := range strings.Fields(text) {
+			if strings.IndexFunc(word, func(c rune) bool { return unicode.IsLetter(c) || unicode.IsNumber(c) }) >= 0 {
+				syllables += stats.CountSyllables(word)
+			}
+		}
+		got := stats.CountAllStats(text)
+		want := stats.TotalStats{
+			Symbols:       stats.CountSymbols(text),
+			Characters:    stats.CountCharacters(text),
+			Letters:       stats.CountLetters(text),
+			Words:         stats.CountWords(text),
+			Sentences:     stats.CountSentences(text),
+			Syllables:     syllables,
+			Polysyllables: stats.CountPolysyllabicWords(text, stats.PolysyllableThreshold),
+		}
+		if got != want {
+			t.Errorf("CountAllStats(%q) = %+v, want %+v", text, got, want)
+		}
+
+		// The counters that accept options count with them as CountAllStats does.
+		for _, opt := range []stats.Option{stats.WithSplitHyphens(), stats.WithUAX29Words(), stats.WithUAX29Sentences(), stats.WithDigitsAsLetters(), stats.WithSmartAbbreviations()} {
+			got := stats.CountAllStats(text, opt)
+			if words := stats.CountWords(text, opt); got.Words != words {
+				t.Errorf("CountAllStats(%q).Words = %d, want %d", text, got.Words, words)
+			}
+			if sentences := stats.CountSentences(text, opt); got.Sentences != sentences {
+				t.Errorf("CountAllStats(%q).Sentences = %d, want %d", text, got.Sentences, sentences)
+			}
+			if letters := stats.CountLetters(text, opt); got.Letters != letters {
+				t.Errorf("CountAllStats(%q).Letters = %d, want %d", text, got.Letters, letters)
+			}
+		}
+	}
+}
+
+func TestAbbreviationOptions(t *testing.T) {
+	tests := []struct {
+		text string
+		want uint
+		opts []stats.Option
+	}{
+		{"It costs approx. five dollars. Yes.", 3, nil},
+		{"It costs approx. five dollars. Yes.", 2, []stats.Option{stats.WithExtraAbbreviations("Approx.")}},
+		{"Mr. Smith left. He ran.", 2, nil},
+		{"Mr. Smith left. He ran.", 3, []stats.Option{stats.WithoutAbbreviations("MR.")}},
+		{"Mr. Smith saw the xyz. model. Yes.", 3, []stats.Option{stats.WithAbbreviations(map[string]int{"xyz.": 1})}},
+		{"Das ist z.B. gut. Ja.", 2, []stats.Option{stats.WithLanguage("de")}},
+		{"Das ist z.B. gut. Ja.", 2, []stats.Option{stats.WithAbbreviations(stats.DefaultAbbreviations("de"))}},
+		{"It was approx. five. Yes.", 2, []stats.Option{stats.WithAbbreviations(map[string]int{}), stats.WithExtraAbbreviations("approx.")}},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSentences(tt.text, tt.opts...); got != tt.want {
+			t.Errorf("CountSentences(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+
+	// DefaultAbbreviations returns a copy, so changing it doesn't change the defaults.
+	defaults := stats.DefaultAbbreviations("en")
+	delete(defaults, "mr.")
+	if got := stats.DefaultAbbreviations("EN"); got["mr."] != 1 {
+		t.Errorf("DefaultAbbreviations(\"EN\")[\"mr.\"] = %d, want 1", got["mr."])
+	}
+	if got := stats.DefaultAbbreviations("xx"); got["mr."] != 1 {
+		t.Errorf("DefaultAbbreviations(\"xx\")[\"mr.\"] = %d, want the English defaults", got["mr."])
+	}
+}
+
+func TestAbbreviationMatching(t *testing.T) {
+	tests := map[string]uint{
+		"Mr. Smith left.":                  1,
+		"MR. SMITH LEFT.":                  1,
+		"mr. smith left.":                  1,
+		"(Mr. Smith) left.":                1,
+		"He lives on Main St. in town.":    1,
+		"It was the first. Then it ended.": 2,
+		"He came last. Then he left.":      2,
+		"She was Dr.Who. Fine.":            2,
+	}
+	for text, want := range tests {
+		if got := stats.CountSentences(text); got != want {
+			t.Errorf("CountSentences(%q) = %d, want %d", text, got, want)
+		}
+	}
+}
+
+func TestSmartAbbreviations(t *testing.T) {
+	tests := []struct {
+		text        string
+		plain, want uint
+	}{
+		{"J. R. R. Tolkien wrote it. Yes.", 5, 2},
+		{"The approx. value is high. Yes.", 3, 2},
+		{"We met at the conf. in May. Good.", 3, 2},
+		{"It was good. Then it rained.", 2, 2},
+		{"The king was Henry V. He ruled England.", 2, 2},
+		{"It ended in 1999. A new era began.", 2, 2},
+	}
+	for _, tt := range tests {
+		if got := stats.CountSentences(tt.text); got != tt.plain {
+			t.Errorf("CountSentences(%q) = %d, want %d", tt.text, got, tt.plain)
+		}
+		if got := stats.CountSentences(tt.text, stats.WithSmartAbbreviations()); got != tt.want {
+			t.Errorf("CountSentences(%q, WithSmartAbbreviations()) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
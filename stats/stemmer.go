@@ -0,0 +1,215 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+)
+
+// ====== Types ======
+
+// Stemmer returns the stems of words, so the forms of a word ("walk", "walked", "walking") are matched as one word.
+// `PorterStemmer` implements it for English.
+type Stemmer interface {
+	Stem(word string) string
+}
+
+// StemmerFunc is a function that returns the stem of a word, used as a Stemmer.
+type StemmerFunc func(word string) string
+
+// PorterStemmer is the Stemmer of the Porter stemming algorithm for English (M. F. Porter, "An algorithm for suffix stripping", 1980),
+// with the revisions of the reference implementation ("-bli" and "-logi" in step 2).
+type PorterStemmer struct{}
+
+// porterRule replaces the suffix of a word with the replacement if the measure of the rest of the word is greater than min.
+type porterRule struct {
+	suffix      string
+	replacement string
+	min         int
+}
+
+// porterStep2, porterStep3, and porterStep4 are the rules of the steps 2, 3, and 4, sorted by the suffix length in descending order, so the longest suffix matches first.
+var (
+	porterStep2 = sortedPorterRules([]porterRule{
+		{"ational", "ate", 0}, {"tional", "tion", 0}, {"enci", "ence", 0}, {"anci", "ance", 0}, {"izer", "ize", 0}, {"bli", "ble", 0},
+		{"alli", "al", 0}, {"entli", "ent", 0}, {"eli", "e", 0}, {"ousli", "ous", 0}, {"ization", "ize", 0}, {"ation", "ate", 0},
+		{"ator", "ate", 0}, {"alism", "al", 0}, {"iveness", "ive", 0}, {"fulness", "ful", 0}, {"ousness", "ous", 0}, {"aliti", "al", 0},
+		{"iviti", "ive", 0}, {"biliti", "ble", 0}, {"logi", "log", 0},
+	})
+	porterStep3 = sortedPorterRules([]porterRule{
+		{"icate", "ic", 0}, {"ative", "", 0}, {"alize", "al", 0}, {"iciti", "ic", 0}, {"ical", "ic", 0}, {"ful", "", 0}, {"ness", "", 0},
+	})
+	porterStep4 = sortedPorterRules([]porterRule{
+		{"al", "", 1}, {"ance", "", 1}, {"ence", "", 1}, {"er", "", 1}, {"ic", "", 1}, {"able", "", 1}, {"ible", "", 1}, {"ant", "", 1},
+		{"ement", "", 1}, {"ment", "", 1}, {"ent", "", 1}, {"ion", "", 1}, {"ou", "", 1}, {"ism", "", 1}, {"ate", "", 1}, {"iti", "", 1},
+		{"ous", "", 1}, {"ive", "", 1}, {"ize", "", 1},
+	})
+)
+
+// ====== Methods ======
+
+// Stem calls f(word).
+func (f StemmerFunc) Stem(word string) string {
+	return f(word)
+}
+
+// Stem accepts an English word in lower case and returns its stem ("walked" is "walk", "generalizations" is "gener").
+// The stems are not always words ("happy" is "happi"), but the forms of a word have the same stem.
+// The words of two letters or less and the words with characters other than the Latin letters ("don't", "café") are returned as they are.
+func (PorterStemmer) Stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	w := porterStep1(word)
+	w = applyPorterRules(w, porterStep2)
+	w = applyPorterRules(w, porterStep3)
+	w = applyPorterRules(w, porterStep4)
+	return porterStep5(w)
+}
+
+// ====== Functions ======
+
+// WithStemmer sets the Stemmer that the words are compared by (see `WithLemmatizer`), so the forms of a word count as one in the word frequencies and the unique words,
+// and match the word lists ("walked" matches "walk" in the Dale–Chall list of `en.CountDifficultWords`).
+func WithStemmer(stemmer Stemmer) Option {
+	return WithLemmatizer(stemmer.Stem)
+}
+
+// porterStep1 removes the plurals and the "-ed" and "-ing" endings and turns a final "y" into "i" (the steps 1a, 1b, and 1c).
+func porterStep1(w string) string {
+	switch {
+	case strings.HasSuffix(w, "sses"), strings.HasSuffix(w, "ies"):
+		w = w[:len(w)-2]
+	case strings.HasSuffix(w, "ss"):
+	case strings.HasSuffix(w, "s"):
+		w = w[:len(w)-1]
+	}
+
+	if strings.HasSuffix(w, "eed") {
+		if porterMeasure(w[:len(w)-3]) > 0 {
+			w = w[:len(w)-1]
+		}
+	} else if stem, ok := trimSuffixWithVowel(w, "ed", "ing"); ok {
+		w = stem
+		switch {
+		case strings.HasSuffix(w, "at"), strings.HasSuffix(w, "bl"), strings.HasSuffix(w, "iz"):
+			w += "e"
+		case endsWithDoubleConsonant(w) && !strings.HasSuffix(w, "l") && !strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "z"):
+			w = w[:len(w)-1]
+		case porterMeasure(w) == 1 && endsWithCVC(w):
+			w += "e"
+		}
+	}
+
+	if strings.HasSuffix(w, "y") && containsPorterVowel(w[:len(w)-1]) {
+		w = w[:len(w)-1] + "i"
+	}
+	return w
+}
+
+// porterStep5 removes a final "e" and a double "l" (the steps 5a and 5b).
+func porterStep5(w string) string {
+	if strings.HasSuffix(w, "e") {
+		stem := w[:len(w)-1]
+		if m := porterMeasure(stem); m > 1 || (m == 1 && !endsWithCVC(stem)) {
+			w = stem
+		}
+	}
+	if strings.HasSuffix(w, "ll") && porterMeasure(w) > 1 {
+		w = w[:len(w)-1]
+	}
+	return w
+}
+
+// applyPorterRules applies the first rule whose suffix ends the word. The other rules are not tried even if the measure doesn't allow the first one.
+func applyPorterRules(w string, rules []porterRule) string {
+	for _, rule := range rules {
+		if !strings.HasSuffix(w, rule.suffix) {
+			continue
+		}
+		stem := w[:len(w)-len(rule.suffix)]
+		if porterMeasure(stem) <= rule.min {
+			return w
+		}
+		// "-ion" is removed only after "s" or "t": "adoption", "decision", but not "onion".
+		if rule.suffix == "ion" && !strings.HasSuffix(stem, "s") && !strings.HasSuffix(stem, "t") {
+			return w
+		}
+		return stem + rule.replacement
+	}
+	return w
+}
+
+// trimSuffixWithVowel returns the word without the first of the suffixes that ends it and true, if the rest of the word contains a vowel.
+func trimSuffixWithVowel(w string, suffixes ...string) (string, bool) {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(w, suffix) && containsPorterVowel(w[:len(w)-len(suffix)]) {
+			return w[:len(w)-len(suffix)], true
+		}
+	}
+	return w, false
+}
+
+// isPorterConsonant reports whether the letter at the index is a consonant. "y" is a consonant at the start of the word and after a vowel.
+func isPorterConsonant(w string, i int) bool {
+	switch w[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !isPorterConsonant(w, i-1)
+	}
+	return true
+}
+
+// porterMeasure returns the number of vowel-consonant sequences in the word, m in [C](VC){m}[V].
+func porterMeasure(w string) int {
+	m := 0
+	prevIsVowel := false
+	for i := range len(w) {
+		isVowel := !isPorterConsonant(w, i)
+		if prevIsVowel && !isVowel {
+			m++
+		}
+		prevIsVowel = isVowel
+	}
+	return m
+}
+
+// containsPorterVowel reports whether the word contains a vowel.
+func containsPorterVowel(w string) bool {
+	for i := range len(w) {
+		if !isPorterConsonant(w, i) {
+			return true
+		}
+	}
+	return false
+}
+
+// endsWithDoubleConsonant reports whether the word ends with two identical consonants ("tt", "ss").
+func endsWithDoubleConsonant(w string) bool {
+	n := len(w)
+	return n >= 2 && w[n-1] == w[n-2] && isPorterConsonant(w, n-1)
+}
+
+// endsWithCVC reports whether the word ends with a consonant, a vowel, and a consonant other than "w", "x", or "y" ("hop", but not "snow").
+func endsWithCVC(w string) bool {
+	n := len(w)
+	if n < 3 || !isPorterConsonant(w, n-3) || isPorterConsonant(w, n-2) || !isPorterConsonant(w, n-1) {
+		return false
+	}
+	last := w[n-1]
+	return last != 'w' && last != 'x' && last != 'y'
+}
+
+// sortedPorterRules returns the rules sorted by the suffix length in descending order.
+func sortedPorterRules(rules []porterRule) []porterRule {
+	sort.SliceStable(rules, func(i, j int) bool {
+		return len(rules[i].suffix) > len(rules[j].suffix)
+	})
+	return rules
+}
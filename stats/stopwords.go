@@ -0,0 +1,85 @@
+package stats
+
+import "strings"
+
+// languageStopWords maps the ISO 639-1 language codes to the stop words of the language: the most common function words, in lower case.
+var languageStopWords = map[string]map[string]bool{
+	"en": toStopWords(`
+		a about above after again against all am an and any are as at be because been before being below between both but by can could
+		did do does doing down during each few for from further had has have having he her here hers herself him himself his how i if in into is it its itself
+		just me more most my myself no nor not now of off on once only or other our ours ourselves out over own same she should so some such
+		than that the their theirs them themselves then there these they this those through to too under until up very was we were what when where which
+		while who whom why will with would you your yours yourself yourselves i'm you're he's she's it's we're they're i've you've we've they've
+		i'd you'd he'd she'd we'd they'd i'll you'll he'll she'll we'll they'll isn't aren't wasn't weren't hasn't haven't hadn't doesn't don't didn't
+		won't wouldn't can't cannot couldn't shouldn't let's that's there's what's who's`),
+	"it": toStopWords(`
+		a ad al alla alle allo agli ai anche avere aveva c' che chi ci come con contro cui da dal dalla dalle dallo dagli dai de del della delle dello
+		degli dei di dove e è ed era erano essere fa gli ha hanno ho i il in io l' la le lei li lo loro lui ma mi mia mie miei mio ne negli nei nel nella
+		nelle nello noi non nostra nostre nostri nostro o per perché più quale quando quella quelle quelli quello questa queste questi questo se sei si
+		sia siamo sono su sua sue sui sul sulla sulle sullo suo suoi ti tra tu tua tue tuo tuoi tutti tutto un una uno vi voi`),
+	"es": toStopWords(`
+		a al algo algunos ante antes como con contra cual cuando de del desde donde durante e el él ella ellas ellos en entre era eran es esa esas ese
+		eso esos esta está están estas este esto estos fue fueron ha han hasta hay la las le les lo los más me mi mis mucho muy nada ni no nos nosotros
+		o os otra otro para pero poco por porque que qué quien se ser si sí sin sobre son su sus también tanto te tiene tienen todo todos tu tus un una
+		uno unos y ya yo`),
+	"fr": toStopWords(`
+		à au aux avec ce ces c' cette d' dans de des du elle elles en est et été être eu il ils j' je l' la le les leur leurs lui m' ma mais me même mes
+		moi mon n' ne nos notre nous on ont ou où par pas pour qu' que qui s' sa se ses si son sont sur t' ta te tes toi ton tu un une vos votre vous y`),
+	"de": toStopWords(`
+		aber alle als also am an auch auf aus bei bin bis bist da damit dann das dass dem den denn der des die dies diese dieser dieses doch dort du durch
+		ein eine einem einen einer eines er es für hat hatte hier ich ihr ihre im in ist ja jetzt kann kein keine man mich mir mit nach nicht noch nur ob
+		oder ohne schon sein seine sich sie sind so über um und uns unter viel vom von vor war waren was weil wenn werden wie wir wird wo zu zum zur`),
+	"nl": toStopWords(`
+		aan al als bij dan dat de der die dit doch door een en er had heb heeft hem het hier hij hoe hun ik in is ja je kan maar me men met mij mijn na
+		naar niet nog nu of om omdat ons ook op over te tot u uit van veel voor was wat we wel werd wie wij worden zal ze zei zich zij zijn zo zou`),
+	"ru": toStopWords(`
+		а без бы был была были было быть в вам вас во вот все всё вы где да для до его ее её если есть еще ещё же за здесь и из или им их к как когда
+		кто ли меня мне мы на над не него нет ни них но о об однако он она они оно от по под при с со так также там то тоже только ты у уже чем что
+		чтобы эта эти это этот я`),
+	"ar": toStopWords(`
+		و في من على إلى عن مع هذا هذه ذلك تلك التي الذي الذين هو هي هم هن أنا نحن أنت أن إن كان كانت يكون قد لا ما لم لن أو ثم كل بعض بين عند حتى إذا
+		لكن بل أي غير منذ هناك هنا أيضا فقط`),
+	"ja": toStopWords(`
+		の に は を た が で て と し れ さ ある いる も する から な こと として い や れる など なっ ない この ため その あっ よう また もの という
+		あり まで られ なる へ か だ これ によって により おり より による ず なり られる において ば なかっ なく しかし について せ だっ できる それ`),
+	"zh": toStopWords(`
+		的 了 在 是 我 有 和 就 不 人 都 一 一个 上 也 很 到 说 要 去 你 会 着 没有 看 好 自己 这 他 她 它 们 我们 你们 他们 那 之 与 及 而 或 但 被
+		把 给 从 对 为 以 于 中 等 吗 呢 吧 啊 这个 那个 因为 所以 如果 但是`),
+}
+
+// ====== Functions ======
+
+// DefaultStopWords accepts an ISO 639-1 language code and returns a copy of the stop words of the language (in lower case), or nil for the languages without them,
+// so they can be changed and passed to `WithStopWords`. Stop words are shipped for "en", "it", "es", "fr", "de", "nl", "ru", "ar", "ja", and "zh".
+func DefaultStopWords(language string) map[string]bool {
+	defaults, ok := languageStopWords[strings.ToLower(language)]
+	if !ok {
+		return nil
+	}
+	copied := make(map[string]bool, len(defaults))
+	for word := range defaults {
+		copied[word] = true
+	}
+	return copied
+}
+
+// IsStopWord accepts an ISO 639-1 language code and a word and reports whether the word is a stop word of the language. The word is matched case-insensitively.
+func IsStopWord(language, word string) bool {
+	return languageStopWords[strings.ToLower(language)][strings.ToLower(normalizeApostrophes(word))]
+}
+
+// WithDefaultStopWords excludes the stop words of the language (see `WithLanguage`, `DefaultStopWords`) from the word statistics, unless other stop words are set by `WithStopWords`.
+func WithDefaultStopWords() Option {
+	return func(c *Config) {
+		c.defaultStopWords = true
+	}
+}
+
+// toStopWords returns the set of the whitespace-separated words.
+func toStopWords(words string) map[string]bool {
+	set := map[string]bool{}
+	for _, word := range strings.Fields(words) {
+		set[word] = true
+	}
+	return set
+}
@@ -0,0 +1,147 @@
+package stats
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ====== Types & Consts ======
+
+// syllableRule represents a correction of the number of vowel groups in an English word.
+type syllableRule struct {
+	// sequence is the letters the rule applies to.
+	sequence string
+	// final is true if the sequence must end the word.
+	final bool
+	// notAfter is the letters that cancel the rule if the sequence follows one of them. The sequence must follow a letter if notAfter is not empty.
+	notAfter string
+	// delta is added to the number of syllables for every match.
+	delta int
+}
+
+// consonants are the English consonants, as opposed to the vowels of the rules.
+const consonants = "bcdfghjklmnpqrstvwxz"
+
+// syllableRules are the corrections applied to the number of vowel groups, in order.
+var syllableRules = []syllableRule{
+	// Silent final "e": "make", "stone". Not after a vowel, which shares its group ("free"), nor in "-le" after a consonant ("table").
+	{"e", true, "aeiouyl", -1},
+	// "-le" and "-les" after a vowel are silent too: "whole", "miles".
+	{"le", true, consonants, -1},
+	{"les", true, consonants, -1},
+	// Silent "-es": "makes", "stones", but "boxes", "wishes", "pages", "places".
+	{"es", true, "aeiouyszxcghl", -1},
+	// Silent "-ed": "jumped", but "wanted", "ended".
+	{"ed", true, "aeiouytd", -1},
+	// Vowels pronounced apart: "media", "radio", "video", "actual", but "special", "nation", "equal".
+	{"ia", false, "cstg", 1},
+	{"io", false, "cstgx", 1},
+	{"eo", false, "cg", 1},
+	{"ua", false, "qg", 1},
+	// "-ism": "criticism".
+	{"ism", true, "", 1},
+}
+
+// syllableExceptions are the English words the rules count wrong.
+var syllableExceptions = map[string]uint{
+	"business": 2,
+	"clothes":  1,
+	"every":    2,
+	"people":   2,
+	"billion":  2,
+	"million":  2,
+	"area":     3,
+	"idea":     3,
+	"poem":     2,
+	"quiet":    2,
+	"science":  2,
+	"create":   2,
+	"being":    2,
+	"hundred":  2,
+	"ninety":   2,
+	"nineteen": 2,
+	"trillion": 2,
+}
+
+// languageSyllableCounters maps the ISO 639-1 language codes to the syllable counters registered for them.
+var languageSyllableCounters = map[string]SyllableCounter{}
+
+// ====== Functions ======
+
+// RegisterSyllableCounter registers the SyllableCounter of the language by its ISO 639-1 code, so the texts in the language (see `WithLanguage`)
+// count syllables with it unless another counter is set (see `WithSyllableCounter`). The language packages register their counters when they are imported.
+// It is not safe for concurrent use with counting, so it should be called in an init function.
+func RegisterSyllableCounter(language string, counter SyllableCounter) {
+	languageSyllableCounters[strings.ToLower(language)] = counter
+}
+
+// CountSyllables accepts a string that represents an English word and returns the number of syllables in it.
+// The syllables are the groups of adjacent vowels ("y" is a vowel unless it starts the word), corrected by the rules for silent endings ("make", "jumped")
+// and for adjacent vowels pronounced apart ("radio"), and the known exceptions. Characters other than letters are ignored, so "don't" counts as "dont".
+// The words with digits are counted as they are read: "42" as "forty-two", "3rd" as "third", "$5" as "five dollars", "COVID-19" as "COVID nineteen" (see `WithNumberPolicy`).
+// A word without vowels ("hmm") counts as one syllable, and an empty string as zero.
+func CountSyllables(s string) uint {
+	if len(s) == 0 {
+		return 0
+	}
+	if strings.IndexFunc(s, unicode.IsDigit) >= 0 {
+		var syllables uint
+		for _, word := range spokenWords(s) {
+			syllables += CountSyllables(word)
+		}
+		return max(syllables, 1)
+	}
+
+	word := []rune(strings.ToLower(strings.Map(func(c rune) rune {
+		if unicode.IsLetter(c) {
+			return c
+		}
+		return -1
+	}, s)))
+	if syllables, ok := syllableExceptions[string(word)]; ok {
+		return syllables
+	}
+
+	syllables := 0
+	prevIsVowel := false
+	for i, char := range word {
+		isVowel := isEnglishVowel(char) && (char != 'y' || i > 0)
+		// A diaeresis marks a vowel pronounced apart from the previous one: "naïve", "coöperate".
+		if isVowel && (!prevIsVowel || strings.ContainsRune("äëïöüÿ", char)) {
+			syllables++
+		}
+		prevIsVowel = isVowel
+	}
+	for _, rule := range syllableRules {
+		syllables += rule.delta * rule.matches(word)
+	}
+
+	if syllables < 1 {
+		syllables = 1
+	}
+	return uint(syllables)
+}
+
+// matches returns the number of matches of the rule in the word.
+func (r syllableRule) matches(word []rune) int {
+	sequence := []rune(r.sequence)
+	count := 0
+	for i := len(word) - len(sequence); i >= 0; i-- {
+		if r.final && i != len(word)-len(sequence) {
+			break
+		}
+		if string(word[i:i+len(sequence)]) != r.sequence {
+			continue
+		}
+		if r.notAfter != "" && (i == 0 || strings.ContainsRune(r.notAfter, word[i-1])) {
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+// isEnglishVowel reports whether the rune is a vowel, including the accented ones of loanwords ("café", "naïve").
+func isEnglishVowel(char rune) bool {
+	return strings.ContainsRune("aeiouyàáâãäåæèéêëìíîïòóôõöøœùúûüýÿ", char)
+}
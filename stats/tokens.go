@@ -0,0 +1,126 @@
+package stats
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// Token represents a word of a text with its byte offsets in the text. Text is `text[Start:End]`.
+type Token struct {
+	Text  string `json:"text"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+// ====== Functions ======
+
+// Words accepts a string and options and returns its words with their byte offsets.
+// The words are split by the configured Tokenizer (see `WithTokenizer`), by whitespaces by default, and the leading and trailing punctuation is trimmed off them,
+// so "(quickly)." is returned as "quickly". Tokens without letters or numbers (for example, a dash between spaces) are skipped.
+// The offsets are in the given text, so the options that rewrite the text before counting (`WithoutCitations`, `WithLegalProfile`, `WithPlaceholders`,
+// the equations of `WithAcademicProfile`) are not applied, and the words can differ from the counted ones: "See [1]." is two words here and one for `CountWords`.
+// To get the counted words, pass the text of a Document (see `Document.Text`), which has no offsets in the original text.
+func Words(text string, opts ...Option) []Token {
+	return tokenize(text, NewConfig(opts...).Tokenizer)
+}
+
+// tokenize splits the text with the tokenizer and returns the words with the leading and trailing punctuation trimmed off and their byte offsets.
+// The offsets are found by searching each word in the text after the previous one, so words the tokenizer changed are skipped.
+func tokenize(text string, tokenizer Tokenizer) []Token {
+	var tokens []Token
+	offset := 0
+	for _, word := range tokenizer(text) {
+		index := strings.Index(text[offset:], word)
+		if index < 0 {
+			continue
+		}
+		start := offset + index
+		offset = start + len(word)
+
+		if token, ok := trimToken(word, start); ok {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// trimToken returns the Token of the word starting at the offset with the leading and trailing punctuation trimmed off, and false if nothing is left.
+func trimToken(word string, start int) (Token, bool) {
+	trimmed := strings.TrimLeftFunc(word, isNotLetterOrNumber)
+	start += len(word) - len(trimmed)
+	trimmed = trimWordEnd(trimmed)
+	if len(trimmed) == 0 {
+		return Token{}, false
+	}
+	return Token{trimmed, start, start + len(trimmed)}, true
+}
+
+// trimWordEnd returns the word without the trailing characters that are neither letters nor numbers, except for the combining marks of its last letter,
+// so the final vowel mark of a vocalized Arabic word ("كِتَابٌ") stays in the word.
+func trimWordEnd(word string) string {
+	end := len(strings.TrimRightFunc(word, isNotLetterOrNumber))
+	if end == 0 {
+		return ""
+	}
+	for end < len(word) {
+		char, size := utf8.DecodeRuneInString(word[end:])
+		if !unicode.Is(unicode.M, char) {
+			break
+		}
+		end += size
+	}
+	return word[:end]
+}
+
+// isNotLetterOrNumber reports whether the rune is neither a letter nor a number.
+func isNotLetterOrNumber(c rune) bool {
+	return !unicode.IsLetter(c) && !unicode.IsNumber(c)
+}
+
+// splitAtDashes returns a Tokenizer that splits the words of the tokenizer at the dashes. URLs and file paths ("https://my-site.com") are not split,
+// and neither are the words that are dashes only ("—" between spaces).
+func splitAtDashes(tokenizer Tokenizer, dashes string) Tokenizer {
+	isDash := func(c rune) bool {
+		return strings.ContainsRune(dashes, c)
+	}
+	return func(text string) []string {
+		var words []string
+		for _, word := range tokenizer(text) {
+			parts := strings.FieldsFunc(word, isDash)
+			if len(parts) == 0 || isURLLike(trimURLLike(word)) {
+				words = append(words, word)
+				continue
+			}
+			words = append(words, parts...)
+		}
+		return words
+	}
+}
+
+// isURLLike reports whether the word is a URL ("https://example.com/docs", "www.example.com"), an email address ("user@example.com"),
+// or a file path ("/etc/hosts", "./run.sh", "~/.bashrc", "C:\\Windows", "stats/stats.go"), which are read as one word.
+func isURLLike(word string) bool {
+	switch {
+	case strings.Contains(word, "://"), strings.HasPrefix(word, "www."):
+		return true
+	case strings.HasPrefix(word, "/") && len(word) > 1, strings.HasPrefix(word, "./"), strings.HasPrefix(word, "../"), strings.HasPrefix(word, "~/"):
+		return true
+	case len(word) > 2 && word[1] == ':' && (word[2] == '\\' || word[2] == '/') && unicode.IsLetter(rune(word[0])):
+		return true
+	}
+	if at := strings.IndexByte(word, '@'); at > 0 && strings.Count(word, "@") == 1 && strings.Contains(word[at:], ".") {
+		return true
+	}
+	separators := strings.Count(word, "/") + strings.Count(word, "\\")
+	lastSeparator := strings.LastIndexAny(word, "/\\")
+	return separators >= 2 || (separators == 1 && strings.Contains(word[lastSeparator:], "."))
+}
+
+// trimURLLike returns the word without the surrounding quotes, brackets, and sentence punctuation, so a URL at the end of a sentence can be recognized.
+func trimURLLike(word string) string {
+	word = strings.TrimLeft(word, "(<[\"'“‘«")
+	return strings.TrimRight(word, ".,;:!?)>]\"'”’»")
+}
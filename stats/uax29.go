@@ -0,0 +1,305 @@
+package stats
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// wordBreakClass represents the Word_Break property of a rune (Unicode Standard Annex #29), reduced to the classes the word rules need.
+type wordBreakClass int
+
+const (
+	wbOther wordBreakClass = iota
+	wbALetter
+	wbNumeric
+	wbKatakana
+	wbIdeographic
+	wbMidLetter
+	wbMidNum
+	wbMidNumLet
+	wbExtend
+	wbExtendNumLet
+)
+
+// segmentRune represents a rune of a text with its Word_Break class and byte offset.
+type segmentRune struct {
+	class  wordBreakClass
+	offset int
+}
+
+// ====== Functions ======
+
+// WithUAX29Words sets the Tokenizer to `UAX29Words`, so words are split by Unicode word boundaries instead of whitespaces.
+func WithUAX29Words() Option {
+	return WithTokenizer(UAX29Words)
+}
+
+// UAX29Words accepts a string and returns its words split by the word boundary rules of Unicode Text Segmentation (UAX #29). It can be used as a Tokenizer.
+// Only the segments with letters or numbers are returned, so punctuation, symbols, and emoji are dropped.
+// As in the standard, hyphenated words are split ("well-known" is two words), contractions ("don't", "don’t") and numbers ("3.14", "1,000") are one word,
+// every Han ideograph and hiragana character is a word, and katakana runs are one word.
+// URLs, email addresses, and file paths are kept as one word, as they are read as one.
+// The rules are an approximation of the standard based on the `unicode` package tables: the Word_Break property is derived from general categories and scripts,
+// and the rules for emoji, regional indicators, and Hebrew letters are not implemented.
+func UAX29Words(text string) []string {
+	var words []string
+	for _, field := range strings.Fields(text) {
+		if trimmed := trimURLLike(field); isURLLike(trimmed) {
+			words = append(words, trimmed)
+			continue
+		}
+		words = append(words, uax29Words(field)...)
+	}
+	return words
+}
+
+// uax29Words returns the words of the text split by the word boundary rules. The rules never join words across whitespaces, so the fields of a text can be split separately.
+func uax29Words(text string) []string {
+	runes := make([]segmentRune, 0, len(text))
+	for i, char := range text {
+		runes = append(runes, segmentRune{wordBreakClassOf(char), i})
+	}
+	end := func(i int) int {
+		if i < len(runes) {
+			return runes[i].offset
+		}
+		return len(text)
+	}
+
+	var words []string
+	for i := 0; i < len(runes); {
+		class := runes[i].class
+		j := skipExtend(runes, i+1)
+		switch class {
+		case wbALetter, wbNumeric, wbKatakana, wbExtendNumLet:
+			last := class
+			for j < len(runes) {
+				next := runes[j].class
+				if joinsWord(last, next) {
+					last = next
+					j = skipExtend(runes, j+1)
+					continue
+				}
+				// WB6, WB7, WB11, WB12: letters or numbers joined by a middle punctuation ("don't", "3.14").
+				k := skipExtend(runes, j+1)
+				if k < len(runes) && joinsAcross(last, next, runes[k].class) {
+					last = runes[k].class
+					j = skipExtend(runes, k+1)
+					continue
+				}
+				break
+			}
+			if class != wbExtendNumLet || strings.IndexFunc(text[runes[i].offset:end(j)], isLetterOrNumber) >= 0 {
+				words = append(words, text[runes[i].offset:end(j)])
+			}
+		case wbIdeographic:
+			words = append(words, text[runes[i].offset:end(j)])
+		}
+		i = j
+	}
+	return words
+}
+
+// joinsWord reports whether there is no word boundary between the runes of the classes (WB5, WB8, WB9, WB10, WB13, WB13a, WB13b).
+func joinsWord(prev, next wordBreakClass) bool {
+	switch {
+	case prev == wbKatakana || next == wbKatakana:
+		return (prev == wbKatakana || prev == wbExtendNumLet) && (next == wbKatakana || next == wbExtendNumLet)
+	case prev == wbExtendNumLet || next == wbExtendNumLet:
+		return isWordClass(prev) && isWordClass(next)
+	}
+	return (prev == wbALetter || prev == wbNumeric) && (next == wbALetter || next == wbNumeric)
+}
+
+// joinsAcross reports whether the middle rune doesn't break the word between the previous and the next runes (WB6, WB7, WB11, WB12).
+func joinsAcross(prev, middle, next wordBreakClass) bool {
+	if prev == wbALetter && next == wbALetter {
+		return middle == wbMidLetter || middle == wbMidNumLet
+	}
+	if prev == wbNumeric && next == wbNumeric {
+		return middle == wbMidNum || middle == wbMidNumLet
+	}
+	return false
+}
+
+// isWordClass reports whether the class can be a part of a word.
+func isWordClass(class wordBreakClass) bool {
+	return class == wbALetter || class == wbNumeric || class == wbKatakana || class == wbExtendNumLet
+}
+
+// skipExtend returns the index of the first rune starting from i that is not Extend or Format (WB4).
+func skipExtend(runes []segmentRune, i int) int {
+	for i < len(runes) && runes[i].class == wbExtend {
+		i++
+	}
+	return i
+}
+
+// wordBreakClassOf returns the Word_Break class of the rune.
+func wordBreakClassOf(char rune) wordBreakClass {
+	switch {
+	case unicode.Is(unicode.Katakana, char) || char == 'ー' || char == '゛' || char == '゜':
+		return wbKatakana
+	case unicode.Is(unicode.Han, char) || unicode.Is(unicode.Hiragana, char):
+		return wbIdeographic
+	case unicode.IsLetter(char):
+		return wbALetter
+	case unicode.Is(unicode.Nd, char):
+		return wbNumeric
+	case unicode.In(char, unicode.Mn, unicode.Me, unicode.Mc, unicode.Cf):
+		return wbExtend
+	case unicode.Is(unicode.Pc, char):
+		return wbExtendNumLet
+	case strings.ContainsRune(".'‘’․﹒＇．", char):
+		return wbMidNumLet
+	case strings.ContainsRune(":··״‧︓﹕：", char):
+		return wbMidLetter
+	case strings.ContainsRune(",;;։،؍٬߸⁄︐︔﹐﹔，；", char):
+		return wbMidNum
+	}
+	return wbOther
+}
+
+// isLetterOrNumber reports whether the rune is a letter or a number.
+func isLetterOrNumber(char rune) bool {
+	return unicode.IsLetter(char) || unicode.IsNumber(char)
+}
+
+// WithUAX29Sentences sets the sentence segmenter to `UAX29Sentences`, so sentences are split by Unicode sentence boundaries.
+func WithUAX29Sentences() Option {
+	return WithSentenceSegmenter(UAX29Sentences)
+}
+
+// UAX29Sentences accepts a string and returns its sentences split by the sentence boundary rules of Unicode Text Segmentation (UAX #29). It can be used as a SentenceSegmenter.
+// Unlike the default segmenter, it handles the non-Latin terminators ("。", "！", "？", "।", "؟") and breaks after every line break (paragraph separator).
+// As in the standard, a point doesn't end a sentence before a number ("3.14"), between letters ("U.S"), or when the next word starts with a lower case letter ("etc. and so on").
+// Abbreviations are not taken into account. `Terminated` is false for the sentences without a terminator, such as the lines without one.
+// Whitespaces between sentences are not included in them.
+func UAX29Sentences(text string) []Sentence {
+	var sentences []Sentence
+	emit := func(start, end int, terminated bool) {
+		part := text[start:end]
+		trimmed := strings.TrimLeftFunc(part, unicode.IsSpace)
+		start += len(part) - len(trimmed)
+		trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+		if len(trimmed) > 0 {
+			sentences = append(sentences, Sentence{trimmed, start, start + len(trimmed), terminated})
+		}
+	}
+
+	start := 0
+	var prev rune
+	for i := 0; i < len(text); {
+		char, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case isParagraphSeparator(char):
+			// SB3, SB4: break after a paragraph separator, "\r\n" is one separator.
+			end := i + size
+			if char == '\r' && strings.HasPrefix(text[end:], "\n") {
+				end++
+			}
+			emit(start, end, false)
+			start, i, prev = end, end, char
+			continue
+		case isATerm(char) || isSTerm(char):
+			if isATerm(char) {
+				next, _ := utf8.DecodeRuneInString(text[i+size:])
+				// SB6, SB7: "3.14", "U.S".
+				if unicode.IsDigit(next) || ((unicode.IsUpper(prev) || unicode.IsLower(prev)) && unicode.IsUpper(next)) {
+					break
+				}
+			}
+			end, ok := sentenceBreakAfter(text, i)
+			if !ok {
+				break
+			}
+			emit(start, end, true)
+			start, i, prev = end, end, char
+			continue
+		}
+		prev = char
+		i += size
+	}
+	emit(start, len(text), false)
+	return sentences
+}
+
+// sentenceBreakAfter accepts the index of a terminator and returns the index after the terminators, closing punctuation, whitespaces, and a paragraph separator following it,
+// and whether there is a sentence boundary there (SB8, SB8a, SB9, SB10, SB11).
+func sentenceBreakAfter(text string, i int) (int, bool) {
+	aTerm := false
+	for i < len(text) {
+		char, size := utf8.DecodeRuneInString(text[i:])
+		if !isATerm(char) && !isSTerm(char) {
+			break
+		}
+		aTerm = isATerm(char)
+		i += size
+	}
+	i = skipRunes(text, i, isSentenceClose)
+	i = skipRunes(text, i, func(c rune) bool { return unicode.IsSpace(c) && !isParagraphSeparator(c) })
+
+	if next, size := utf8.DecodeRuneInString(text[i:]); i < len(text) && isParagraphSeparator(next) {
+		i += size
+		if next == '\r' && strings.HasPrefix(text[i:], "\n") {
+			i++
+		}
+		return i, true
+	}
+	if i == len(text) {
+		return i, true
+	}
+
+	next, _ := utf8.DecodeRuneInString(text[i:])
+	// SB8a: "?!", "etc., and".
+	if strings.ContainsRune(",;:、，；：", next) {
+		return i, false
+	}
+	// SB8: a point followed by a lower case word is not a sentence end.
+	if aTerm {
+		for _, char := range text[i:] {
+			if unicode.IsLower(char) {
+				return i, false
+			}
+			if unicode.IsLetter(char) || isParagraphSeparator(char) || isATerm(char) || isSTerm(char) {
+				break
+			}
+		}
+	}
+	return i, true
+}
+
+// skipRunes returns the index of the first rune starting from i that doesn't satisfy the function.
+func skipRunes(text string, i int, f func(rune) bool) int {
+	for i < len(text) {
+		char, size := utf8.DecodeRuneInString(text[i:])
+		if !f(char) {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// isATerm reports whether the rune is a point (Sentence_Break=ATerm).
+func isATerm(char rune) bool {
+	return strings.ContainsRune(".․﹒．", char)
+}
+
+// isSTerm reports whether the rune is a sentence terminator other than a point (Sentence_Break=STerm).
+func isSTerm(char rune) bool {
+	return strings.ContainsRune("!?‼‽⁇⁈⁉。！？｡։؟۔܀܁܂।॥።፧፨᙮᠃᠉", char)
+}
+
+// isSentenceClose reports whether the rune is a quote or a bracket (Sentence_Break=Close).
+func isSentenceClose(char rune) bool {
+	return char == '"' || char == '\'' || unicode.In(char, unicode.Ps, unicode.Pe, unicode.Pi, unicode.Pf)
+}
+
+// isParagraphSeparator reports whether the rune separates paragraphs (Sentence_Break=Sep, CR, LF).
+func isParagraphSeparator(char rune) bool {
+	return char == '\n' || char == '\r' || char == '\u0085' || char == ' ' || char == ' '
+}
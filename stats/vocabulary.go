@@ -0,0 +1,191 @@
+package stats
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ====== Types ======
+
+// FrequencyList represents a list of words ranked by their frequency in a corpus, the most frequent word having rank 1,
+// such as a list derived from SUBTLEX or wordfreq. The package has no list of its own: a list is loaded with `LoadFrequencyList`
+// or made with `NewFrequencyList` and is used under the license of its source.
+type FrequencyList struct {
+	ranks map[string]uint
+}
+
+// FrequencyBand represents the words of a text that are among the most frequent words of a frequency list, up to MaxRank.
+// The bands are cumulative: the top 2000 words include the top 1000.
+type FrequencyBand struct {
+	MaxRank    uint    `json:"max_rank"`
+	Words      uint    `json:"words"`
+	Percentage float64 `json:"percentage"`
+}
+
+// VocabularyProfile represents the vocabulary of a text profiled against a frequency list: the shares of its words in the frequency bands,
+// the words missing in the list, and the rare words, which are out of the last band or missing in the list.
+type VocabularyProfile struct {
+	Words             uint            `json:"words"`
+	Bands             []FrequencyBand `json:"bands"`
+	OffList           uint            `json:"off_list"`
+	OffListPercentage float64         `json:"off_list_percentage"`
+	RareWords         []Token         `json:"rare_words"`
+}
+
+// DefaultFrequencyBands are the maximal ranks of the common frequency bands: the top 1000, 2000, 5000, and 10000 words.
+var DefaultFrequencyBands = []uint{1000, 2000, 5000, 10000}
+
+// ====== Methods ======
+
+// Rank accepts a word and returns its rank in the list and true, or false if the word is missing in the list.
+// The word is matched case-insensitively and without the leading and trailing punctuation.
+func (l *FrequencyList) Rank(word string) (uint, bool) {
+	rank, ok := l.ranks[dictionaryKey(word)]
+	return rank, ok
+}
+
+// Len returns the number of words in the list.
+func (l *FrequencyList) Len() int {
+	return len(l.ranks)
+}
+
+// ====== Functions ======
+
+// NewFrequencyList accepts words in the order of their frequency, the most frequent first, and returns the list of them. A repeated word keeps its first rank.
+func NewFrequencyList(words []string) *FrequencyList {
+	l := &FrequencyList{ranks: make(map[string]uint, len(words))}
+	for _, word := range words {
+		key := dictionaryKey(word)
+		if _, ok := l.ranks[key]; !ok && key != "" {
+			l.ranks[key] = uint(len(l.ranks) + 1)
+		}
+	}
+	return l
+}
+
+// LoadFrequencyList accepts a reader of a frequency list and returns the list. Every line starts with a word, and blank lines and lines starting with "#" are skipped.
+// If every line has a number of occurrences as its second field ("the 1501908"), as in the tables of SUBTLEX, the words are ranked by the numbers,
+// otherwise by the order of the lines. Other fields are ignored.
+func LoadFrequencyList(r io.Reader) (*FrequencyList, error) {
+	var words []string
+	var counts []float64
+	counted := true
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		words = append(words, fields[0])
+		if !counted {
+			continue
+		}
+		if len(fields) < 2 {
+			counted = false
+			continue
+		}
+		count, err := strconv.ParseFloat(fields[1], 64)
+		counted = err == nil
+		counts = append(counts, count)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if counted {
+		order := make([]int, len(words))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return counts[order[i]] > counts[order[j]]
+		})
+		ranked := make([]string, len(words))
+		for i, index := range order {
+			ranked[i] = words[index]
+		}
+		words = ranked
+	}
+	return NewFrequencyList(words), nil
+}
+
+// LoadFrequencyListFile accepts a path to a frequency list file and returns the list. See `LoadFrequencyList`.
+func LoadFrequencyListFile(path string) (*FrequencyList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadFrequencyList(file)
+}
+
+// WithFrequencyList sets the frequency list the vocabulary of the text is estimated by, for example, the CEFR level of the vocabulary.
+func WithFrequencyList(list *FrequencyList) Option {
+	return func(c *Config) {
+		c.FrequencyList = list
+	}
+}
+
+// ProfileVocabulary accepts a non-empty string, a frequency list, the maximal ranks of the frequency bands, and options and returns the VocabularyProfile of the string.
+// If the bands are nil, `DefaultFrequencyBands` are used. Only the words with letters are profiled, so numbers are neither in the list nor off it.
+// A word missing in the list is looked up by its lemma if a lemmatizer is configured (see `WithLemmatizer`), so the lists of lemmas can be used.
+// The percentages are rounded to the first decimal point. The share of the top 2000 words is commonly used to estimate the CEFR level of the vocabulary.
+func ProfileVocabulary(s string, list *FrequencyList, bands []uint, opts ...Option) (VocabularyProfile, error) {
+	if len(s) == 0 {
+		return VocabularyProfile{}, errors.New("Empty string.")
+	}
+	if list == nil || list.Len() == 0 {
+		return VocabularyProfile{}, errors.New("Empty frequency list. Cannot profile vocabulary.")
+	}
+	if bands == nil {
+		bands = DefaultFrequencyBands
+	}
+	bands = append([]uint(nil), bands...)
+	sort.Slice(bands, func(i, j int) bool { return bands[i] < bands[j] })
+
+	cfg := NewConfig(opts...)
+	profile := VocabularyProfile{Bands: make([]FrequencyBand, len(bands))}
+	for _, word := range tokenize(s, cfg.Tokenizer) {
+		if strings.IndexFunc(word.Text, unicode.IsLetter) < 0 {
+			continue
+		}
+		profile.Words++
+		rank, ok := list.Rank(word.Text)
+		if !ok && cfg.Lemmatizer != nil {
+			rank, ok = list.Rank(cfg.Lemmatizer(strings.ToLower(normalizeApostrophes(word.Text))))
+		}
+		if !ok {
+			profile.OffList++
+		}
+		for i, maxRank := range bands {
+			if ok && rank <= maxRank {
+				profile.Bands[i].Words++
+			}
+		}
+		if !ok || (len(bands) > 0 && rank > bands[len(bands)-1]) {
+			profile.RareWords = append(profile.RareWords, word)
+		}
+	}
+	if profile.Words == 0 {
+		return VocabularyProfile{}, errors.New("No words were parsed. Cannot profile vocabulary.")
+	}
+
+	for i, maxRank := range bands {
+		profile.Bands[i].MaxRank = maxRank
+		profile.Bands[i].Percentage = percentage(profile.Bands[i].Words, profile.Words)
+	}
+	profile.OffListPercentage = percentage(profile.OffList, profile.Words)
+	return profile, nil
+}
+
+// percentage returns the part as a percentage of the whole, rounded to the first decimal point.
+func percentage(part, whole uint) float64 {
+	return math.Round(ratio(part, whole)*1000) / 10
+}
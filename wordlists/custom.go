@@ -0,0 +1,137 @@
+package wordlists
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"goreadability/stats"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// ====== Types ======
+
+// WordList represents a list of words loaded at runtime, such as the approved vocabulary of an organization.
+// The words are case-folded, and, if the list has a stemmer, the words of a text match the words of the list with the same stem ("walked" matches "walk").
+type WordList struct {
+	words   map[string]bool
+	stems   map[string]bool
+	stemmer stats.Stemmer
+}
+
+// ====== Methods ======
+
+// Contains accepts a word and reports whether it is in the list. The word is matched case-insensitively, without the leading and trailing punctuation,
+// and with typographic apostrophes made straight, and then by its stem if the list has a stemmer.
+// It can be passed to `stats.WithFamiliarWords`.
+func (l *WordList) Contains(word string) bool {
+	key := wordKey(word)
+	if l.words[key] {
+		return true
+	}
+	return l.stemmer != nil && l.stems[l.stemmer.Stem(key)]
+}
+
+// Add adds the words to the list.
+func (l *WordList) Add(words ...string) {
+	for _, word := range words {
+		key := wordKey(word)
+		if key == "" {
+			continue
+		}
+		l.words[key] = true
+		if l.stemmer != nil {
+			l.stems[l.stemmer.Stem(key)] = true
+		}
+	}
+}
+
+// Len returns the number of words in the list.
+func (l *WordList) Len() int {
+	return len(l.words)
+}
+
+// DifficultRatio accepts a non-empty string and options and returns the share of its words that are not in the list, from 0 to 1. See the `DifficultRatio` function.
+func (l *WordList) DifficultRatio(text string, opts ...stats.Option) (float64, error) {
+	return difficultRatio(text, l.Contains, opts...)
+}
+
+// ====== Functions ======
+
+// NewWordList accepts words and a stemmer (for example, `stats.PorterStemmer{}`) and returns the list of the words.
+// The stemmer accepts a word in lower case and returns its stem. It can be nil to match the words as they are.
+func NewWordList(words []string, stemmer stats.Stemmer) *WordList {
+	l := &WordList{words: map[string]bool{}, stems: map[string]bool{}, stemmer: stemmer}
+	l.Add(words...)
+	return l
+}
+
+// LoadWordList accepts a reader of a plain-text word list and a stemmer and returns the list. The list has one word or phrase per line.
+// Blank lines and lines starting with "#" are skipped. See `NewWordList`.
+func LoadWordList(r io.Reader, stemmer stats.Stemmer) (*WordList, error) {
+	var words []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+		words = append(words, word)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewWordList(words, stemmer), nil
+}
+
+// LoadWordListJSON accepts a reader of a JSON array of words (`["walk", "run"]`) and a stemmer and returns the list. See `NewWordList`.
+func LoadWordListJSON(r io.Reader, stemmer stats.Stemmer) (*WordList, error) {
+	var words []string
+	if err := json.NewDecoder(r).Decode(&words); err != nil {
+		return nil, err
+	}
+	return NewWordList(words, stemmer), nil
+}
+
+// LoadWordListFile accepts a path to a word list file and a stemmer and returns the list.
+// Files with the ".json" extension are loaded as JSON (see `LoadWordListJSON`), and other files as plain text (see `LoadWordList`).
+func LoadWordListFile(path string, stemmer stats.Stemmer) (*WordList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return LoadWordListJSON(file, stemmer)
+	}
+	return LoadWordList(file, stemmer)
+}
+
+// difficultRatio returns the share of the words of the text that are not familiar, from 0 to 1.
+func difficultRatio(text string, isFamiliar func(word string) bool, opts ...stats.Option) (float64, error) {
+	if len(text) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	words := stats.Words(text, opts...)
+	if len(words) == 0 {
+		return 0, errors.New("No words were parsed. Cannot calculate the ratio of difficult words.")
+	}
+	difficult := 0
+	for _, word := range words {
+		if !isFamiliar(word.Text) {
+			difficult++
+		}
+	}
+	return float64(difficult) / float64(len(words)), nil
+}
+
+// wordKey returns the word as it is stored in a list: in lower case, without the leading and trailing punctuation, and with straight apostrophes.
+func wordKey(word string) string {
+	word = strings.TrimFunc(word, func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsNumber(c)
+	})
+	return strings.ToLower(strings.ReplaceAll(word, "’", "'"))
+}
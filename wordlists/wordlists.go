@@ -0,0 +1,42 @@
+// Package `wordlists` provides the word lists used by the readability formulas, such as the Dale–Chall list of familiar words,
+// so they can be used independently of the formulas.
+package wordlists
+
+import (
+	"goreadability/stats"
+	"strings"
+)
+
+// ====== Functions ======
+
+// IsFamiliar accepts a word and reports whether it is in the Dale–Chall list of familiar words. The check is case-insensitive, and possessives ("boy's") are familiar if the word is.
+// It can be passed to `stats.WithFamiliarWords`.
+func IsFamiliar(word string) bool {
+	word = strings.ToLower(word)
+	if _, ok := daleChall[word]; ok {
+		return true
+	}
+	for _, suffix := range []string{"'s", "’s"} {
+		if strings.HasSuffix(word, suffix) {
+			_, ok := daleChall[strings.TrimSuffix(word, suffix)]
+			return ok
+		}
+	}
+	return false
+}
+
+// DaleChall accepts a stemmer and returns the Dale–Chall list of familiar words as a WordList, so the forms of the familiar words are familiar too:
+// with `stats.PorterStemmer{}`, "walked" matches "walk". The stemmer can be nil to match the words as `IsFamiliar` does, except for the possessives.
+func DaleChall(stemmer stats.Stemmer) *WordList {
+	words := make([]string, 0, len(daleChall))
+	for word := range daleChall {
+		words = append(words, word)
+	}
+	return NewWordList(words, stemmer)
+}
+
+// DifficultRatio accepts a non-empty string and options and returns the share of its words that are not in the Dale–Chall list of familiar words (see `IsFamiliar`),
+// from 0 to 1. The words are split as in `stats.Words`. The string must contain at least one word. The ratio is not rounded.
+func DifficultRatio(text string, opts ...stats.Option) (float64, error) {
+	return difficultRatio(text, IsFamiliar, opts...)
+}
@@ -0,0 +1,126 @@
+package zh
+
+import (
+	"bufio"
+	"errors"
+	"goreadability/stats"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ====== Types ======
+
+// StrokeCounts represents the numbers of strokes of Han characters, such as the kTotalStrokes property of the Unihan database.
+// No table is embedded, so it is loaded with `LoadStrokeCounts`.
+type StrokeCounts struct {
+	strokes map[rune]uint
+}
+
+// ====== Methods ======
+
+// Strokes accepts a character and returns its number of strokes and true, or false if the character is missing in the table.
+func (c *StrokeCounts) Strokes(char rune) (uint, bool) {
+	strokes, ok := c.strokes[char]
+	return strokes, ok
+}
+
+// Len returns the number of characters in the table.
+func (c *StrokeCounts) Len() int {
+	return len(c.strokes)
+}
+
+// ====== Functions ======
+
+// LoadStrokeCounts accepts a reader of a table of stroke counts and returns the table. Every line is a character and its number of strokes ("的 8"),
+// or a line of the Unihan database ("U+7684\tkTotalStrokes\t8"), in which case the lines of other properties are skipped and the first of several counts
+// (the count of the Simplified form) is used. Blank lines and lines starting with "#" are skipped.
+func LoadStrokeCounts(r io.Reader) (*StrokeCounts, error) {
+	c := &StrokeCounts{strokes: map[rune]uint{}}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) > 2 && strings.HasPrefix(fields[1], "k") {
+			if fields[1] != "kTotalStrokes" {
+				continue
+			}
+			fields = append(fields[:1], fields[2:]...)
+		}
+		if len(fields) < 2 {
+			return nil, errors.New("No stroke count in the line. Cannot load stroke counts.")
+		}
+		char, err := parseStrokeCharacter(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		strokes, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		c.strokes[char] = uint(strokes)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadStrokeCountsFile accepts a path to a table of stroke counts and returns the table. See `LoadStrokeCounts`.
+func LoadStrokeCountsFile(path string) (*StrokeCounts, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadStrokeCounts(file)
+}
+
+// CalcAverageStrokes accepts a non-empty string, a table of stroke counts, and options and returns the average number of strokes of its Han characters,
+// a measure of their visual complexity. Traditional characters are counted by their Simplified forms, so both scripts are measured on the same scale,
+// and the characters missing in the table are not counted. The string must contain at least one character of the table.
+// The calculated average is rounded to the second decimal point.
+func CalcAverageStrokes(s string, counts *StrokeCounts, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	var characters, strokes uint
+	for _, char := range stats.NewDocument(s, opts...).Text() {
+		if !unicode.Is(unicode.Han, char) {
+			continue
+		}
+		if simplified, ok := traditionalToSimplified[char]; ok {
+			char = simplified
+		}
+		if n, ok := counts.Strokes(char); ok {
+			characters++
+			strokes += n
+		}
+	}
+	if characters == 0 {
+		return 0, errors.New("No characters with known stroke counts were parsed. Cannot calculate average strokes.")
+	}
+	return math.Round(float64(strokes)/float64(characters)*100) / 100, nil
+}
+
+// parseStrokeCharacter returns the character of a table line: the character itself or its code point ("U+7684").
+func parseStrokeCharacter(field string) (rune, error) {
+	if code, ok := strings.CutPrefix(field, "U+"); ok {
+		char, err := strconv.ParseUint(code, 16, 32)
+		if err != nil {
+			return 0, err
+		}
+		return rune(char), nil
+	}
+	char, size := utf8.DecodeRuneInString(field)
+	if size != len(field) {
+		return 0, errors.New("Not a single character in the line. Cannot load stroke counts.")
+	}
+	return char, nil
+}
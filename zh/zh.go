@@ -0,0 +1,353 @@
+// Package `zh` provides functions and types to calculate the readability for texts in Chinese language, both Simplified and Traditional.
+// 1. Character-based readability score (average sentence length in characters and share of uncommon characters), experimental
+// 2. Average number of strokes of the characters, with a table of stroke counts loaded from the Unihan database
+//
+// Chinese is written without spaces between words, so the space-based counters of `stats` package cannot be used.
+// Instead, the text is split into sentences by full-width punctuation (`。`, `！`, `？`, `；`, `…`) and every Han character is counted separately.
+package zh
+
+import (
+	"errors"
+	"goreadability/internal/cjk"
+	"goreadability/stats"
+	"math"
+	"unicode"
+)
+
+// terminators are the runes that end a Chinese sentence.
+const terminators = "。！？；…!?;"
+
+// ====== Types ======
+
+// Script represents the Chinese writing system of a text.
+type Script int
+
+const (
+	Unknown Script = iota
+	Simplified
+	Traditional
+)
+
+// String returns the name of the script.
+func (s Script) String() string {
+	switch s {
+	case Simplified:
+		return "Simplified"
+	case Traditional:
+		return "Traditional"
+	}
+	return "Unknown"
+}
+
+// MarshalText encodes the script as its name, so it is marshaled to JSON as a string.
+func (s Script) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes the script from its name. Unknown names are decoded as Unknown.
+func (s *Script) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Simplified":
+		*s = Simplified
+	case "Traditional":
+		*s = Traditional
+	default:
+		*s = Unknown
+	}
+	return nil
+}
+
+// TextStats represents the statistics of a Chinese text.
+type TextStats struct {
+	Sentences          uint   `json:"sentences"`
+	Characters         uint   `json:"characters"`
+	UncommonCharacters uint   `json:"uncommon_characters"`
+	Script             Script `json:"script"`
+}
+
+// ====== Functions ======
+
+// CalcReadability accepts a non-empty string and options and returns the character-based readability score for it. The string must contain at least one Han character and at least one sentence.
+// The score follows the structure of the Dale–Chall formula, where the list of familiar words is replaced by the list of about 3000 most frequent characters:
+// 0.1579 * (percentage of uncommon characters) + 0.0496 * (characters / sentences). Higher score means more difficult text.
+// The score is experimental: the coefficients are the ones of Dale–Chall for English and were not validated on Chinese texts, so it doesn't map to grade levels
+// and is meant for comparing Chinese texts with each other. Traditional characters are converted to their Simplified forms before the lookup, so both scripts are scored on the same scale.
+// The calculated score is rounded to the second decimal point.
+func CalcReadability(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return CalcReadabilityDoc(stats.NewDocument(s, opts...))
+}
+
+// CalcReadabilityRaw accepts a non-empty string and options and returns the character-based readability score for it without rounding. See `CalcReadability`.
+func CalcReadabilityRaw(s string, opts ...stats.Option) (float64, error) {
+	if len(s) == 0 {
+		return 0, errors.New("Empty string.")
+	}
+	return readabilityRaw(stats.NewDocument(s, opts...))
+}
+
+// CalcReadabilityDoc accepts a Document and returns the character-based readability score for it. See `CalcReadability`.
+// Only the text of the document is used, as its space-based statistics do not apply to Chinese.
+func CalcReadabilityDoc(doc *stats.Document) (float64, error) {
+	score, err := readabilityRaw(doc)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(score*100) / 100, nil
+}
+
+// readabilityRaw returns the character-based readability score without rounding.
+func readabilityRaw(doc *stats.Document) (float64, error) {
+	textStats := CountStats(doc.Text())
+	if textStats.Characters == 0 {
+		return 0, errors.New("No Han characters were parsed. Cannot calculate readability score.")
+	}
+	if textStats.Sentences == 0 {
+		return 0, errors.New("No sentences were parsed. Cannot calculate readability score.")
+	}
+
+	characters := float64(textStats.Characters)
+	uncommonPerc := float64(textStats.UncommonCharacters) / characters * 100
+
+	score := 0.1579*uncommonPerc + 0.0496*(characters/float64(textStats.Sentences))
+	return score, nil
+}
+
+// CountStats accepts a string and returns the number of sentences, Han characters, and uncommon Han characters in it, as well as the detected script.
+func CountStats(s string) TextStats {
+	var result TextStats
+	result.Sentences = uint(len(SplitSentences(s)))
+
+	var simplified, traditional uint
+	for _, char := range s {
+		if !unicode.Is(unicode.Han, char) {
+			continue
+		}
+		result.Characters++
+		if simplifiedChar, ok := traditionalToSimplified[char]; ok {
+			traditional++
+			char = simplifiedChar
+		} else if isSimplifiedOnly(char) {
+			simplified++
+		}
+		if _, ok := commonCharacterSet[char]; !ok {
+			result.UncommonCharacters++
+		}
+	}
+
+	switch {
+	case traditional > simplified:
+		result.Script = Traditional
+	case simplified > 0:
+		result.Script = Simplified
+	}
+	return result
+}
+
+// SplitSentences accepts a string and splits it into sentences ended with `。`, `！`, `？`, `；`, `…` (or their half-width forms).
+// Closing quotes and brackets right after a terminator (`。」`, `！”`) belong to the sentence they close.
+// A trailing text without a terminator is returned as the last sentence. Empty sentences are skipped.
+func SplitSentences(s string) []string {
+	return cjk.SplitSentences(s, terminators)
+}
+
+// isSimplifiedOnly reports whether the character is the Simplified form of one of the characters in `traditionalToSimplified`.
+func isSimplifiedOnly(char rune) bool {
+	_, ok := simplifiedForms[char]
+	return ok
+}
+
+// simplifiedForms is the set of Simplified characters that have a different Traditional form.
+var simplifiedForms = func() map[rune]struct{} {
+	forms := make(map[rune]struct{}, len(traditionalToSimplified))
+	for _, simplified := range traditionalToSimplified {
+		forms[simplified] = struct{}{}
+	}
+	return forms
+}()
+
+// traditionalToSimplified maps the Traditional characters to their Simplified forms. It covers the Traditional forms of the characters in `commonCharacters`
+// (the one-to-one pairs of ICU's "Hant-Hans" transform), so a Traditional text is not scored as more difficult than the same text in Simplified characters.
+var traditionalToSimplified = map[rune]rune{
+	'丟': '丢', '並': '并', '亂': '乱', '亞': '亚', '佔': '占', '來': '来', '侖': '仑', '侶': '侣', '俠': '侠', '倆': '俩',
+	'倉': '仓', '個': '个', '們': '们', '倫': '伦', '偉': '伟', '側': '侧', '偵': '侦', '偽': '伪', '傑': '杰', '傘': '伞',
+	'備': '备', '傭': '佣', '傳': '传', '債': '债', '傷': '伤', '傾': '倾', '僅': '仅', '僑': '侨', '僕': '仆', '價': '价',
+	'儀': '仪', '億': '亿', '儉': '俭', '償': '偿', '優': '优', '儲': '储', '兌': '兑', '兒': '儿', '內': '内', '兩': '两',
+	'冊': '册', '凍': '冻', '凱': '凯', '別': '别', '刪': '删', '則': '则', '剎': '刹', '剛': '刚', '剝': '剥', '創': '创',
+	'劃': '划', '劇': '剧', '劉': '刘', '劍': '剑', '劑': '剂', '勁': '劲', '動': '动', '務': '务', '勝': '胜', '勞': '劳',
+	'勢': '势', '勳': '勋', '勵': '励', '勸': '劝', '勻': '匀', '匯': '汇', '區': '区', '協': '协', '卻': '却', '厭': '厌',
+	'厲': '厉', '參': '参', '叢': '丛', '吳': '吴', '吶': '呐', '呂': '吕', '員': '员', '問': '问', '啓': '启', '啞': '哑',
+	'喚': '唤', '喪': '丧', '喬': '乔', '單': '单', '喲': '哟', '嗎': '吗', '嗚': '呜', '嘆': '叹', '嘔': '呕', '嘗': '尝',
+	'嘩': '哗', '嘯': '啸', '噓': '嘘', '噴': '喷', '噸': '吨', '嚇': '吓', '嚴': '严', '囂': '嚣', '囑': '嘱', '國': '国',
+	'圍': '围', '園': '园', '圓': '圆', '圖': '图', '團': '团', '執': '执', '堅': '坚', '報': '报', '場': '场', '塊': '块',
+	'塗': '涂', '塵': '尘', '墊': '垫', '墜': '坠', '墮': '堕', '墳': '坟', '壇': '坛', '壓': '压', '壘': '垒', '壞': '坏',
+	'壟': '垄', '壩': '坝', '壯': '壮', '壺': '壶', '壽': '寿', '夠': '够', '夢': '梦', '夾': '夹', '奧': '奥', '奪': '夺',
+	'奮': '奋', '妝': '妆', '娛': '娱', '婦': '妇', '婭': '娅', '媽': '妈', '嬌': '娇', '嬰': '婴', '嬸': '婶', '孫': '孙',
+	'學': '学', '宮': '宫', '寢': '寝', '實': '实', '寧': '宁', '審': '审', '寫': '写', '寬': '宽', '寵': '宠', '寶': '宝',
+	'將': '将', '專': '专', '尋': '寻', '對': '对', '導': '导', '尷': '尴', '屆': '届', '屍': '尸', '屢': '屡', '層': '层',
+	'屬': '属', '岡': '冈', '島': '岛', '峽': '峡', '崗': '岗', '嵐': '岚', '嶺': '岭', '嶼': '屿', '帥': '帅', '師': '师',
+	'帳': '帐', '帶': '带', '幟': '帜', '幣': '币', '幫': '帮', '幾': '几', '庫': '库', '廁': '厕', '廂': '厢', '廈': '厦',
+	'廚': '厨', '廟': '庙', '廠': '厂', '廢': '废', '廣': '广', '廬': '庐', '廳': '厅', '張': '张', '強': '强', '彈': '弹',
+	'彌': '弥', '彎': '弯', '彥': '彦', '後': '后', '徑': '径', '從': '从', '復': '复', '徵': '征', '徹': '彻', '恆': '恒',
+	'恥': '耻', '悅': '悦', '悶': '闷', '惡': '恶', '惱': '恼', '愛': '爱', '態': '态', '慘': '惨', '慚': '惭', '慣': '惯',
+	'慮': '虑', '慶': '庆', '憂': '忧', '憐': '怜', '憑': '凭', '憤': '愤', '憫': '悯', '憲': '宪', '憶': '忆', '懇': '恳',
+	'應': '应', '懲': '惩', '懶': '懒', '懷': '怀', '懸': '悬', '懼': '惧', '懾': '慑', '戀': '恋', '戰': '战', '戲': '戏',
+	'戶': '户', '拋': '抛', '挾': '挟', '捨': '舍', '掃': '扫', '掙': '挣', '掛': '挂', '採': '采', '揀': '拣', '揚': '扬',
+	'換': '换', '揮': '挥', '損': '损', '搖': '摇', '搗': '捣', '搶': '抢', '摟': '搂', '撈': '捞', '撐': '撑', '撓': '挠',
+	'撥': '拨', '撫': '抚', '撲': '扑', '撿': '捡', '擁': '拥', '擇': '择', '擊': '击', '擋': '挡', '擔': '担', '據': '据',
+	'擠': '挤', '擬': '拟', '擱': '搁', '擲': '掷', '擴': '扩', '擺': '摆', '擾': '扰', '攏': '拢', '攔': '拦', '攜': '携',
+	'攝': '摄', '攤': '摊', '攪': '搅', '攬': '揽', '敗': '败', '敘': '叙', '敵': '敌', '數': '数', '斂': '敛', '斃': '毙',
+	'斬': '斩', '斷': '断', '於': '于', '時': '时', '晉': '晋', '晝': '昼', '暈': '晕', '暢': '畅', '暫': '暂', '曆': '历',
+	'曉': '晓', '曠': '旷', '曬': '晒', '書': '书', '會': '会', '朧': '胧', '東': '东', '柵': '栅', '桿': '杆', '條': '条',
+	'棄': '弃', '棗': '枣', '棟': '栋', '棲': '栖', '楊': '杨', '業': '业', '極': '极', '榮': '荣', '構': '构', '槍': '枪',
+	'槳': '桨', '樁': '桩', '樂': '乐', '樓': '楼', '標': '标', '樞': '枢', '樣': '样', '樸': '朴', '樹': '树', '橋': '桥',
+	'機': '机', '橫': '横', '檔': '档', '檢': '检', '櫃': '柜', '櫻': '樱', '欄': '栏', '權': '权', '欽': '钦', '歐': '欧',
+	'歡': '欢', '歲': '岁', '歷': '历', '歸': '归', '殘': '残', '殲': '歼', '殺': '杀', '殼': '壳', '毀': '毁', '氣': '气',
+	'氫': '氢', '決': '决', '沒': '没', '況': '况', '洩': '泄', '洶': '汹', '涼': '凉', '淒': '凄', '淚': '泪', '淨': '净',
+	'淪': '沦', '淵': '渊', '淺': '浅', '減': '减', '渦': '涡', '測': '测', '渾': '浑', '湊': '凑', '湧': '涌', '湯': '汤',
+	'準': '准', '溝': '沟', '溫': '温', '滅': '灭', '滬': '沪', '滯': '滞', '滲': '渗', '滾': '滚', '滿': '满', '漁': '渔',
+	'漢': '汉', '漲': '涨', '漸': '渐', '漿': '浆', '潑': '泼', '潔': '洁', '潛': '潜', '潤': '润', '潰': '溃', '澀': '涩',
+	'澆': '浇', '澤': '泽', '濁': '浊', '濃': '浓', '濕': '湿', '濟': '济', '濤': '涛', '濫': '滥', '濱': '滨', '濺': '溅',
+	'瀉': '泻', '瀏': '浏', '瀾': '澜', '灑': '洒', '灘': '滩', '灣': '湾', '災': '灾', '為': '为', '烏': '乌', '無': '无',
+	'煉': '炼', '煙': '烟', '煥': '焕', '煩': '烦', '熱': '热', '燈': '灯', '燒': '烧', '燙': '烫', '營': '营', '燦': '灿',
+	'燭': '烛', '爍': '烁', '爐': '炉', '爛': '烂', '爭': '争', '爺': '爷', '爾': '尔', '牆': '墙', '牽': '牵', '犧': '牺',
+	'狀': '状', '狹': '狭', '猶': '犹', '獄': '狱', '獅': '狮', '獎': '奖', '獨': '独', '獲': '获', '獵': '猎', '獸': '兽',
+	'獻': '献', '現': '现', '瑣': '琐', '瑤': '瑶', '瑩': '莹', '瑪': '玛', '璣': '玑', '環': '环', '瓊': '琼', '產': '产',
+	'畝': '亩', '畢': '毕', '畫': '画', '異': '异', '當': '当', '疇': '畴', '疊': '叠', '瘋': '疯', '療': '疗', '癢': '痒',
+	'癱': '瘫', '癲': '癫', '發': '发', '皺': '皱', '盜': '盗', '盞': '盏', '盡': '尽', '監': '监', '盤': '盘', '盧': '卢',
+	'眾': '众', '睜': '睁', '瞞': '瞒', '矯': '矫', '碩': '硕', '確': '确', '碼': '码', '磚': '砖', '礎': '础', '礙': '碍',
+	'礦': '矿', '祿': '禄', '禍': '祸', '禪': '禅', '禮': '礼', '禱': '祷', '禿': '秃', '稅': '税', '稜': '棱', '稟': '禀',
+	'種': '种', '稱': '称', '穌': '稣', '積': '积', '穎': '颖', '穩': '稳', '窩': '窝', '窮': '穷', '窯': '窑', '窺': '窥',
+	'竄': '窜', '竇': '窦', '竊': '窃', '竪': '竖', '競': '竞', '筆': '笔', '節': '节', '範': '范', '築': '筑', '簡': '简',
+	'簽': '签', '簾': '帘', '籃': '篮', '籌': '筹', '籠': '笼', '糞': '粪', '糧': '粮', '糾': '纠', '紀': '纪', '約': '约',
+	'紅': '红', '紋': '纹', '納': '纳', '紐': '纽', '純': '纯', '紗': '纱', '紙': '纸', '級': '级', '紛': '纷', '紡': '纺',
+	'細': '细', '紳': '绅', '紹': '绍', '終': '终', '組': '组', '結': '结', '絕': '绝', '絞': '绞', '絡': '络', '給': '给',
+	'絨': '绒', '統': '统', '絲': '丝', '絹': '绢', '綁': '绑', '綏': '绥', '經': '经', '綜': '综', '綠': '绿', '綢': '绸',
+	'維': '维', '綱': '纲', '網': '网', '綽': '绰', '綿': '绵', '緊': '紧', '緒': '绪', '線': '线', '締': '缔', '緣': '缘',
+	'編': '编', '緩': '缓', '緬': '缅', '緯': '纬', '練': '练', '縛': '缚', '縣': '县', '縫': '缝', '縮': '缩', '縱': '纵',
+	'縷': '缕', '總': '总', '績': '绩', '繃': '绷', '織': '织', '繞': '绕', '繡': '绣', '繩': '绳', '繪': '绘', '繳': '缴',
+	'繼': '继', '續': '续', '纏': '缠', '纖': '纤', '纜': '缆', '罰': '罚', '罵': '骂', '罷': '罢', '羅': '罗', '羨': '羡',
+	'義': '义', '習': '习', '翹': '翘', '聖': '圣', '聞': '闻', '聯': '联', '聰': '聪', '聲': '声', '聳': '耸', '聶': '聂',
+	'職': '职', '聽': '听', '聾': '聋', '肅': '肃', '脅': '胁', '脈': '脉', '脫': '脱', '脹': '胀', '腎': '肾', '腦': '脑',
+	'腫': '肿', '腳': '脚', '腸': '肠', '膚': '肤', '膠': '胶', '膩': '腻', '膽': '胆', '臉': '脸', '臘': '腊', '臥': '卧',
+	'臨': '临', '與': '与', '興': '兴', '舉': '举', '舊': '旧', '艙': '舱', '艦': '舰', '艱': '艰', '艷': '艳', '茲': '兹',
+	'荊': '荆', '莊': '庄', '莖': '茎', '華': '华', '萊': '莱', '萬': '万', '葉': '叶', '蒼': '苍', '蓋': '盖', '蓮': '莲',
+	'蔣': '蒋', '蔥': '葱', '蔭': '荫', '蕩': '荡', '蕭': '萧', '薦': '荐', '薩': '萨', '藍': '蓝', '藝': '艺', '藥': '药',
+	'蘆': '芦', '蘇': '苏', '蘊': '蕴', '蘋': '苹', '蘭': '兰', '蘿': '萝', '處': '处', '虛': '虚', '虜': '虏', '號': '号',
+	'虧': '亏', '蝕': '蚀', '蝦': '虾', '螞': '蚂', '蟲': '虫', '蟻': '蚁', '蠅': '蝇', '蠟': '蜡', '蠶': '蚕', '蠻': '蛮',
+	'術': '术', '衛': '卫', '衝': '冲', '裏': '里', '補': '补', '裝': '装', '裡': '里', '複': '复', '褲': '裤', '襯': '衬',
+	'襲': '袭', '見': '见', '規': '规', '覓': '觅', '視': '视', '親': '亲', '覺': '觉', '覽': '览', '觀': '观', '觸': '触',
+	'訂': '订', '計': '计', '訊': '讯', '討': '讨', '訓': '训', '託': '托', '記': '记', '訝': '讶', '訟': '讼', '訣': '诀',
+	'訪': '访', '設': '设', '許': '许', '訴': '诉', '診': '诊', '詐': '诈', '詔': '诏', '評': '评', '詞': '词', '詢': '询',
+	'試': '试', '詩': '诗', '詫': '诧', '詭': '诡', '話': '话', '該': '该', '詳': '详', '誇': '夸', '認': '认', '誕': '诞',
+	'誘': '诱', '語': '语', '誠': '诚', '誡': '诫', '誤': '误', '誦': '诵', '說': '说', '誰': '谁', '課': '课', '誼': '谊',
+	'調': '调', '談': '谈', '請': '请', '諒': '谅', '論': '论', '諜': '谍', '諧': '谐', '諭': '谕', '諱': '讳', '諷': '讽',
+	'諸': '诸', '諾': '诺', '謀': '谋', '謂': '谓', '謊': '谎', '謎': '谜', '謙': '谦', '講': '讲', '謝': '谢', '謠': '谣',
+	'謬': '谬', '謹': '谨', '證': '证', '譏': '讥', '識': '识', '譚': '谭', '譜': '谱', '譯': '译', '議': '议', '譴': '谴',
+	'護': '护', '譽': '誉', '讀': '读', '變': '变', '讓': '让', '讚': '赞', '豈': '岂', '豐': '丰', '豬': '猪', '貓': '猫',
+	'貝': '贝', '貞': '贞', '負': '负', '財': '财', '貢': '贡', '貧': '贫', '貨': '货', '販': '贩', '貪': '贪', '貫': '贯',
+	'責': '责', '貯': '贮', '貴': '贵', '貶': '贬', '買': '买', '貸': '贷', '費': '费', '貼': '贴', '貿': '贸', '賀': '贺',
+	'賄': '贿', '資': '资', '賈': '贾', '賊': '贼', '賓': '宾', '賜': '赐', '賞': '赏', '賠': '赔', '賢': '贤', '賣': '卖',
+	'賤': '贱', '賦': '赋', '質': '质', '賬': '账', '賭': '赌', '賴': '赖', '賺': '赚', '購': '购', '賽': '赛', '贈': '赠',
+	'贊': '赞', '贏': '赢', '贖': '赎', '趕': '赶', '趙': '赵', '趨': '趋', '跡': '迹', '踐': '践', '蹤': '踪', '躍': '跃',
+	'軀': '躯', '車': '车', '軌': '轨', '軍': '军', '軒': '轩', '軟': '软', '軸': '轴', '軾': '轼', '較': '较', '載': '载',
+	'輓': '挽', '輔': '辅', '輕': '轻', '輛': '辆', '輝': '辉', '輩': '辈', '輪': '轮', '輯': '辑', '輸': '输', '輻': '辐',
+	'輿': '舆', '轄': '辖', '轉': '转', '轎': '轿', '轟': '轰', '辦': '办', '辭': '辞', '辯': '辩', '農': '农', '這': '这',
+	'連': '连', '週': '周', '進': '进', '運': '运', '過': '过', '達': '达', '違': '违', '遙': '遥', '遜': '逊', '遞': '递',
+	'遠': '远', '適': '适', '遲': '迟', '遷': '迁', '選': '选', '遺': '遗', '遼': '辽', '邁': '迈', '還': '还', '邊': '边',
+	'邏': '逻', '郵': '邮', '鄉': '乡', '鄧': '邓', '鄭': '郑', '鄰': '邻', '醜': '丑', '醫': '医', '釀': '酿', '釋': '释',
+	'釐': '厘', '釘': '钉', '針': '针', '釣': '钓', '鈎': '钩', '鈔': '钞', '鈕': '钮', '鈞': '钧', '鈣': '钙', '鈴': '铃',
+	'鉛': '铅', '銀': '银', '銅': '铜', '銘': '铭', '銜': '衔', '銳': '锐', '銷': '销', '鋒': '锋', '鋪': '铺', '鋼': '钢',
+	'錄': '录', '錘': '锤', '錢': '钱', '錦': '锦', '錫': '锡', '錯': '错', '鍋': '锅', '鍛': '锻', '鍵': '键', '鎊': '镑',
+	'鎖': '锁', '鎮': '镇', '鏈': '链', '鏡': '镜', '鏢': '镖', '鐘': '钟', '鐵': '铁', '鑄': '铸', '鑒': '鉴', '鑰': '钥',
+	'鑲': '镶', '鑽': '钻', '鑿': '凿', '長': '长', '門': '门', '閃': '闪', '閉': '闭', '開': '开', '閒': '闲', '間': '间',
+	'閣': '阁', '閥': '阀', '閱': '阅', '閻': '阎', '闆': '板', '闊': '阔', '闖': '闯', '關': '关', '闡': '阐', '陝': '陕',
+	'陣': '阵', '陰': '阴', '陳': '陈', '陸': '陆', '陽': '阳', '隊': '队', '階': '阶', '際': '际', '隨': '随', '險': '险',
+	'隱': '隐', '隸': '隶', '雖': '虽', '雙': '双', '雜': '杂', '雞': '鸡', '離': '离', '難': '难', '雲': '云', '電': '电',
+	'霧': '雾', '靈': '灵', '靜': '静', '鞏': '巩', '韋': '韦', '韓': '韩', '韻': '韵', '響': '响', '頁': '页', '頂': '顶',
+	'頃': '顷', '項': '项', '順': '顺', '須': '须', '頌': '颂', '預': '预', '頑': '顽', '頒': '颁', '頓': '顿', '頗': '颇',
+	'領': '领', '頤': '颐', '頭': '头', '頰': '颊', '頸': '颈', '頹': '颓', '頻': '频', '顆': '颗', '題': '题', '額': '额',
+	'顏': '颜', '願': '愿', '顛': '颠', '類': '类', '顧': '顾', '顫': '颤', '顯': '显', '顱': '颅', '風': '风', '飄': '飘',
+	'飛': '飞', '飢': '饥', '飯': '饭', '飲': '饮', '飼': '饲', '飽': '饱', '飾': '饰', '餅': '饼', '養': '养', '餓': '饿',
+	'餘': '余', '館': '馆', '餵': '喂', '饋': '馈', '饒': '饶', '馬': '马', '馮': '冯', '馳': '驰', '馴': '驯', '駁': '驳',
+	'駐': '驻', '駕': '驾', '駛': '驶', '駝': '驼', '駭': '骇', '駱': '骆', '騎': '骑', '騙': '骗', '騰': '腾', '騷': '骚',
+	'驅': '驱', '驕': '骄', '驗': '验', '驚': '惊', '驟': '骤', '驢': '驴', '髒': '脏', '體': '体', '髮': '发', '鬥': '斗',
+	'鬧': '闹', '魚': '鱼', '魯': '鲁', '鮑': '鲍', '鮮': '鲜', '鯨': '鲸', '鰭': '鳍', '鱗': '鳞', '鳥': '鸟', '鳳': '凤',
+	'鳴': '鸣', '鴉': '鸦', '鴨': '鸭', '鴻': '鸿', '鴿': '鸽', '鵝': '鹅', '鵬': '鹏', '鶯': '莺', '鶴': '鹤', '鷹': '鹰',
+	'鹼': '碱', '鹽': '盐', '麗': '丽', '麥': '麦', '麼': '么', '黃': '黄', '點': '点', '黨': '党', '齊': '齐', '齋': '斋',
+	'齒': '齿', '齡': '龄', '龍': '龙', '龐': '庞', '龜': '龟',
+}
+
+// commonCharacterSet is the set of `commonCharacters`.
+var commonCharacterSet = func() map[rune]struct{} {
+	set := make(map[rune]struct{}, len(commonCharacters)/3)
+	for _, char := range commonCharacters {
+		set[char] = struct{}{}
+	}
+	return set
+}()
+
+// commonCharacters lists about 3000 most frequent Simplified characters, ordered by frequency.
+const commonCharacters = "" +
+	"的一是不了在人有我他这个们中来上大为和国地到以说时要就出会可也你对生能而子那得于着下自之年过发后作里" +
+	"用道行所然家种事成方多经么去法学如都同现没动面起看定天分还进好小部其些主样理心她本前开但因只从想实日" +
+	"军者意无力它与长把机十民第公此已工使情明性知全三又关点正业外将两高间由问很最重并物手应战向头文体政美" +
+	"相见被利什二等产或新己制身果加西斯月话合回特代内信表化老给世位次度门任常先海通教儿原东声提立及比员解" +
+	"水名真论处走义各入几口认条平系气题活尔更别打女变四神总何电数安少报才结反受目太量再感建务做接必场件计" +
+	"管期市直德资命山金指克许统区保至队形社便空决治展马科司五基眼书非则听白却界达光放强即像难且权思王象完" +
+	"设式色路记南品住告类求据程北边死张该交规万取拉格望觉术领共确传师观清今切院让识候带导争运笑飞风步改收" +
+	"根干造言联持组每济车亲极林服快办议往元英士证近失转夫令准布始怎呢存未远叫台单影具罗字爱击流备兵连调深" +
+	"商算质团集百需价花党华城石级整府离况亚请技际约示复病息究线似官火断精满支视消越器容照须九增研写称企八" +
+	"功吗包片史委乎查轻易早曾除农找装广显吧阿李标谈吃图念六引历首医局突专费号尽另周较注语仅考落青随选列武" +
+	"红响虽推势参希古众构房半节土投某案黑维革划敌致陈律足态护七兴派孩验责营星够章音跟志底站严巴例防族供效" +
+	"续施留讲型料终答紧黄绝奇察母京段依批群项故按河米围江织害斗双境客纪采举杀攻父苏密低朝友诉止细愿千值仍" +
+	"男钱破网热助倒育属坐帝限船脸职速刻乐否刚威毛状率甚独球般普怕弹校苦创假久错承印晚兰试股拿脑预谁益阳若" +
+	"哪微尼继送急血惊伤素药适波夜省初喜卫源食险待述陆习置居劳财环排福纳欢雷警获模充负云停木游龙树疑层冷洲" +
+	"冲射略范竟句室异激汉村哈策演简卡罪判担州静退既衣您宗积余痛检差富灵协角占配征修皮挥胜降阶审沉坚善妈刘" +
+	"读啊超免压银买皇养伊怀执副乱抗犯追帮宣佛岁航优怪香著田铁控税左右份穿艺背阵草脚概恶块顿敢守酒岛托央户" +
+	"烈洋哥索胡款靠评版宝座释景顾弟登货互付伯慢欧换闻危忙核暗姐介坏讨丽良序升监临亮露永呼味野架域沙掉括舰" +
+	"鱼杂误湾吉减编楚肯测败屋跑梦散温困剑渐封救贵枪缺楼县尚毫移娘朋画班智亦耳恩短掌恐遗固席松秘谢鲁遇康虑" +
+	"幸均销钟诗藏赶剧票损忽巨炮旧端探湖录叶春乡附吸予礼港雨呀板庭妇归睛饭额含顺输摇招婚脱补谓督毒油疗旅泽" +
+	"材灭逐莫笔亡鲜词圣择寻厂睡博勒烟授诺伦岸奥唐卖俄炸载洛健堂旁宫喝借君禁阴园谋宋避抓荣姑孙逃牙束跳顶玉" +
+	"镇雪午练迫爷篇肉嘴馆遍凡础洞卷坦牛宁纸诸训私庄祖丝翻暴森塔默握戏隐熟骨访弱蒙歌店鬼软典欲萨伙遭盘爸扩" +
+	"盖弄雄稳忘亿刺拥徒姆杨齐赛趣曲刀床迎冰虚玩析窗醒妻透购替塞努休虎扬途侵刑绿兄迅套贸毕唯谷轮库迹尤竞街" +
+	"促延震弃甲伟麻川申缓潜闪售灯针哲络抵朱埃抱鼓植纯夏忍页杰筑折郑贝尊吴秀混臣雅振染盛怒舞圆搞狂措姓残秋" +
+	"培迷诚宽宇猛摆梅毁伸摩盟末乃悲拍丁赵硬麦蒋操耶阻订彩抽赞魔纷沿喊违妹浪汇币丰蓝殊献桌啦瓦莱援译夺汽烧" +
+	"距裁偏符勇触课敬哭懂墙袭召罚侠厅拜巧侧韩冒债曼融惯享戴童犹乘挂奖绍厚纵障讯涉彻刊丈爆乌役描洗玛患妙镜" +
+	"唱烦签仙彼弗症仿倾牌陷鸟轰咱菜闭奋庆撤泪茶疾缘播朗杜奶季丹狗尾仪偷奔珠虫驻孔宜艾桥淡翼恨繁寒伴叹旦愈" +
+	"潮粮缩罢聚径恰挑袋灰捕徐珍幕映裂泰隔启尖忠累炎暂估泛荒偿横拒瑞忆孤鼻闹羊呆厉衡胞零穷舍码赫婆魂灾洪腿" +
+	"胆津俗辩胸晓劲贫仁偶辑邦恢赖圈摸仰润堆碰艇稍迟辆废净凶署壁御奉旋冬矿抬蛋晨伏吹鸡倍糊秦盾杯租骑乏隆诊" +
+	"奴摄丧污渡旗甘耐凭扎抢绪粗肩梁幻菲皆碎宙叔岩荡综爬荷悉蒂返井壮薄悄扫敏碍殖详迪矛霍允幅撒剩凯颗骂赏液" +
+	"番箱贴漫酸郎腰舒眉忧浮辛恋餐吓挺励辞艘键伍峰尺昨黎辈贯侦滑券崇扰宪绕趋慈乔阅汗枝拖墨胁插箭腊粉泥氏彭" +
+	"拔骗凤慧媒佩愤扑龄驱惜豪掩兼跃尸肃帕驶堡届欣惠册储飘桑闲惨洁踪勃宾频仇磨递邪撞拟滚奏巡颜剂绩贡疯坡瞧" +
+	"截燃焦殿伪柳锁逼颇昏劝呈搜勤戒驾漂饮曹朵仔柔俩孟腐幼践籍牧凉牲佳娜浓芳稿竹腹跌逻垂遵脉貌柏狱猜怜惑陶" +
+	"兽帐饰贷昌叙躺钢沟寄扶铺邓寿惧询汤盗肥尝匆辉奈扣廷澳嘛董迁凝慰厌脏腾幽怨鞋丢埋泉涌辖躲晋紫艰魏吾慌祝" +
+	"邮吐狠鉴曰械咬邻赤挤弯椅陪割揭韦悟聪雾锋梯猫祥阔誉筹丛牵鸣沈阁穆屈旨袖猎臂蛇贺柱抛鼠瑟戈牢逊迈欺吨琴" +
+	"衰瓶恼燕仲诱狼池疼卢仗冠粒遥吕玄尘冯抚浅敦纠钻晶岂峡苍喷耗凌敲菌赔涂粹扁亏寂煤熊恭湿循暖糖赋抑秩帽哀" +
+	"宿踏烂袁侯抖夹昆肝擦猪炼恒慎搬纽纹玻渔磁铜齿跨押怖漠疲叛遣兹祭醉拳弥斜档稀捷肤疫肿豆削岗晃吞宏癌肚隶" +
+	"履涨耀扭坛拨沃绘伐堪仆郭牺歼墓雇廉契拼惩捉覆刷劫嫌瓜歇雕闷乳串娃缴唤赢莲霸桃妥瘦搭赴岳嘉舱俊址庞耕锐" +
+	"缝悔邀玲惟斥宅添挖呵讼氧浩羽斤酷掠妖祸侍乙妨贪挣汪尿莉悬唇翰仓轨枚盐览傅帅庙芬屏寺胖璃愚滴疏萧姿颤丑" +
+	"劣柯寸扔盯辱匹俱辨饿蜂哦腔郁溃谨糟葛苗肠忌溜鸿爵鹏鹰笼丘桂滋聊挡纲肌茨壳痕碗穴膀卓贤卧膜毅锦欠哩函茫" +
+	"昂薛皱夸豫胃舌剥傲拾窝睁携陵哼棉晴铃填饲渴吻扮逆脆喘罩卜炉柴愉绳胎蓄眠竭喂傻慕浑奸扇柜悦拦诞饱乾泡贼" +
+	"亭夕爹酬儒姻卵氛泄杆挨僧蜜吟猩遂狭肖甜霞驳裕顽於摘矮秒卿畜咽披辅勾盆疆赌塑畏吵囊嗯泊肺骤缠冈羞瞪吊贾" +
+	"漏斑涛悠鹿俘锡卑葬铭滩嫁催璇翅盒蛮矣潘歧赐鲍锅廊拆灌勉盲宰佐啥胀扯禧辽抹筒棋裤唉朴咐孕誓喉妄拘链驰栏" +
+	"逝窃艳臭纤玑棵趁匠盈翁愁瞬婴孝颈倘浙谅蔽畅赠妮莎尉冻跪闯葡後厨鸭颠遮谊圳吁仑辟瘤嫂陀框谭亨钦庸歉芝吼" +
+	"甫衫摊宴嘱衷娇陕矩浦讶耸裸碧摧薪淋耻胶屠鹅饥盼脖虹翠崩账萍逢赚撑翔倡绵猴枯巫昭怔渊凑溪蠢禅阐旺寓藤匪" +
+	"伞碑挪琼脂谎慨菩萄狮掘抄岭晕逮砍掏狄晰罕挽脾舟痴蔡剪脊弓懒叉拐喃僚捐姊骚拓歪粘柄坑陌窄湘兆崖骄刹鞭芒" +
+	"筋聘钩棍嚷腺弦焰耍俯厘愣厦恳饶钉寡憾摔叠惹喻谱愧煌徽溶坠煞巾滥洒堵瓷咒姨棒郡浴媚稣淮哎屁漆淫巢吩撰啸" +
+	"滞玫硕钓蝶膝姚茂躯吏猿寨恕渠戚辰舶颁惶狐讽笨袍嘲啡泼衔倦涵雀旬僵撕肢垄夷逸茅侨舆窑涅蒲谦杭噢弊勋刮郊" +
+	"凄捧浸砖鼎篮蒸饼亩肾陡爪兔殷贞荐哑炭坟眨搏咳拢舅昧擅爽咖搁禄雌哨巩绢螺裹昔轩谬谍龟媳姜瞎冤鸦蓬巷琳栽" +
+	"沾诈斋瞒彪厄咨纺罐桶壤糕颂膨谐垒咕隙辣绑宠嘿兑霉挫稽辐乞纱裙嘻哇绣杖塘衍轴攀膊譬斌祈踢肆坎轿棚泣屡躁" +
+	"邱凰溢椎砸趟帘帆栖窜丸斩堤塌贩厢掀喀乖谜捏阎滨虏匙芦苹卸沼钥株祷剖熙哗劈怯棠胳桩瑰娱娶沫嗓蹲焚淘嫩韵" +
+	"衬匈钧竖峻豹捞菊鄙魄兜哄颖镑屑蚁壶怡渗秃迦旱哟咸焉谴宛稻铸锻伽詹毙恍贬烛骇芯汁桓坊驴朽靖佣汝碌迄冀荆" +
+	"崔雁绅珊榜诵傍彦醇笛禽勿娟瞄幢寇睹贿踩霆呜拱妃蔑谕缚诡篷淹腕煮倩卒勘馨逗甸贱炒灿敞蜡囚栗辜垫妒魁谣寞" +
+	"蜀甩涯枕丐泳奎泌逾叮黛燥掷藉枢憎鲸弘倚侮藩拂鹤蚀浆芙垃烤晒霜剿蕴圾绸屿氢驼妆捆铅逛淑榴丙痒钞蹄犬躬昼" +
+	"藻蛛褐颊奠募耽蹈陋侣魅岚侄虐堕陛莹荫狡阀绞膏垮茎缅喇绒搅凳梭丫姬诏钮棺耿缔懈嫉灶匀嗣鸽澡凿纬沸畴刃遏" +
+	"烁嗅叭熬瞥骸奢拙栋毯桐砂莽泻坪梳杉晤稚蔬蝇捣顷麽尴镖诧尬硫嚼羡沦沪旷彬芽狸冥碳咧惕暑咯萝汹腥窥俺潭崎" +
+	"麟捡拯厥澄萎哉涡滔暇溯鳞酿茵愕瞅暮衙诫斧兮焕棕佑嘶妓喧蓉删樱伺嗡娥梢坝蚕敷澜杏绥冶庇挠搂倏聂婉噪稼鳍" +
+	"菱盏匿吱寝揽髓秉哺矢啪帜邵嗽挟缸揉腻驯缆晌瘫贮觅朦僻隋蔓咋嵌虔畔琐碟涩胧嘟蹦冢浏裔襟叨诀旭虾簿啤擒枣" +
+	"嘎苑牟呕骆凸熄兀喔裳凹赎屯膛浇灼裘砰棘橡碱聋姥瑜毋娅沮萌俏黯撇粟粪尹苟癫蚂禹廖俭帖煎缕窦簇棱叩呐瑶墅" +
+	"莺烫蛙歹伶葱哮眩坤廓讳啼乍瓣矫跋枉梗厕琢讥釉窟敛轼庐胚呻绰扼懿炯竿慷虞锤栓桨蚊磅孽惭戳禀鄂馈垣溅咚钙" +
+	"礁彰豁眯磷雯墟迂瞻颅琉悼蝴拣渺眷悯汰慑婶斐嘘镶炕宦趴绷窘襄珀嚣拚酌浊毓撼嗜扛峭磕翘槽淌栅颓熏瑛颐忖当"
@@ -0,0 +1,127 @@
+package zh_test
+
+import (
+	"goreadability/zh"
+	"strings"
+	"testing"
+)
+
+func TestCalcReadabilityScripts(t *testing.T) {
+	texts := map[string]string{
+		"我们的国家很大。这个问题很难，请你说说。": "我們的國家很大。這個問題很難，請你說說。",
+		"发展经济，实现现代化。":          "發展經濟，實現現代化。",
+		"银行的职员认为这项规则过于复杂。":     "銀行的職員認為這項規則過於複雜。",
+	}
+	for simplified, traditional := range texts {
+		want, err := zh.CalcReadability(simplified)
+		if err != nil {
+			t.Fatalf("CalcReadability(%q) returned error: %v", simplified, err)
+		}
+		if got, _ := zh.CalcReadability(traditional); got != want {
+			t.Errorf("CalcReadability(%q) = %v, want %v as for %q", traditional, got, want, simplified)
+		}
+		if got := zh.CountStats(traditional).Script; got != zh.Traditional {
+			t.Errorf("CountStats(%q).Script = %v, want Traditional", traditional, got)
+		}
+		if got := zh.CountStats(simplified).Script; got != zh.Simplified {
+			t.Errorf("CountStats(%q).Script = %v, want Simplified", simplified, got)
+		}
+	}
+}
+
+func TestCalcAverageStrokes(t *testing.T) {
+	table := "# Unihan\nU+4E00\tkTotalStrokes\t1\nU+4E00\tkDefinition\tone\nU+4EBA\tkTotalStrokes\t2\n学 8\n"
+	counts, err := zh.LoadStrokeCounts(strings.NewReader(table))
+	if err != nil {
+		t.Fatalf("LoadStrokeCounts() returned error: %v", err)
+	}
+	if counts.Len() != 3 {
+		t.Errorf("LoadStrokeCounts().Len() = %d, want 3", counts.Len())
+	}
+	tests := map[string]float64{
+		"一人学。": 3.67,
+		"一人學。": 3.67,
+		"一个人。": 1.5,
+	}
+	for text, want := range tests {
+		if got, err := zh.CalcAverageStrokes(text, counts); err != nil || got != want {
+			t.Errorf("CalcAverageStrokes(%q) = %v, %v, want %v", text, got, err, want)
+		}
+	}
+	if _, err := zh.CalcAverageStrokes("你好。", counts); err == nil {
+		t.Errorf("CalcAverageStrokes() without known characters returned no error")
+	}
+	if _, err := zh.LoadStrokeCounts(strings.NewReader("一\n")); err == nil {
+		t.Errorf("LoadStrokeCounts() of a line without a count returned no error")
+	}
+}
+
+func TestCountStats(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  zh.TextStats
+		score float64
+	}{
+		// 7 common characters: 0.0496 * 7 = 0.35.
+		{"我们的国家很大。", zh.TextStats{Sentences: 1, Characters: 7, Script: zh.Simplified}, 0.35},
+		// 2 uncommon characters of 5: 0.1579 * 40 + 0.0496 * 5 = 6.56.
+		{"我看见魑魍。", zh.TextStats{Sentences: 1, Characters: 5, UncommonCharacters: 2, Script: zh.Simplified}, 6.56},
+		// 3 uncommon characters of 4 ("魅" is common), none of which has a Traditional form: 0.1579 * 75 + 0.0496 * 4 = 12.04.
+		{"魑魅魍魉。", zh.TextStats{Sentences: 1, Characters: 4, UncommonCharacters: 3}, 12.04},
+		// 11 characters in 2 sentences, the Latin letters and the digits are not counted: 0.0496 * 5.5 = 0.27.
+		{"我们学习中文。你们学习Go 1.23吗？", zh.TextStats{Sentences: 2, Characters: 11, Script: zh.Simplified}, 0.27},
+	}
+	for _, tt := range tests {
+		if got := zh.CountStats(tt.text); got != tt.want {
+			t.Errorf("CountStats(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+		if got, err := zh.CalcReadability(tt.text); err != nil || got != tt.score {
+			t.Errorf("CalcReadability(%q) = %v, %v, want %v", tt.text, got, err, tt.score)
+		}
+	}
+}
+
+func TestTraditionalCharacters(t *testing.T) {
+	// The Traditional characters are scored by their Simplified forms, which are common.
+	pairs := map[rune]rune{'國': '国', '學': '学', '們': '们', '龍': '龙', '愛': '爱', '習': '习', '這': '这', '個': '个', '來': '来', '說': '说', '時': '时', '會': '会'}
+	for traditional, simplified := range pairs {
+		want := zh.TextStats{Sentences: 1, Characters: 1, Script: zh.Traditional}
+		if got := zh.CountStats(string(traditional)); got != want {
+			t.Errorf("CountStats(%q) = %+v, want %+v", traditional, got, want)
+		}
+		want.Script = zh.Simplified
+		if got := zh.CountStats(string(simplified)); got != want {
+			t.Errorf("CountStats(%q) = %+v, want %+v", simplified, got, want)
+		}
+	}
+}
+
+func TestLoadStrokeCountsFile(t *testing.T) {
+	counts, err := zh.LoadStrokeCountsFile("testdata/unihan_strokes.txt")
+	if err != nil {
+		t.Fatalf("LoadStrokeCountsFile() returned error: %v", err)
+	}
+	if counts.Len() != 16 {
+		t.Errorf("LoadStrokeCountsFile().Len() = %d, want 16", counts.Len())
+	}
+	for char, want := range map[rune]uint{'一': 1, '學': 16, '学': 8, '龍': 16} {
+		if got, ok := counts.Strokes(char); !ok || got != want {
+			t.Errorf("Strokes(%q) = %d, %v, want %d", char, got, ok, want)
+		}
+	}
+	tests := map[string]float64{
+		// (7 + 5 + 8 + 4 + 4) / 5 = 5.6, in both scripts.
+		"我们学中文。": 5.6,
+		"我們學中文。": 5.6,
+		// (7 + 10 + 8) / 3, "爱" and "国" in both scripts.
+		"我爱国。": 8.33,
+		"我愛國。": 8.33,
+		// "很" and "大" are not in the table.
+		"龙很大。": 5,
+	}
+	for text, want := range tests {
+		if got, err := zh.CalcAverageStrokes(text, counts); err != nil || got != want {
+			t.Errorf("CalcAverageStrokes(%q) = %v, %v, want %v", text, got, err, want)
+		}
+	}
+}